@@ -64,10 +64,14 @@ func (i *innerSdk) AuthorizationEndpoint(w http.ResponseWriter, r *http.Request)
 			b, _ := json.MarshalIndent(fail.Error, "", "  ")
 			w.Write(b)
 		} else if out := res.Msg.GetIssue(); out != nil {
-			err := i.authorizationIssue(w, r, out.RequestId, out.SessionId, out.Subject)
+			err := i.authorizationIssue(w, r, out.RequestId, out.SessionId, out.Subject, false)
 			if err != nil {
 				return err
 			}
+		} else if out := res.Msg.GetConsent(); out != nil {
+			if err := i.writeConsentHtml(w, r, out); err != nil {
+				return err
+			}
 		} else if out := res.Msg.GetRedirect(); out != nil {
 			http.Redirect(w, r, out.Url, http.StatusFound)
 		} else if out := res.Msg.GetHtml(); out != nil {
@@ -76,11 +80,7 @@ func (i *innerSdk) AuthorizationEndpoint(w http.ResponseWriter, r *http.Request)
 			}
 			w.Write([]byte(out.Content))
 		} else if out := res.Msg.GetLogin(); out != nil {
-			i.config.Callbacks.WriteLoginHtmlCallback(&RequestInfo{
-				RequestId:  out.RequestId,
-				Client:     out.Client,
-				AuthParams: out.AuthParams,
-			}).ServeHTTP(w, r)
+			i.config.Callbacks.WriteLoginHtmlCallback(newRequestInfo(out.RequestId, out.Client, out.AuthParams)).ServeHTTP(w, r)
 		}
 		return nil
 	}(); err != nil {
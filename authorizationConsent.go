@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package opgo
+
+import (
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+func (i *innerSdk) AuthorizationConsent(w http.ResponseWriter, r *http.Request, requestId string) {
+	if err := func() error {
+		ctx := r.Context()
+		req := connect.NewRequest(&oppb.RequestRequest{
+			RequestId: requestId,
+		})
+		auth.SetAuth(req, i)
+		res, err := i.provider.Request(ctx, req)
+		if err != nil {
+			return err
+		}
+		if res.Msg.Subject == "" {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("consent is not requested"))
+		}
+		return i.authorizationIssue(w, r, requestId, res.Msg.SessionId, res.Msg.Subject, true)
+	}(); err != nil {
+		writeError(w, err)
+		return
+	}
+}
+
+func (i *innerSdk) writeConsentHtml(w http.ResponseWriter, r *http.Request, out *oppb.AuthorizationNextActionConsent) error {
+	info := newRequestInfo(out.RequestId, out.Client, out.AuthParams)
+	info.Subject = out.Subject
+	if cb, ok := i.config.Callbacks.(ConsentCallbacks); ok {
+		if h := cb.WriteConsentHtmlCallback(info); h != nil {
+			h.ServeHTTP(w, r)
+			return nil
+		}
+	}
+	// No confirmation page is provided, so the consent is granted immediately.
+	return i.authorizationIssue(w, r, out.RequestId, out.SessionId, out.Subject, true)
+}
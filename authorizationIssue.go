@@ -32,14 +32,14 @@ import (
 )
 
 func (i *innerSdk) AuthorizationIssue(w http.ResponseWriter, r *http.Request, requestId, subject string) {
-	err := i.authorizationIssue(w, r, requestId, "", subject)
+	err := i.authorizationIssue(w, r, requestId, "", subject, false)
 	if err != nil {
 		writeError(w, err)
 		return
 	}
 }
 
-func (i *innerSdk) authorizationIssue(w http.ResponseWriter, r *http.Request, requestId, sessionId, subject string) error {
+func (i *innerSdk) authorizationIssue(w http.ResponseWriter, r *http.Request, requestId, sessionId, subject string, consentGranted bool) error {
 	ctx := r.Context()
 
 	claims, err := i.config.Callbacks.GetUserClaimsCallback(ctx, subject)
@@ -55,11 +55,28 @@ func (i *innerSdk) authorizationIssue(w http.ResponseWriter, r *http.Request, re
 		}
 	}
 
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+	authorizationDetails := ""
+	if cb, ok := i.config.Callbacks.(AuthorizationDetailsCallbacks); ok {
+		info, err := i.GetRequestInfo(ctx, requestId)
+		if err != nil {
+			return err
+		}
+		if len(info.AuthorizationDetails) > 0 {
+			authorizationDetails, err = cb.EnrichAuthorizationDetailsCallback(ctx, info, subject)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	req := connect.NewRequest(&oppb.AuthorizationIssueRequest{
-		RequestId: requestId,
-		SessionId: sessionId,
-		Subject:   subject,
-		Claims:    claims,
+		RequestId:            requestId,
+		SessionId:            sessionId,
+		Subject:              subject,
+		Claims:               claims,
+		AuthorizationDetails: authorizationDetails,
+		ConsentGranted:       consentGranted,
 	})
 	auth.SetAuth(req, i)
 	res, err := i.provider.AuthorizationIssue(ctx, req)
@@ -73,6 +90,8 @@ func (i *innerSdk) authorizationIssue(w http.ResponseWriter, r *http.Request, re
 			w.Header().Set(k, v)
 		}
 		w.Write([]byte(out.Content))
+	} else if out := res.Msg.GetConsent(); out != nil {
+		return i.writeConsentHtml(w, r, out)
 	}
 
 	return nil
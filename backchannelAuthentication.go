@@ -0,0 +1,168 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package opgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+)
+
+func (i *innerSdk) BackchannelAuthenticationEndpoint(w http.ResponseWriter, r *http.Request) {
+	if err := func() error {
+		ctx := r.Context()
+		cert, handshake := i.clientCertificate(r)
+		req := connect.NewRequest(&oppb.BackchannelAuthenticationRequest{
+			ContentType:                       r.Header.Get(httphelper.HeaderContentType),
+			Method:                            r.Method,
+			TlsClientCertificate:              cert,
+			TlsClientCertificateFromHandshake: handshake,
+		})
+		// Get form
+		defer r.Body.Close()
+		if r.Body != nil {
+			if b, err := io.ReadAll(r.Body); err == nil {
+				req.Msg.Form = string(b)
+			}
+		}
+		// Set Basic auth
+		if username, password, ok := r.BasicAuth(); ok {
+			req.Msg.BasicAuth = &oppb.BasicAuth{
+				Username: username,
+				Password: password,
+			}
+		}
+		auth.SetAuth(req, i)
+		res, err := i.provider.BackchannelAuthentication(ctx, req)
+		if err != nil {
+			return err
+		}
+		fail := res.Msg.GetFail()
+		if success := res.Msg.GetSuccess(); success != nil {
+			// Notify the end-user through the application
+			if err := i.notifyBackchannelAuthentication(ctx, res.Msg.Notify); err != nil {
+				fail = &oppb.TokenFailResponse{
+					StatusCode: http.StatusBadRequest,
+					Error: &oppb.OauthError{
+						Error:            oauth.TokenErrorUnknownUserId,
+						ErrorDescription: err.Error(),
+					},
+				}
+				if !errors.Is(err, ErrUnknownUser) {
+					fail.Error.Error = oauth.TokenErrorTransactionFailed
+				}
+			} else {
+				body, err := json.MarshalIndent(success, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				for key, val := range httphelper.DefaultJsonHeader() {
+					w.Header().Add(key, val)
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write(body)
+				return nil
+			}
+		}
+		if fail != nil {
+			body, err := json.MarshalIndent(fail.Error, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			for key, val := range httphelper.DefaultJsonHeader() {
+				w.Header().Add(key, val)
+			}
+			w.WriteHeader(int(fail.StatusCode))
+			w.Write(body)
+		}
+		return nil
+	}(); err != nil {
+		writeError(w, err)
+		return
+	}
+}
+
+func (i *innerSdk) notifyBackchannelAuthentication(ctx context.Context, notify *oppb.BackchannelAuthenticationNotify) error {
+	err := ErrUnknownUser
+	if cb, ok := i.config.Callbacks.(BackchannelAuthenticationCallbacks); ok {
+		err = cb.NotifyBackchannelAuthenticationCallback(ctx, &BackchannelAuthenticationInfo{
+			AuthReqId:      notify.AuthReqId,
+			ClientId:       notify.ClientId,
+			Client:         notify.Client,
+			Scopes:         notify.Scopes,
+			AcrValues:      notify.AcrValues,
+			LoginHint:      notify.LoginHint,
+			LoginHintToken: notify.LoginHintToken,
+			Subject:        notify.Subject,
+			BindingMessage: notify.BindingMessage,
+			UserCode:       notify.UserCode,
+		})
+	}
+	if err != nil {
+		// The end-user can not be reached, so discard the request without notifying the client
+		req := connect.NewRequest(&oppb.BackchannelAuthenticationCancelRequest{
+			AuthReqId: notify.AuthReqId,
+			Discard:   true,
+		})
+		auth.SetAuth(req, i)
+		if _, cerr := i.provider.BackchannelAuthenticationCancel(ctx, req); cerr != nil {
+			return errors.Join(err, cerr)
+		}
+	}
+	return err
+}
+
+func (i *innerSdk) BackchannelAuthenticationIssue(ctx context.Context, authReqId, subject string) error {
+	claims, err := i.config.Callbacks.GetUserClaimsCallback(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	req := connect.NewRequest(&oppb.BackchannelAuthenticationIssueRequest{
+		AuthReqId: authReqId,
+		Subject:   subject,
+		Claims:    claims,
+	})
+	auth.SetAuth(req, i)
+	_, err = i.provider.BackchannelAuthenticationIssue(ctx, req)
+	return err
+}
+
+func (i *innerSdk) BackchannelAuthenticationCancel(ctx context.Context, authReqId string) error {
+	req := connect.NewRequest(&oppb.BackchannelAuthenticationCancelRequest{
+		AuthReqId: authReqId,
+	})
+	auth.SetAuth(req, i)
+	_, err := i.provider.BackchannelAuthenticationCancel(ctx, req)
+	return err
+}
@@ -0,0 +1,179 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package opgo
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// HeaderXForwardedClientCert is the header set by Envoy.
+	HeaderXForwardedClientCert = "X-Forwarded-Client-Cert"
+	// HeaderAmznMtlsClientcert is the header set by AWS Application Load Balancer.
+	HeaderAmznMtlsClientcert = "X-Amzn-Mtls-Clientcert"
+)
+
+// ClientCertificateExtractor extracts the TLS client certificate of a request in PEM format,
+// optionally followed by the intermediate certificates of the chain.
+// It returns an empty string if no certificate is presented.
+type ClientCertificateExtractor func(r *http.Request) (string, error)
+
+// ClientCertificateCallbacks can optionally be implemented by SdkCallbacks
+// to choose how the TLS client certificate is obtained from requests.
+// If it is not implemented, only the certificate of the TLS connection is used.
+type ClientCertificateCallbacks interface {
+	// ClientCertificateExtractor returns the extractor used by the endpoints
+	// that accept mutual-TLS client authentication or certificate-bound tokens.
+	ClientCertificateExtractor() ClientCertificateExtractor
+}
+
+// ClientCertificateFromTls extracts the certificate chain from the TLS connection
+// when the server terminates TLS itself.
+func ClientCertificateFromTls() ClientCertificateExtractor {
+	return func(r *http.Request) (string, error) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			return "", nil
+		}
+		var chain []byte
+		for _, cert := range r.TLS.PeerCertificates {
+			chain = append(chain, pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: cert.Raw,
+			})...)
+		}
+		return string(chain), nil
+	}
+}
+
+// ClientCertificateFromXfcc extracts the certificate from the Envoy X-Forwarded-Client-Cert header.
+// The Cert field of the first element (the certificate presented to the edge proxy) is used.
+// The header can be set by any client, so use this extractor only behind a proxy
+// that strips the header from incoming requests and sets it from the TLS connection.
+func ClientCertificateFromXfcc() ClientCertificateExtractor {
+	return func(r *http.Request) (string, error) {
+		v := r.Header.Get(HeaderXForwardedClientCert)
+		if v == "" {
+			return "", nil
+		}
+		elements := splitQuoted(v, ',')
+		for _, pair := range splitQuoted(elements[0], ';') {
+			key, val, ok := strings.Cut(pair, "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "Cert") {
+				continue
+			}
+			return unescapePem(strings.Trim(strings.TrimSpace(val), `"`))
+		}
+		return "", nil
+	}
+}
+
+// ClientCertificateFromPemHeader extracts the URL-encoded PEM certificate from the header,
+// e.g. nginx $ssl_client_escaped_cert or X-Amzn-Mtls-Clientcert of AWS Application Load Balancer.
+// The header can be set by any client, so use this extractor only behind a proxy
+// that strips the header from incoming requests and sets it from the TLS connection.
+func ClientCertificateFromPemHeader(name string) ClientCertificateExtractor {
+	return func(r *http.Request) (string, error) {
+		v := r.Header.Get(name)
+		if v == "" {
+			return "", nil
+		}
+		return unescapePem(v)
+	}
+}
+
+// ClientCertificateFromFirst returns the first certificate found by the extractors.
+func ClientCertificateFromFirst(extractors ...ClientCertificateExtractor) ClientCertificateExtractor {
+	return func(r *http.Request) (string, error) {
+		for _, extractor := range extractors {
+			cert, err := extractor(r)
+			if err != nil {
+				return "", err
+			}
+			if cert != "" {
+				return cert, nil
+			}
+		}
+		return "", nil
+	}
+}
+
+// defaultClientCertificateExtractor is used when ClientCertificateCallbacks is not implemented.
+// Headers are not trusted by default since they can be set by the client.
+var defaultClientCertificateExtractor = ClientCertificateFromTls()
+
+// clientCertificate returns the client certificate of the request and
+// whether it was presented in the TLS handshake of the connection,
+// in which the client proved possession of the private key.
+func (i *innerSdk) clientCertificate(r *http.Request) (string, bool) {
+	extractor := defaultClientCertificateExtractor
+	if cb, ok := i.config.Callbacks.(ClientCertificateCallbacks); ok {
+		extractor = cb.ClientCertificateExtractor()
+	}
+	cert, err := extractor(r)
+	if err != nil {
+		log.Printf("client certificate extraction error: %v", err)
+		return "", false
+	}
+	if cert == "" || r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return cert, false
+	}
+	block, _ := pem.Decode([]byte(cert))
+	return cert, block != nil && bytes.Equal(block.Bytes, r.TLS.PeerCertificates[0].Raw)
+}
+
+func unescapePem(v string) (string, error) {
+	// '+' is a base64 character, so it is not unescaped to a space
+	cert, err := url.PathUnescape(v)
+	if err != nil {
+		return "", err
+	}
+	if block, _ := pem.Decode([]byte(cert)); block == nil {
+		return "", fmt.Errorf("invalid PEM certificate")
+	}
+	return cert, nil
+}
+
+// splitQuoted splits s by sep, ignoring separators within double quotes.
+func splitQuoted(s string, sep rune) []string {
+	res := []string{}
+	quoted := false
+	start := 0
+	for i, c := range s {
+		switch c {
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				res = append(res, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(res, s[start:])
+}
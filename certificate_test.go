@@ -0,0 +1,180 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package opgo
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCallbacks struct {
+	extractor ClientCertificateExtractor
+}
+
+func (testCallbacks) GetUserClaimsCallback(context.Context, string) (string, error) {
+	return "{}", nil
+}
+
+func (testCallbacks) WriteLoginHtmlCallback(*RequestInfo) http.HandlerFunc {
+	return nil
+}
+
+// testCertificateCallbacks implements ClientCertificateCallbacks.
+type testCertificateCallbacks struct {
+	testCallbacks
+}
+
+func (c testCertificateCallbacks) ClientCertificateExtractor() ClientCertificateExtractor {
+	return c.extractor
+}
+
+func newTestCertificate(t *testing.T) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestClientCertificate(t *testing.T) {
+	cert := newTestCertificate(t)
+	other := newTestCertificate(t)
+	pemCert := func(c *x509.Certificate) string {
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw}))
+	}
+
+	type testCase struct {
+		name          string
+		callbacks     SdkCallbacks
+		tls           []*x509.Certificate
+		header        map[string]string
+		wantCert      string
+		wantHandshake bool
+	}
+
+	tests := []testCase{
+		{
+			name:          "TLS connection by default",
+			callbacks:     testCallbacks{},
+			tls:           []*x509.Certificate{cert},
+			wantCert:      pemCert(cert),
+			wantHandshake: true,
+		},
+		{
+			name:      "Headers are ignored by default",
+			callbacks: testCallbacks{},
+			header: map[string]string{
+				"X-Client-Cert-Hash":       "dGh1bWJwcmludA",
+				HeaderAmznMtlsClientcert:   url.PathEscape(pemCert(cert)),
+				HeaderXForwardedClientCert: `Cert="` + url.PathEscape(pemCert(cert)) + `"`,
+			},
+			wantCert: "",
+		},
+		{
+			name: "PEM header when opted in",
+			callbacks: testCertificateCallbacks{testCallbacks{
+				extractor: ClientCertificateFromPemHeader(HeaderAmznMtlsClientcert),
+			}},
+			header: map[string]string{
+				HeaderAmznMtlsClientcert: url.PathEscape(pemCert(cert)),
+			},
+			wantCert:      pemCert(cert),
+			wantHandshake: false,
+		},
+		{
+			name: "XFCC header when opted in",
+			callbacks: testCertificateCallbacks{testCallbacks{
+				extractor: ClientCertificateFromXfcc(),
+			}},
+			header: map[string]string{
+				HeaderXForwardedClientCert: `Hash=abc;Cert="` + url.PathEscape(pemCert(cert)) + `";Subject="CN=client"`,
+			},
+			wantCert:      pemCert(cert),
+			wantHandshake: false,
+		},
+		{
+			name: "Header certificate differs from the TLS connection",
+			callbacks: testCertificateCallbacks{testCallbacks{
+				extractor: ClientCertificateFromPemHeader(HeaderAmznMtlsClientcert),
+			}},
+			tls: []*x509.Certificate{other},
+			header: map[string]string{
+				HeaderAmznMtlsClientcert: url.PathEscape(pemCert(cert)),
+			},
+			wantCert:      pemCert(cert),
+			wantHandshake: false,
+		},
+		{
+			name:          "Intermediate certificates follow the client certificate",
+			callbacks:     testCallbacks{},
+			tls:           []*x509.Certificate{cert, other},
+			wantCert:      pemCert(cert) + pemCert(other),
+			wantHandshake: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			i := &innerSdk{config: &SdkConfig{Callbacks: tc.callbacks}}
+			r := httptest.NewRequest(http.MethodPost, "https://op.example.com/token", nil)
+			if tc.tls != nil {
+				r.TLS = &tls.ConnectionState{PeerCertificates: tc.tls}
+			}
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+			cert, handshake := i.clientCertificate(r)
+			assert.Equal(t, tc.wantCert, cert)
+			assert.Equal(t, tc.wantHandshake, handshake)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package opgo
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Eigen438/opgo/pkg/httphelper"
+)
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.3
+// dpopProof returns the DPoP header of the request.
+// Multiple DPoP headers are joined so that the provider can reject them.
+func dpopProof(r *http.Request) string {
+	return strings.Join(r.Header.Values(httphelper.HeaderDpop), ",")
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+func setDpopNonce(w http.ResponseWriter, nonce string) {
+	if nonce != "" {
+		w.Header().Set(httphelper.HeaderDpopNonce, nonce)
+	}
+}
@@ -74,13 +74,20 @@ func main() {
 		AcrValuesSupported:                         []string{"urn:mace:incommon:iap:silver"},
 		SubjectTypesSupported:                      []string{"public", "pairwise"},
 		IdTokenSigningAlgValuesSupported:           []string{"none", "RS256"},
+		IdTokenEncryptionAlgValuesSupported:        []string{"RSA-OAEP", "RSA-OAEP-256", "ECDH-ES", "ECDH-ES+A128KW", "ECDH-ES+A256KW", "A128KW", "A256KW", "dir"},
+		IdTokenEncryptionEncValuesSupported:        []string{"A128CBC-HS256", "A128GCM", "A256GCM"},
 		UserinfoSigningAlgValuesSupported:          []string{"none", "RS256"},
-		TokenEndpointAuthSigningAlgValuesSupported: []string{"none", "RS256"},
+		UserinfoEncryptionAlgValuesSupported:       []string{"RSA-OAEP", "RSA-OAEP-256", "ECDH-ES", "ECDH-ES+A128KW", "ECDH-ES+A256KW", "A128KW", "A256KW", "dir"},
+		UserinfoEncryptionEncValuesSupported:       []string{"A128CBC-HS256", "A128GCM", "A256GCM"},
+		TokenEndpointAuthSigningAlgValuesSupported: []string{"none", "RS256", "HS256"},
 		RequestObjectSigningAlgValuesSupported:     []string{"none", "RS256"},
+		RequestObjectEncryptionAlgValuesSupported:  []string{"RSA-OAEP", "RSA-OAEP-256", "ECDH-ES", "ECDH-ES+A128KW", "ECDH-ES+A256KW", "A128KW", "A256KW", "dir"},
+		RequestObjectEncryptionEncValuesSupported:  []string{"A128CBC-HS256", "A128GCM", "A256GCM"},
 		ClaimsSupported:                            []string{"iss"},
 		TokenEndpointAuthMethodsSupported:          []string{"client_secret_basic", "client_secret_post", "client_secret_jwt", "private_key_jwt"},
 		RequestParameterSupported:                  true,
 		RequestUriParameterSupported:               true,
+		DpopSigningAlgValuesSupported:              []string{"ES256", "PS256"},
 	}
 	sdk, err := opgo.NewHostedSdk(ctx, meta, authenticationui.Callbacks{}, firestoreService)
 	if err != nil {
@@ -50,6 +50,7 @@ func JWKMarchalsFromKeys(keys []*oppb.Jwk) []jwkset.JWKMarshal {
 			ALG:     jwkset.ALG(org.Alg),
 			KID:     org.Kid,
 			X5U:     org.X5U,
+			X5C:     org.X5C,
 			X5T:     org.X5T,
 			X5TS256: org.X5TS256,
 			CRV:     jwkset.CRV(org.Crv),
@@ -94,6 +95,7 @@ func JwksFromJWKMarshals(keys []jwkset.JWKMarshal) []*oppb.Jwk {
 			Alg:     string(org.ALG),
 			Kid:     org.KID,
 			X5U:     org.X5U,
+			X5C:     org.X5C,
 			X5T:     org.X5T,
 			X5TS256: org.X5TS256,
 			Crv:     string(org.CRV),
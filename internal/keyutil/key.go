@@ -46,6 +46,10 @@ const (
 	ECDSA256 = "ecdsa256"
 	ECDSA384 = "ecdsa384"
 	ECDSA512 = "ecdsa512"
+
+	// encryption keys (use=enc)
+	RSAENC  = "rsaenc"
+	ECDHENC = "ecdhenc"
 )
 
 var mappedKeyType = map[string]string{
@@ -60,11 +64,32 @@ var mappedKeyType = map[string]string{
 	jwt.SigningMethodES512.Alg(): ECDSA512,
 }
 
+var mappedEncryptionKeyType = map[string]string{
+	"RSA1_5":         RSAENC,
+	"RSA-OAEP":       RSAENC,
+	"RSA-OAEP-256":   RSAENC,
+	"ECDH-ES":        ECDHENC,
+	"ECDH-ES+A128KW": ECDHENC,
+	"ECDH-ES+A192KW": ECDHENC,
+	"ECDH-ES+A256KW": ECDHENC,
+}
+
 func KeyType(algorithm string) (string, bool) {
 	keyType, ok := mappedKeyType[algorithm]
 	return keyType, ok
 }
 
+// EncryptionKeyType returns the key type of the JWE key management algorithm.
+func EncryptionKeyType(algorithm string) (string, bool) {
+	keyType, ok := mappedEncryptionKeyType[algorithm]
+	return keyType, ok
+}
+
+// IsEncryptionKeyType reports whether the key type is used for encryption.
+func IsEncryptionKeyType(keyType string) bool {
+	return keyType == RSAENC || keyType == ECDHENC
+}
+
 func SigningAlgValueSupported() []string {
 	return []string{
 		jwt.SigningMethodNone.Alg(),
@@ -82,13 +107,13 @@ func SigningAlgValueSupported() []string {
 
 func GetPublicKey(k *model.Key) (any, error) {
 	switch k.KeyType {
-	case RSA256, RSA384, RSA512:
+	case RSA256, RSA384, RSA512, RSAENC:
 		pk, err := x509.ParsePKCS8PrivateKey(k.SerializedKey)
 		if err != nil {
 			return nil, err
 		}
 		return &pk.(*rsa.PrivateKey).PublicKey, nil
-	case ECDSA256, ECDSA384, ECDSA512:
+	case ECDSA256, ECDSA384, ECDSA512, ECDHENC:
 		pk, err := x509.ParsePKCS8PrivateKey(k.SerializedKey)
 		if err != nil {
 			return nil, err
@@ -203,7 +228,7 @@ func GetKeyInfo(ctx context.Context, iss *model.Issuer, algorithm string) (*KeyI
 
 func curve(keyType string) elliptic.Curve {
 	switch keyType {
-	case ECDSA256:
+	case ECDSA256, ECDHENC:
 		return elliptic.P256()
 	case ECDSA384:
 		return elliptic.P384()
@@ -215,7 +240,7 @@ func curve(keyType string) elliptic.Curve {
 
 func bits(keyType string) int {
 	switch keyType {
-	case RSA256:
+	case RSA256, RSAENC:
 		return 2048
 	case RSA384:
 		return 3072
@@ -227,9 +252,9 @@ func bits(keyType string) int {
 
 func KeyGen(keyType string) (any, error) {
 	switch keyType {
-	case RSA256, RSA384, RSA512:
+	case RSA256, RSA384, RSA512, RSAENC:
 		return rsa.GenerateKey(rand.Reader, bits(keyType))
-	case ECDSA256, ECDSA384, ECDSA512:
+	case ECDSA256, ECDSA384, ECDSA512, ECDHENC:
 		return ecdsa.GenerateKey(curve(keyType), rand.Reader)
 	}
 	return nil, fmt.Errorf("unknown algorithm")
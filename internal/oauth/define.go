@@ -28,6 +28,18 @@ const (
 	GrantTypeAuthorizationCode = "authorization_code"
 	GrantTypeRefreshToken      = "refresh_token"
 	GrantTypeImplicit          = "implicit"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+	GrantTypeCiba              = "urn:openid:params:grant-type:ciba"
+	GrantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	GrantTypeJwtBearer         = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.5
+const (
+	BackchannelTokenDeliveryModePoll = "poll"
+	BackchannelTokenDeliveryModePing = "ping"
+	BackchannelTokenDeliveryModePush = "push"
 )
 
 const (
@@ -75,6 +87,39 @@ const (
 	TokenErrorUnauthorizedClient   = "unauthorized_client"
 	TokenErrorUnsupportedGrantType = "unsupported_grant_type"
 	TokenErrorInvalidScope         = "invalid_scope"
+	// https://www.rfc-editor.org/rfc/rfc7009.html#section-2.2.1
+	TokenErrorUnsupportedTokenType = "unsupported_token_type"
+	// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.5
+	TokenErrorAuthorizationPending = "authorization_pending"
+	TokenErrorSlowDown             = "slow_down"
+	TokenErrorAccessDenied         = "access_denied"
+	TokenErrorExpiredToken         = "expired_token"
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.13
+	TokenErrorInvalidRequestObject  = "invalid_request_object"
+	TokenErrorUnknownUserId         = "unknown_user_id"
+	TokenErrorExpiredLoginHintToken = "expired_login_hint_token"
+	TokenErrorMissingUserCode       = "missing_user_code"
+	TokenErrorInvalidUserCode       = "invalid_user_code"
+	TokenErrorInvalidBindingMessage = "invalid_binding_message"
+	TokenErrorTransactionFailed     = "transaction_failed"
+	// https://www.rfc-editor.org/rfc/rfc8693.html#section-2.2.2
+	TokenErrorInvalidTarget = "invalid_target"
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-12.2
+	TokenErrorInvalidDpopProof = "invalid_dpop_proof"
+	TokenErrorUseDpopNonce     = "use_dpop_nonce"
+)
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+const (
+	AccessTokenTypeBearer = "Bearer"
+	AccessTokenTypeDpop   = "DPoP"
+)
+
+// https://www.rfc-editor.org/rfc/rfc8693.html#section-3
+const (
+	TokenTypeAccessToken  = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeRefreshToken = "urn:ietf:params:oauth:token-type:refresh_token"
+	TokenTypeIdToken      = "urn:ietf:params:oauth:token-type:id_token"
 )
 
 func ResponseModesSupported() []string {
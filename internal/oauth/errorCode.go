@@ -95,4 +95,15 @@ const (
 	// The OP does not support use of the registration parameter
 	// defined in Section 7.2.1.
 	AuthorizationErrorRegistrationNotSupported string = "registration_not_supported"
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-5
+	//
+	// The authorization_details parameter contains an unknown
+	// authorization details type, a value is not allowed for the
+	// client, or is otherwise malformed.
+	AuthorizationErrorInvalidAuthorizationDetails string = "invalid_authorization_details"
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+	//
+	// The requested resource is invalid, missing, unknown, or
+	// malformed.
+	AuthorizationErrorInvalidTarget string = "invalid_target"
 )
@@ -45,3 +45,7 @@ func (r *Result) Get(name string) string {
 	}
 	return ""
 }
+
+func (r *Result) GetAll(name string) []string {
+	return r.vals[name]
+}
@@ -70,6 +70,12 @@ type AuthorizationParameters struct {
 	// https://openid.net/specs/openid-connect-core-1_0.html#JWTRequests
 	Request    string `protobuf:"bytes,21,opt,name=request,proto3" json:"request,omitempty"`
 	RequestUri string `protobuf:"bytes,22,opt,name=request_uri,proto3" json:"request_uri,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-10
+	DpopJkt string `protobuf:"bytes,23,opt,name=dpop_jkt,proto3" json:"dpop_jkt,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-2 (json array)
+	AuthorizationDetails string `protobuf:"bytes,24,opt,name=authorization_details,proto3" json:"authorization_details,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+	Resources []string `protobuf:"bytes,25,rep,name=resources,proto3" json:"resources,omitempty"`
 	// custom parameter
 	IsPar         bool   `protobuf:"varint,50,opt,name=is_par,json=isPar,proto3" json:"is_par,omitempty"`
 	ParKey        string `protobuf:"bytes,51,opt,name=par_key,json=parKey,proto3" json:"par_key,omitempty"`
@@ -247,6 +253,27 @@ func (x *AuthorizationParameters) GetRequestUri() string {
 	return ""
 }
 
+func (x *AuthorizationParameters) GetDpopJkt() string {
+	if x != nil {
+		return x.DpopJkt
+	}
+	return ""
+}
+
+func (x *AuthorizationParameters) GetAuthorizationDetails() string {
+	if x != nil {
+		return x.AuthorizationDetails
+	}
+	return ""
+}
+
+func (x *AuthorizationParameters) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
 func (x *AuthorizationParameters) GetIsPar() bool {
 	if x != nil {
 		return x.IsPar
@@ -265,7 +292,7 @@ var File_oppb_v1_authorization_parameters_proto protoreflect.FileDescriptor
 
 const file_oppb_v1_authorization_parameters_proto_rawDesc = "" +
 	"\n" +
-	"&oppb/v1/authorization_parameters.proto\x12\aoppb.v1\"\xc9\x05\n" +
+	"&oppb/v1/authorization_parameters.proto\x12\aoppb.v1\"\xb9\x06\n" +
 	"\x17AuthorizationParameters\x12\x16\n" +
 	"\x06scopes\x18\x01 \x03(\tR\x06scopes\x12$\n" +
 	"\rresponse_type\x18\x02 \x01(\tR\rresponse_type\x12\x1c\n" +
@@ -293,7 +320,10 @@ const file_oppb_v1_authorization_parameters_proto_rawDesc = "" +
 	"\x0ecode_challenge\x18\x13 \x01(\tR\x0ecode_challenge\x124\n" +
 	"\x15code_challenge_method\x18\x14 \x01(\tR\x15code_challenge_method\x12\x18\n" +
 	"\arequest\x18\x15 \x01(\tR\arequest\x12 \n" +
-	"\vrequest_uri\x18\x16 \x01(\tR\vrequest_uri\x12\x15\n" +
+	"\vrequest_uri\x18\x16 \x01(\tR\vrequest_uri\x12\x1a\n" +
+	"\bdpop_jkt\x18\x17 \x01(\tR\bdpop_jkt\x124\n" +
+	"\x15authorization_details\x18\x18 \x01(\tR\x15authorization_details\x12\x1c\n" +
+	"\tresources\x18\x19 \x03(\tR\tresources\x12\x15\n" +
 	"\x06is_par\x182 \x01(\bR\x05isPar\x12\x17\n" +
 	"\apar_key\x183 \x01(\tR\x06parKeyB\xa2\x01\n" +
 	"\vcom.oppb.v1B\x1cAuthorizationParametersProtoP\x01Z8github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1;oppb\xa2\x02\x03OXX\xaa\x02\aOppb.V1\xca\x02\aOppb\\V1\xe2\x02\x13Oppb\\V1\\GPBMetadata\xea\x02\bOppb::V1b\x06proto3"
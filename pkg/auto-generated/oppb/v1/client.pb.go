@@ -100,9 +100,21 @@ type ClientAttribute struct {
 	RefreshTokenLifetimeSeconds      int32                  `protobuf:"varint,4,opt,name=refresh_token_lifetime_seconds,proto3" json:"refresh_token_lifetime_seconds,omitempty"`
 	RequestLifetimeSeconds           int32                  `protobuf:"varint,5,opt,name=request_lifetime_seconds,proto3" json:"request_lifetime_seconds,omitempty"`
 	JwtResponseLifetimeSeconds       int32                  `protobuf:"varint,6,opt,name=jwt_response_lifetime_seconds,proto3" json:"jwt_response_lifetime_seconds,omitempty"`
-	SessionGroupId                   string                 `protobuf:"bytes,10,opt,name=session_group_id,proto3" json:"session_group_id,omitempty"`
-	unknownFields                    protoimpl.UnknownFields
-	sizeCache                        protoimpl.SizeCache
+	// one-time use refresh tokens. reuse of a rotated refresh token revokes the whole token family.
+	RefreshTokenRotation bool `protobuf:"varint,7,opt,name=refresh_token_rotation,proto3" json:"refresh_token_rotation,omitempty"`
+	// sliding expiry since the last use of the refresh token (0: disabled)
+	RefreshTokenIdleTimeoutSeconds int32 `protobuf:"varint,8,opt,name=refresh_token_idle_timeout_seconds,proto3" json:"refresh_token_idle_timeout_seconds,omitempty"`
+	// absolute lifetime of the refresh token family since the first issue (0: disabled)
+	RefreshTokenMaxLifetimeSeconds int32  `protobuf:"varint,9,opt,name=refresh_token_max_lifetime_seconds,proto3" json:"refresh_token_max_lifetime_seconds,omitempty"`
+	SessionGroupId                 string `protobuf:"bytes,10,opt,name=session_group_id,proto3" json:"session_group_id,omitempty"`
+	// signing alg of JWT access tokens (RFC 9068). opaque access tokens are issued if omitted.
+	AccessTokenSignedResponseAlg string `protobuf:"bytes,11,opt,name=access_token_signed_response_alg,proto3" json:"access_token_signed_response_alg,omitempty"`
+	// resource indicators (RFC 8707) the client is allowed to request. the resource parameter is rejected if omitted.
+	AllowedResources []string `protobuf:"bytes,12,rep,name=allowed_resources,proto3" json:"allowed_resources,omitempty"`
+	// first-party (trusted) client. the end-user consent is skipped.
+	FirstParty    bool `protobuf:"varint,13,opt,name=first_party,proto3" json:"first_party,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClientAttribute) Reset() {
@@ -177,6 +189,27 @@ func (x *ClientAttribute) GetJwtResponseLifetimeSeconds() int32 {
 	return 0
 }
 
+func (x *ClientAttribute) GetRefreshTokenRotation() bool {
+	if x != nil {
+		return x.RefreshTokenRotation
+	}
+	return false
+}
+
+func (x *ClientAttribute) GetRefreshTokenIdleTimeoutSeconds() int32 {
+	if x != nil {
+		return x.RefreshTokenIdleTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ClientAttribute) GetRefreshTokenMaxLifetimeSeconds() int32 {
+	if x != nil {
+		return x.RefreshTokenMaxLifetimeSeconds
+	}
+	return 0
+}
+
 func (x *ClientAttribute) GetSessionGroupId() string {
 	if x != nil {
 		return x.SessionGroupId
@@ -184,6 +217,27 @@ func (x *ClientAttribute) GetSessionGroupId() string {
 	return ""
 }
 
+func (x *ClientAttribute) GetAccessTokenSignedResponseAlg() string {
+	if x != nil {
+		return x.AccessTokenSignedResponseAlg
+	}
+	return ""
+}
+
+func (x *ClientAttribute) GetAllowedResources() []string {
+	if x != nil {
+		return x.AllowedResources
+	}
+	return nil
+}
+
+func (x *ClientAttribute) GetFirstParty() bool {
+	if x != nil {
+		return x.FirstParty
+	}
+	return false
+}
+
 type ClientExtensions struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Profile               EnumClientProfile      `protobuf:"varint,1,opt,name=profile,proto3,enum=oppb.v1.EnumClientProfile" json:"profile,omitempty"`
@@ -316,16 +370,22 @@ var File_oppb_v1_client_proto protoreflect.FileDescriptor
 
 const file_oppb_v1_client_proto_rawDesc = "" +
 	"\n" +
-	"\x14oppb/v1/client.proto\x12\aoppb.v1\x1a\x19oppb/v1/client_meta.proto\x1a\x14oppb/v1/common.proto\"\xdd\x03\n" +
+	"\x14oppb/v1/client.proto\x12\aoppb.v1\x1a\x19oppb/v1/client_meta.proto\x1a\x14oppb/v1/common.proto\"\xd1\x06\n" +
 	"\x0fClientAttribute\x12D\n" +
 	"\x1daccess_token_lifetime_seconds\x18\x01 \x01(\x05R\x1daccess_token_lifetime_seconds\x12P\n" +
 	"#authorization_code_lifetime_seconds\x18\x02 \x01(\x05R#authorization_code_lifetime_seconds\x12<\n" +
 	"\x19id_token_lifetime_seconds\x18\x03 \x01(\x05R\x19id_token_lifetime_seconds\x12F\n" +
 	"\x1erefresh_token_lifetime_seconds\x18\x04 \x01(\x05R\x1erefresh_token_lifetime_seconds\x12:\n" +
 	"\x18request_lifetime_seconds\x18\x05 \x01(\x05R\x18request_lifetime_seconds\x12D\n" +
-	"\x1djwt_response_lifetime_seconds\x18\x06 \x01(\x05R\x1djwt_response_lifetime_seconds\x12*\n" +
+	"\x1djwt_response_lifetime_seconds\x18\x06 \x01(\x05R\x1djwt_response_lifetime_seconds\x126\n" +
+	"\x16refresh_token_rotation\x18\a \x01(\bR\x16refresh_token_rotation\x12N\n" +
+	"\"refresh_token_idle_timeout_seconds\x18\b \x01(\x05R\"refresh_token_idle_timeout_seconds\x12N\n" +
+	"\"refresh_token_max_lifetime_seconds\x18\t \x01(\x05R\"refresh_token_max_lifetime_seconds\x12*\n" +
 	"\x10session_group_id\x18\n" +
-	" \x01(\tR\x10session_group_id\"\x82\x01\n" +
+	" \x01(\tR\x10session_group_id\x12J\n" +
+	" access_token_signed_response_alg\x18\v \x01(\tR access_token_signed_response_alg\x12,\n" +
+	"\x11allowed_resources\x18\f \x03(\tR\x11allowed_resources\x12 \n" +
+	"\vfirst_party\x18\r \x01(\bR\vfirst_party\"\x82\x01\n" +
 	"\x10ClientExtensions\x124\n" +
 	"\aprofile\x18\x01 \x01(\x0e2\x1a.oppb.v1.EnumClientProfileR\aprofile\x128\n" +
 	"\x17tls_client_certificates\x18\x02 \x03(\tR\x17tls_client_certificates\"\x85\x02\n" +
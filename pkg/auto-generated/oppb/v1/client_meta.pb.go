@@ -87,8 +87,33 @@ type ClientMeta struct {
 	AuthorizationEncryptedResponseEnc string `protobuf:"bytes,135,opt,name=authorization_encrypted_response_enc,proto3" json:"authorization_encrypted_response_enc,omitempty"`
 	// https://datatracker.ietf.org/doc/html/rfc8705#section-3.4
 	TlsClientCertificateBoundAccessTokens bool `protobuf:"varint,136,opt,name=tls_client_certificate_bound_access_tokens,proto3" json:"tls_client_certificate_bound_access_tokens,omitempty"`
-	unknownFields                         protoimpl.UnknownFields
-	sizeCache                             protoimpl.SizeCache
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-6
+	IntrospectionSignedResponseAlg string `protobuf:"bytes,137,opt,name=introspection_signed_response_alg,proto3" json:"introspection_signed_response_alg,omitempty"`
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRegistration
+	BackchannelLogoutUri             string `protobuf:"bytes,138,opt,name=backchannel_logout_uri,proto3" json:"backchannel_logout_uri,omitempty"`
+	BackchannelLogoutSessionRequired bool   `protobuf:"varint,139,opt,name=backchannel_logout_session_required,proto3" json:"backchannel_logout_session_required,omitempty"`
+	// https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPLogout
+	FrontchannelLogoutUri             string `protobuf:"bytes,140,opt,name=frontchannel_logout_uri,proto3" json:"frontchannel_logout_uri,omitempty"`
+	FrontchannelLogoutSessionRequired bool   `protobuf:"varint,141,opt,name=frontchannel_logout_session_required,proto3" json:"frontchannel_logout_session_required,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc7591.html#section-2
+	Scope string `protobuf:"bytes,142,opt,name=scope,proto3" json:"scope,omitempty"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+	BackchannelTokenDeliveryMode               string `protobuf:"bytes,143,opt,name=backchannel_token_delivery_mode,proto3" json:"backchannel_token_delivery_mode,omitempty"`
+	BackchannelClientNotificationEndpoint      string `protobuf:"bytes,144,opt,name=backchannel_client_notification_endpoint,proto3" json:"backchannel_client_notification_endpoint,omitempty"`
+	BackchannelAuthenticationRequestSigningAlg string `protobuf:"bytes,145,opt,name=backchannel_authentication_request_signing_alg,proto3" json:"backchannel_authentication_request_signing_alg,omitempty"`
+	BackchannelUserCodeParameter               bool   `protobuf:"varint,146,opt,name=backchannel_user_code_parameter,proto3" json:"backchannel_user_code_parameter,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1.2
+	TlsClientAuthSubjectDn string `protobuf:"bytes,147,opt,name=tls_client_auth_subject_dn,proto3" json:"tls_client_auth_subject_dn,omitempty"`
+	TlsClientAuthSanDns    string `protobuf:"bytes,148,opt,name=tls_client_auth_san_dns,proto3" json:"tls_client_auth_san_dns,omitempty"`
+	TlsClientAuthSanUri    string `protobuf:"bytes,149,opt,name=tls_client_auth_san_uri,proto3" json:"tls_client_auth_san_uri,omitempty"`
+	TlsClientAuthSanIp     string `protobuf:"bytes,150,opt,name=tls_client_auth_san_ip,proto3" json:"tls_client_auth_san_ip,omitempty"`
+	TlsClientAuthSanEmail  string `protobuf:"bytes,151,opt,name=tls_client_auth_san_email,proto3" json:"tls_client_auth_san_email,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+	DpopBoundAccessTokens bool `protobuf:"varint,152,opt,name=dpop_bound_access_tokens,proto3" json:"dpop_bound_access_tokens,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+	AuthorizationDetailsTypes []string `protobuf:"bytes,153,rep,name=authorization_details_types,proto3" json:"authorization_details_types,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *ClientMeta) Reset() {
@@ -373,6 +398,125 @@ func (x *ClientMeta) GetTlsClientCertificateBoundAccessTokens() bool {
 	return false
 }
 
+func (x *ClientMeta) GetIntrospectionSignedResponseAlg() string {
+	if x != nil {
+		return x.IntrospectionSignedResponseAlg
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetBackchannelLogoutUri() string {
+	if x != nil {
+		return x.BackchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetBackchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.BackchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *ClientMeta) GetFrontchannelLogoutUri() string {
+	if x != nil {
+		return x.FrontchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetFrontchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.FrontchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *ClientMeta) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetBackchannelTokenDeliveryMode() string {
+	if x != nil {
+		return x.BackchannelTokenDeliveryMode
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetBackchannelClientNotificationEndpoint() string {
+	if x != nil {
+		return x.BackchannelClientNotificationEndpoint
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetBackchannelAuthenticationRequestSigningAlg() string {
+	if x != nil {
+		return x.BackchannelAuthenticationRequestSigningAlg
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetBackchannelUserCodeParameter() bool {
+	if x != nil {
+		return x.BackchannelUserCodeParameter
+	}
+	return false
+}
+
+func (x *ClientMeta) GetTlsClientAuthSubjectDn() string {
+	if x != nil {
+		return x.TlsClientAuthSubjectDn
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetTlsClientAuthSanDns() string {
+	if x != nil {
+		return x.TlsClientAuthSanDns
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetTlsClientAuthSanUri() string {
+	if x != nil {
+		return x.TlsClientAuthSanUri
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetTlsClientAuthSanIp() string {
+	if x != nil {
+		return x.TlsClientAuthSanIp
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetTlsClientAuthSanEmail() string {
+	if x != nil {
+		return x.TlsClientAuthSanEmail
+	}
+	return ""
+}
+
+func (x *ClientMeta) GetDpopBoundAccessTokens() bool {
+	if x != nil {
+		return x.DpopBoundAccessTokens
+	}
+	return false
+}
+
+func (x *ClientMeta) GetAuthorizationDetailsTypes() []string {
+	if x != nil {
+		return x.AuthorizationDetailsTypes
+	}
+	return nil
+}
+
 type ClientIdentity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// https://openid.net/specs/openid-connect-registration-1_0.html#RegistrationResponse
@@ -462,7 +606,7 @@ var File_oppb_v1_client_meta_proto protoreflect.FileDescriptor
 
 const file_oppb_v1_client_meta_proto_rawDesc = "" +
 	"\n" +
-	"\x19oppb/v1/client_meta.proto\x12\aoppb.v1\x1a\x12oppb/v1/jwks.proto\"\xca\x0f\n" +
+	"\x19oppb/v1/client_meta.proto\x12\aoppb.v1\x1a\x12oppb/v1/jwks.proto\"\xd7\x18\n" +
 	"\n" +
 	"ClientMeta\x12$\n" +
 	"\rredirect_uris\x18e \x03(\tR\rredirect_uris\x12&\n" +
@@ -504,7 +648,24 @@ const file_oppb_v1_client_meta_proto_rawDesc = "" +
 	"!authorization_signed_response_alg\x18\x85\x01 \x01(\tR!authorization_signed_response_alg\x12S\n" +
 	"$authorization_encrypted_response_alg\x18\x86\x01 \x01(\tR$authorization_encrypted_response_alg\x12S\n" +
 	"$authorization_encrypted_response_enc\x18\x87\x01 \x01(\tR$authorization_encrypted_response_enc\x12_\n" +
-	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\"\xba\x02\n" +
+	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\x12M\n" +
+	"!introspection_signed_response_alg\x18\x89\x01 \x01(\tR!introspection_signed_response_alg\x127\n" +
+	"\x16backchannel_logout_uri\x18\x8a\x01 \x01(\tR\x16backchannel_logout_uri\x12Q\n" +
+	"#backchannel_logout_session_required\x18\x8b\x01 \x01(\bR#backchannel_logout_session_required\x129\n" +
+	"\x17frontchannel_logout_uri\x18\x8c\x01 \x01(\tR\x17frontchannel_logout_uri\x12S\n" +
+	"$frontchannel_logout_session_required\x18\x8d\x01 \x01(\bR$frontchannel_logout_session_required\x12\x15\n" +
+	"\x05scope\x18\x8e\x01 \x01(\tR\x05scope\x12I\n" +
+	"\x1fbackchannel_token_delivery_mode\x18\x8f\x01 \x01(\tR\x1fbackchannel_token_delivery_mode\x12[\n" +
+	"(backchannel_client_notification_endpoint\x18\x90\x01 \x01(\tR(backchannel_client_notification_endpoint\x12g\n" +
+	".backchannel_authentication_request_signing_alg\x18\x91\x01 \x01(\tR.backchannel_authentication_request_signing_alg\x12I\n" +
+	"\x1fbackchannel_user_code_parameter\x18\x92\x01 \x01(\bR\x1fbackchannel_user_code_parameter\x12?\n" +
+	"\x1atls_client_auth_subject_dn\x18\x93\x01 \x01(\tR\x1atls_client_auth_subject_dn\x129\n" +
+	"\x17tls_client_auth_san_dns\x18\x94\x01 \x01(\tR\x17tls_client_auth_san_dns\x129\n" +
+	"\x17tls_client_auth_san_uri\x18\x95\x01 \x01(\tR\x17tls_client_auth_san_uri\x127\n" +
+	"\x16tls_client_auth_san_ip\x18\x96\x01 \x01(\tR\x16tls_client_auth_san_ip\x12=\n" +
+	"\x19tls_client_auth_san_email\x18\x97\x01 \x01(\tR\x19tls_client_auth_san_email\x12;\n" +
+	"\x18dpop_bound_access_tokens\x18\x98\x01 \x01(\bR\x18dpop_bound_access_tokens\x12A\n" +
+	"\x1bauthorization_details_types\x18\x99\x01 \x03(\tR\x1bauthorization_details_types\"\xba\x02\n" +
 	"\x0eClientIdentity\x12\x1c\n" +
 	"\tclient_id\x18\x01 \x01(\tR\tclient_id\x12$\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\rclient_secret\x12<\n" +
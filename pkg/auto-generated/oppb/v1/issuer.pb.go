@@ -112,8 +112,11 @@ func (x *Issuer) GetAttribute() *IssuerAttribute {
 }
 
 type IssuerSecret struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+	// salt of the pairwise subject identifiers
+	PairwiseSalt  string `protobuf:"bytes,2,opt,name=pairwise_salt,proto3" json:"pairwise_salt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -155,6 +158,13 @@ func (x *IssuerSecret) GetPassword() string {
 	return ""
 }
 
+func (x *IssuerSecret) GetPairwiseSalt() string {
+	if x != nil {
+		return x.PairwiseSalt
+	}
+	return ""
+}
+
 type IssuerResources struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	KeyMap        map[string]*KeyRing    `protobuf:"bytes,1,rep,name=key_map,proto3" json:"key_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -252,11 +262,17 @@ func (x *KeyRing) GetReservedKeyIds() []string {
 }
 
 type IssuerAttribute struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memo          string                 `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Memo  string                 `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	Owner string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.2
+	// end-user verification URI of the device authorization grant
+	DeviceVerificationUri string `protobuf:"bytes,10,opt,name=device_verification_uri,proto3" json:"device_verification_uri,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+	// require a server-provided nonce in DPoP proofs
+	DpopNonceRequired bool `protobuf:"varint,11,opt,name=dpop_nonce_required,proto3" json:"dpop_nonce_required,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *IssuerAttribute) Reset() {
@@ -303,6 +319,20 @@ func (x *IssuerAttribute) GetOwner() string {
 	return ""
 }
 
+func (x *IssuerAttribute) GetDeviceVerificationUri() string {
+	if x != nil {
+		return x.DeviceVerificationUri
+	}
+	return ""
+}
+
+func (x *IssuerAttribute) GetDpopNonceRequired() bool {
+	if x != nil {
+		return x.DpopNonceRequired
+	}
+	return false
+}
+
 var File_oppb_v1_issuer_proto protoreflect.FileDescriptor
 
 const file_oppb_v1_issuer_proto_rawDesc = "" +
@@ -312,9 +342,10 @@ const file_oppb_v1_issuer_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\v2\x12.oppb.v1.CommonKeyR\x03key\x12'\n" +
 	"\x04meta\x18\x02 \x01(\v2\x13.oppb.v1.IssuerMetaR\x04meta\x12-\n" +
 	"\x06secret\x18\x03 \x01(\v2\x15.oppb.v1.IssuerSecretR\x06secret\x126\n" +
-	"\tattribute\x18\x04 \x01(\v2\x18.oppb.v1.IssuerAttributeR\tattribute\"*\n" +
+	"\tattribute\x18\x04 \x01(\v2\x18.oppb.v1.IssuerAttributeR\tattribute\"P\n" +
 	"\fIssuerSecret\x12\x1a\n" +
-	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x9e\x01\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12$\n" +
+	"\rpairwise_salt\x18\x02 \x01(\tR\rpairwise_salt\"\x9e\x01\n" +
 	"\x0fIssuerResources\x12>\n" +
 	"\akey_map\x18\x01 \x03(\v2$.oppb.v1.IssuerResources.KeyMapEntryR\akey_map\x1aK\n" +
 	"\vKeyMapEntry\x12\x10\n" +
@@ -322,10 +353,13 @@ const file_oppb_v1_issuer_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x10.oppb.v1.KeyRingR\x05value:\x028\x01\"]\n" +
 	"\aKeyRing\x12&\n" +
 	"\x0ecurrent_key_id\x18\x01 \x01(\tR\x0ecurrent_key_id\x12*\n" +
-	"\x10reserved_key_ids\x18\x02 \x03(\tR\x10reserved_key_ids\";\n" +
+	"\x10reserved_key_ids\x18\x02 \x03(\tR\x10reserved_key_ids\"\xa7\x01\n" +
 	"\x0fIssuerAttribute\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x14\n" +
-	"\x05owner\x18\x02 \x01(\tR\x05ownerB\x91\x01\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x128\n" +
+	"\x17device_verification_uri\x18\n" +
+	" \x01(\tR\x17device_verification_uri\x120\n" +
+	"\x13dpop_nonce_required\x18\v \x01(\bR\x13dpop_nonce_requiredB\x91\x01\n" +
 	"\vcom.oppb.v1B\vIssuerProtoP\x01Z8github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1;oppb\xa2\x02\x03OXX\xaa\x02\aOppb.V1\xca\x02\aOppb\\V1\xe2\x02\x13Oppb\\V1\\GPBMetadata\xea\x02\bOppb::V1b\x06proto3"
 
 var (
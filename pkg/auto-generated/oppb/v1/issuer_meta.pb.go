@@ -109,6 +109,8 @@ type IssuerMeta struct {
 	RequireRequestUriRegistration             bool     `protobuf:"varint,49,opt,name=require_request_uri_registration,proto3" json:"require_request_uri_registration,omitempty"`
 	// https://openid.net/specs/openid-connect-rpinitiated-1_0.html#OPMetadata
 	EndSessionEndpoint string `protobuf:"bytes,50,opt,name=end_session_endpoint,proto3" json:"end_session_endpoint,omitempty"`
+	// https://openid.net/specs/openid-connect-session-1_0.html#OPMetadata
+	CheckSessionIframe string `protobuf:"bytes,51,opt,name=check_session_iframe,proto3" json:"check_session_iframe,omitempty"`
 	// https://www.rfc-editor.org/rfc/rfc9126.html#section-5
 	PushedAuthorizationRequestEndpoint string `protobuf:"bytes,60,opt,name=pushed_authorization_request_endpoint,proto3" json:"pushed_authorization_request_endpoint,omitempty"`
 	RequirePushedAuthorizationRequests bool   `protobuf:"varint,61,opt,name=require_pushed_authorization_requests,proto3" json:"require_pushed_authorization_requests,omitempty"`
@@ -126,8 +128,21 @@ type IssuerMeta struct {
 	FrontchannelLogoutSessionSupported bool `protobuf:"varint,101,opt,name=frontchannel_logout_session_supported,proto3" json:"frontchannel_logout_session_supported,omitempty"`
 	// https://datatracker.ietf.org/doc/html/rfc8705#section-3.3
 	TlsClientCertificateBoundAccessTokens bool `protobuf:"varint,110,opt,name=tls_client_certificate_bound_access_tokens,proto3" json:"tls_client_certificate_bound_access_tokens,omitempty"`
-	unknownFields                         protoimpl.UnknownFields
-	sizeCache                             protoimpl.SizeCache
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-7
+	IntrospectionSigningAlgValuesSupported []string `protobuf:"bytes,120,rep,name=introspection_signing_alg_values_supported,proto3" json:"introspection_signing_alg_values_supported,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8628.html#section-4
+	DeviceAuthorizationEndpoint string `protobuf:"bytes,130,opt,name=device_authorization_endpoint,proto3" json:"device_authorization_endpoint,omitempty"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+	BackchannelAuthenticationEndpoint                         string   `protobuf:"bytes,140,opt,name=backchannel_authentication_endpoint,proto3" json:"backchannel_authentication_endpoint,omitempty"`
+	BackchannelTokenDeliveryModesSupported                    []string `protobuf:"bytes,141,rep,name=backchannel_token_delivery_modes_supported,proto3" json:"backchannel_token_delivery_modes_supported,omitempty"`
+	BackchannelAuthenticationRequestSigningAlgValuesSupported []string `protobuf:"bytes,142,rep,name=backchannel_authentication_request_signing_alg_values_supported,proto3" json:"backchannel_authentication_request_signing_alg_values_supported,omitempty"`
+	BackchannelUserCodeParameterSupported                     bool     `protobuf:"varint,143,opt,name=backchannel_user_code_parameter_supported,proto3" json:"backchannel_user_code_parameter_supported,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.1
+	DpopSigningAlgValuesSupported []string `protobuf:"bytes,150,rep,name=dpop_signing_alg_values_supported,proto3" json:"dpop_signing_alg_values_supported,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+	AuthorizationDetailsTypesSupported []string `protobuf:"bytes,151,rep,name=authorization_details_types_supported,proto3" json:"authorization_details_types_supported,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
 }
 
 func (x *IssuerMeta) Reset() {
@@ -461,6 +476,13 @@ func (x *IssuerMeta) GetEndSessionEndpoint() string {
 	return ""
 }
 
+func (x *IssuerMeta) GetCheckSessionIframe() string {
+	if x != nil {
+		return x.CheckSessionIframe
+	}
+	return ""
+}
+
 func (x *IssuerMeta) GetPushedAuthorizationRequestEndpoint() string {
 	if x != nil {
 		return x.PushedAuthorizationRequestEndpoint
@@ -538,11 +560,67 @@ func (x *IssuerMeta) GetTlsClientCertificateBoundAccessTokens() bool {
 	return false
 }
 
+func (x *IssuerMeta) GetIntrospectionSigningAlgValuesSupported() []string {
+	if x != nil {
+		return x.IntrospectionSigningAlgValuesSupported
+	}
+	return nil
+}
+
+func (x *IssuerMeta) GetDeviceAuthorizationEndpoint() string {
+	if x != nil {
+		return x.DeviceAuthorizationEndpoint
+	}
+	return ""
+}
+
+func (x *IssuerMeta) GetBackchannelAuthenticationEndpoint() string {
+	if x != nil {
+		return x.BackchannelAuthenticationEndpoint
+	}
+	return ""
+}
+
+func (x *IssuerMeta) GetBackchannelTokenDeliveryModesSupported() []string {
+	if x != nil {
+		return x.BackchannelTokenDeliveryModesSupported
+	}
+	return nil
+}
+
+func (x *IssuerMeta) GetBackchannelAuthenticationRequestSigningAlgValuesSupported() []string {
+	if x != nil {
+		return x.BackchannelAuthenticationRequestSigningAlgValuesSupported
+	}
+	return nil
+}
+
+func (x *IssuerMeta) GetBackchannelUserCodeParameterSupported() bool {
+	if x != nil {
+		return x.BackchannelUserCodeParameterSupported
+	}
+	return false
+}
+
+func (x *IssuerMeta) GetDpopSigningAlgValuesSupported() []string {
+	if x != nil {
+		return x.DpopSigningAlgValuesSupported
+	}
+	return nil
+}
+
+func (x *IssuerMeta) GetAuthorizationDetailsTypesSupported() []string {
+	if x != nil {
+		return x.AuthorizationDetailsTypesSupported
+	}
+	return nil
+}
+
 var File_oppb_v1_issuer_meta_proto protoreflect.FileDescriptor
 
 const file_oppb_v1_issuer_meta_proto_rawDesc = "" +
 	"\n" +
-	"\x19oppb/v1/issuer_meta.proto\x12\aoppb.v1\"\xf4\x1e\n" +
+	"\x19oppb/v1/issuer_meta.proto\x12\aoppb.v1\"\x94%\n" +
 	"\n" +
 	"IssuerMeta\x12\x16\n" +
 	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x126\n" +
@@ -590,7 +668,8 @@ const file_oppb_v1_issuer_meta_proto_rawDesc = "" +
 	"\x1brequest_parameter_supported\x18/ \x01(\bR\x1brequest_parameter_supported\x12H\n" +
 	"\x1frequest_uri_parameter_supported\x180 \x01(\bR\x1frequest_uri_parameter_supported\x12J\n" +
 	" require_request_uri_registration\x181 \x01(\bR require_request_uri_registration\x122\n" +
-	"\x14end_session_endpoint\x182 \x01(\tR\x14end_session_endpoint\x12T\n" +
+	"\x14end_session_endpoint\x182 \x01(\tR\x14end_session_endpoint\x122\n" +
+	"\x14check_session_iframe\x183 \x01(\tR\x14check_session_iframe\x12T\n" +
 	"%pushed_authorization_request_endpoint\x18< \x01(\tR%pushed_authorization_request_endpoint\x12T\n" +
 	"%require_pushed_authorization_requests\x18= \x01(\bR%require_pushed_authorization_requests\x12^\n" +
 	"*authorization_signing_alg_values_supported\x18F \x03(\tR*authorization_signing_alg_values_supported\x12d\n" +
@@ -601,7 +680,15 @@ const file_oppb_v1_issuer_meta_proto_rawDesc = "" +
 	"$backchannel_logout_session_supported\x18[ \x01(\bR$backchannel_logout_session_supported\x12D\n" +
 	"\x1dfrontchannel_logout_supported\x18d \x01(\bR\x1dfrontchannel_logout_supported\x12T\n" +
 	"%frontchannel_logout_session_supported\x18e \x01(\bR%frontchannel_logout_session_supported\x12^\n" +
-	"*tls_client_certificate_bound_access_tokens\x18n \x01(\bR*tls_client_certificate_bound_access_tokensB\x95\x01\n" +
+	"*tls_client_certificate_bound_access_tokens\x18n \x01(\bR*tls_client_certificate_bound_access_tokens\x12^\n" +
+	"*introspection_signing_alg_values_supported\x18x \x03(\tR*introspection_signing_alg_values_supported\x12E\n" +
+	"\x1ddevice_authorization_endpoint\x18\x82\x01 \x01(\tR\x1ddevice_authorization_endpoint\x12Q\n" +
+	"#backchannel_authentication_endpoint\x18\x8c\x01 \x01(\tR#backchannel_authentication_endpoint\x12_\n" +
+	"*backchannel_token_delivery_modes_supported\x18\x8d\x01 \x03(\tR*backchannel_token_delivery_modes_supported\x12\x89\x01\n" +
+	"?backchannel_authentication_request_signing_alg_values_supported\x18\x8e\x01 \x03(\tR?backchannel_authentication_request_signing_alg_values_supported\x12]\n" +
+	")backchannel_user_code_parameter_supported\x18\x8f\x01 \x01(\bR)backchannel_user_code_parameter_supported\x12M\n" +
+	"!dpop_signing_alg_values_supported\x18\x96\x01 \x03(\tR!dpop_signing_alg_values_supported\x12U\n" +
+	"%authorization_details_types_supported\x18\x97\x01 \x03(\tR%authorization_details_types_supportedB\x95\x01\n" +
 	"\vcom.oppb.v1B\x0fIssuerMetaProtoP\x01Z8github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1;oppb\xa2\x02\x03OXX\xaa\x02\aOppb.V1\xca\x02\aOppb\\V1\xe2\x02\x13Oppb\\V1\\GPBMetadata\xea\x02\bOppb::V1b\x06proto3"
 
 var (
@@ -91,6 +91,36 @@ const (
 	// ProviderServiceRegistrationGetProcedure is the fully-qualified name of the ProviderService's
 	// RegistrationGet RPC.
 	ProviderServiceRegistrationGetProcedure = "/oppb.v1.ProviderService/RegistrationGet"
+	// ProviderServiceRevocationProcedure is the fully-qualified name of the ProviderService's
+	// Revocation RPC.
+	ProviderServiceRevocationProcedure = "/oppb.v1.ProviderService/Revocation"
+	// ProviderServiceIntrospectionProcedure is the fully-qualified name of the ProviderService's
+	// Introspection RPC.
+	ProviderServiceIntrospectionProcedure = "/oppb.v1.ProviderService/Introspection"
+	// ProviderServiceEndSessionProcedure is the fully-qualified name of the ProviderService's
+	// EndSession RPC.
+	ProviderServiceEndSessionProcedure = "/oppb.v1.ProviderService/EndSession"
+	// ProviderServiceEndSessionConfirmProcedure is the fully-qualified name of the ProviderService's
+	// EndSessionConfirm RPC.
+	ProviderServiceEndSessionConfirmProcedure = "/oppb.v1.ProviderService/EndSessionConfirm"
+	// ProviderServiceCheckSessionIframeProcedure is the fully-qualified name of the ProviderService's
+	// CheckSessionIframe RPC.
+	ProviderServiceCheckSessionIframeProcedure = "/oppb.v1.ProviderService/CheckSessionIframe"
+	// ProviderServiceDeviceAuthorizationProcedure is the fully-qualified name of the ProviderService's
+	// DeviceAuthorization RPC.
+	ProviderServiceDeviceAuthorizationProcedure = "/oppb.v1.ProviderService/DeviceAuthorization"
+	// ProviderServiceDeviceVerificationProcedure is the fully-qualified name of the ProviderService's
+	// DeviceVerification RPC.
+	ProviderServiceDeviceVerificationProcedure = "/oppb.v1.ProviderService/DeviceVerification"
+	// ProviderServiceBackchannelAuthenticationProcedure is the fully-qualified name of the
+	// ProviderService's BackchannelAuthentication RPC.
+	ProviderServiceBackchannelAuthenticationProcedure = "/oppb.v1.ProviderService/BackchannelAuthentication"
+	// ProviderServiceBackchannelAuthenticationIssueProcedure is the fully-qualified name of the
+	// ProviderService's BackchannelAuthenticationIssue RPC.
+	ProviderServiceBackchannelAuthenticationIssueProcedure = "/oppb.v1.ProviderService/BackchannelAuthenticationIssue"
+	// ProviderServiceBackchannelAuthenticationCancelProcedure is the fully-qualified name of the
+	// ProviderService's BackchannelAuthenticationCancel RPC.
+	ProviderServiceBackchannelAuthenticationCancelProcedure = "/oppb.v1.ProviderService/BackchannelAuthenticationCancel"
 )
 
 // ProviderServiceClient is a client for the oppb.v1.ProviderService service.
@@ -108,6 +138,16 @@ type ProviderServiceClient interface {
 	RegistrationCreate(context.Context, *connect.Request[v1.RegistrationCreateRequest]) (*connect.Response[v1.RegistrationCreateResponse], error)
 	RegistrationDelete(context.Context, *connect.Request[v1.RegistrationDeleteRequest]) (*connect.Response[v1.RegistrationDeleteResponse], error)
 	RegistrationGet(context.Context, *connect.Request[v1.RegistrationGetRequest]) (*connect.Response[v1.RegistrationGetResponse], error)
+	Revocation(context.Context, *connect.Request[v1.RevocationRequest]) (*connect.Response[v1.RevocationResponse], error)
+	Introspection(context.Context, *connect.Request[v1.IntrospectionRequest]) (*connect.Response[v1.IntrospectionResponse], error)
+	EndSession(context.Context, *connect.Request[v1.EndSessionRequest]) (*connect.Response[v1.EndSessionResponse], error)
+	EndSessionConfirm(context.Context, *connect.Request[v1.EndSessionConfirmRequest]) (*connect.Response[v1.EndSessionConfirmResponse], error)
+	CheckSessionIframe(context.Context, *connect.Request[v1.CheckSessionIframeRequest]) (*connect.Response[v1.CheckSessionIframeResponse], error)
+	DeviceAuthorization(context.Context, *connect.Request[v1.DeviceAuthorizationRequest]) (*connect.Response[v1.DeviceAuthorizationResponse], error)
+	DeviceVerification(context.Context, *connect.Request[v1.DeviceVerificationRequest]) (*connect.Response[v1.DeviceVerificationResponse], error)
+	BackchannelAuthentication(context.Context, *connect.Request[v1.BackchannelAuthenticationRequest]) (*connect.Response[v1.BackchannelAuthenticationResponse], error)
+	BackchannelAuthenticationIssue(context.Context, *connect.Request[v1.BackchannelAuthenticationIssueRequest]) (*connect.Response[v1.BackchannelAuthenticationIssueResponse], error)
+	BackchannelAuthenticationCancel(context.Context, *connect.Request[v1.BackchannelAuthenticationCancelRequest]) (*connect.Response[v1.BackchannelAuthenticationCancelResponse], error)
 }
 
 // NewProviderServiceClient constructs a client for the oppb.v1.ProviderService service. By default,
@@ -199,24 +239,94 @@ func NewProviderServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(providerServiceMethods.ByName("RegistrationGet")),
 			connect.WithClientOptions(opts...),
 		),
+		revocation: connect.NewClient[v1.RevocationRequest, v1.RevocationResponse](
+			httpClient,
+			baseURL+ProviderServiceRevocationProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("Revocation")),
+			connect.WithClientOptions(opts...),
+		),
+		introspection: connect.NewClient[v1.IntrospectionRequest, v1.IntrospectionResponse](
+			httpClient,
+			baseURL+ProviderServiceIntrospectionProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("Introspection")),
+			connect.WithClientOptions(opts...),
+		),
+		endSession: connect.NewClient[v1.EndSessionRequest, v1.EndSessionResponse](
+			httpClient,
+			baseURL+ProviderServiceEndSessionProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("EndSession")),
+			connect.WithClientOptions(opts...),
+		),
+		endSessionConfirm: connect.NewClient[v1.EndSessionConfirmRequest, v1.EndSessionConfirmResponse](
+			httpClient,
+			baseURL+ProviderServiceEndSessionConfirmProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("EndSessionConfirm")),
+			connect.WithClientOptions(opts...),
+		),
+		checkSessionIframe: connect.NewClient[v1.CheckSessionIframeRequest, v1.CheckSessionIframeResponse](
+			httpClient,
+			baseURL+ProviderServiceCheckSessionIframeProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("CheckSessionIframe")),
+			connect.WithClientOptions(opts...),
+		),
+		deviceAuthorization: connect.NewClient[v1.DeviceAuthorizationRequest, v1.DeviceAuthorizationResponse](
+			httpClient,
+			baseURL+ProviderServiceDeviceAuthorizationProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("DeviceAuthorization")),
+			connect.WithClientOptions(opts...),
+		),
+		deviceVerification: connect.NewClient[v1.DeviceVerificationRequest, v1.DeviceVerificationResponse](
+			httpClient,
+			baseURL+ProviderServiceDeviceVerificationProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("DeviceVerification")),
+			connect.WithClientOptions(opts...),
+		),
+		backchannelAuthentication: connect.NewClient[v1.BackchannelAuthenticationRequest, v1.BackchannelAuthenticationResponse](
+			httpClient,
+			baseURL+ProviderServiceBackchannelAuthenticationProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("BackchannelAuthentication")),
+			connect.WithClientOptions(opts...),
+		),
+		backchannelAuthenticationIssue: connect.NewClient[v1.BackchannelAuthenticationIssueRequest, v1.BackchannelAuthenticationIssueResponse](
+			httpClient,
+			baseURL+ProviderServiceBackchannelAuthenticationIssueProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("BackchannelAuthenticationIssue")),
+			connect.WithClientOptions(opts...),
+		),
+		backchannelAuthenticationCancel: connect.NewClient[v1.BackchannelAuthenticationCancelRequest, v1.BackchannelAuthenticationCancelResponse](
+			httpClient,
+			baseURL+ProviderServiceBackchannelAuthenticationCancelProcedure,
+			connect.WithSchema(providerServiceMethods.ByName("BackchannelAuthenticationCancel")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // providerServiceClient implements ProviderServiceClient.
 type providerServiceClient struct {
-	discovery           *connect.Client[v1.DiscoveryRequest, v1.DiscoveryResponse]
-	jwks                *connect.Client[v1.JwksRequest, v1.JwksResponse]
-	authorization       *connect.Client[v1.AuthorizationRequest, v1.AuthorizationResponse]
-	authorizationIssue  *connect.Client[v1.AuthorizationIssueRequest, v1.AuthorizationIssueResponse]
-	authorizationCancel *connect.Client[v1.AuthorizationCancelRequest, v1.AuthorizationCancelResponse]
-	startSession        *connect.Client[v1.StartSessionRequest, v1.StartSessionResponse]
-	token               *connect.Client[v1.TokenRequest, v1.TokenResponse]
-	userinfo            *connect.Client[v1.UserinfoRequest, v1.UserinfoResponse]
-	pushedAuthorization *connect.Client[v1.PushedAuthorizationRequest, v1.PushedAuthorizationResponse]
-	request             *connect.Client[v1.RequestRequest, v1.RequestResponse]
-	registrationCreate  *connect.Client[v1.RegistrationCreateRequest, v1.RegistrationCreateResponse]
-	registrationDelete  *connect.Client[v1.RegistrationDeleteRequest, v1.RegistrationDeleteResponse]
-	registrationGet     *connect.Client[v1.RegistrationGetRequest, v1.RegistrationGetResponse]
+	discovery                       *connect.Client[v1.DiscoveryRequest, v1.DiscoveryResponse]
+	jwks                            *connect.Client[v1.JwksRequest, v1.JwksResponse]
+	authorization                   *connect.Client[v1.AuthorizationRequest, v1.AuthorizationResponse]
+	authorizationIssue              *connect.Client[v1.AuthorizationIssueRequest, v1.AuthorizationIssueResponse]
+	authorizationCancel             *connect.Client[v1.AuthorizationCancelRequest, v1.AuthorizationCancelResponse]
+	startSession                    *connect.Client[v1.StartSessionRequest, v1.StartSessionResponse]
+	token                           *connect.Client[v1.TokenRequest, v1.TokenResponse]
+	userinfo                        *connect.Client[v1.UserinfoRequest, v1.UserinfoResponse]
+	pushedAuthorization             *connect.Client[v1.PushedAuthorizationRequest, v1.PushedAuthorizationResponse]
+	request                         *connect.Client[v1.RequestRequest, v1.RequestResponse]
+	registrationCreate              *connect.Client[v1.RegistrationCreateRequest, v1.RegistrationCreateResponse]
+	registrationDelete              *connect.Client[v1.RegistrationDeleteRequest, v1.RegistrationDeleteResponse]
+	registrationGet                 *connect.Client[v1.RegistrationGetRequest, v1.RegistrationGetResponse]
+	revocation                      *connect.Client[v1.RevocationRequest, v1.RevocationResponse]
+	introspection                   *connect.Client[v1.IntrospectionRequest, v1.IntrospectionResponse]
+	endSession                      *connect.Client[v1.EndSessionRequest, v1.EndSessionResponse]
+	endSessionConfirm               *connect.Client[v1.EndSessionConfirmRequest, v1.EndSessionConfirmResponse]
+	checkSessionIframe              *connect.Client[v1.CheckSessionIframeRequest, v1.CheckSessionIframeResponse]
+	deviceAuthorization             *connect.Client[v1.DeviceAuthorizationRequest, v1.DeviceAuthorizationResponse]
+	deviceVerification              *connect.Client[v1.DeviceVerificationRequest, v1.DeviceVerificationResponse]
+	backchannelAuthentication       *connect.Client[v1.BackchannelAuthenticationRequest, v1.BackchannelAuthenticationResponse]
+	backchannelAuthenticationIssue  *connect.Client[v1.BackchannelAuthenticationIssueRequest, v1.BackchannelAuthenticationIssueResponse]
+	backchannelAuthenticationCancel *connect.Client[v1.BackchannelAuthenticationCancelRequest, v1.BackchannelAuthenticationCancelResponse]
 }
 
 // Discovery calls oppb.v1.ProviderService.Discovery.
@@ -284,6 +394,56 @@ func (c *providerServiceClient) RegistrationGet(ctx context.Context, req *connec
 	return c.registrationGet.CallUnary(ctx, req)
 }
 
+// Revocation calls oppb.v1.ProviderService.Revocation.
+func (c *providerServiceClient) Revocation(ctx context.Context, req *connect.Request[v1.RevocationRequest]) (*connect.Response[v1.RevocationResponse], error) {
+	return c.revocation.CallUnary(ctx, req)
+}
+
+// Introspection calls oppb.v1.ProviderService.Introspection.
+func (c *providerServiceClient) Introspection(ctx context.Context, req *connect.Request[v1.IntrospectionRequest]) (*connect.Response[v1.IntrospectionResponse], error) {
+	return c.introspection.CallUnary(ctx, req)
+}
+
+// EndSession calls oppb.v1.ProviderService.EndSession.
+func (c *providerServiceClient) EndSession(ctx context.Context, req *connect.Request[v1.EndSessionRequest]) (*connect.Response[v1.EndSessionResponse], error) {
+	return c.endSession.CallUnary(ctx, req)
+}
+
+// EndSessionConfirm calls oppb.v1.ProviderService.EndSessionConfirm.
+func (c *providerServiceClient) EndSessionConfirm(ctx context.Context, req *connect.Request[v1.EndSessionConfirmRequest]) (*connect.Response[v1.EndSessionConfirmResponse], error) {
+	return c.endSessionConfirm.CallUnary(ctx, req)
+}
+
+// CheckSessionIframe calls oppb.v1.ProviderService.CheckSessionIframe.
+func (c *providerServiceClient) CheckSessionIframe(ctx context.Context, req *connect.Request[v1.CheckSessionIframeRequest]) (*connect.Response[v1.CheckSessionIframeResponse], error) {
+	return c.checkSessionIframe.CallUnary(ctx, req)
+}
+
+// DeviceAuthorization calls oppb.v1.ProviderService.DeviceAuthorization.
+func (c *providerServiceClient) DeviceAuthorization(ctx context.Context, req *connect.Request[v1.DeviceAuthorizationRequest]) (*connect.Response[v1.DeviceAuthorizationResponse], error) {
+	return c.deviceAuthorization.CallUnary(ctx, req)
+}
+
+// DeviceVerification calls oppb.v1.ProviderService.DeviceVerification.
+func (c *providerServiceClient) DeviceVerification(ctx context.Context, req *connect.Request[v1.DeviceVerificationRequest]) (*connect.Response[v1.DeviceVerificationResponse], error) {
+	return c.deviceVerification.CallUnary(ctx, req)
+}
+
+// BackchannelAuthentication calls oppb.v1.ProviderService.BackchannelAuthentication.
+func (c *providerServiceClient) BackchannelAuthentication(ctx context.Context, req *connect.Request[v1.BackchannelAuthenticationRequest]) (*connect.Response[v1.BackchannelAuthenticationResponse], error) {
+	return c.backchannelAuthentication.CallUnary(ctx, req)
+}
+
+// BackchannelAuthenticationIssue calls oppb.v1.ProviderService.BackchannelAuthenticationIssue.
+func (c *providerServiceClient) BackchannelAuthenticationIssue(ctx context.Context, req *connect.Request[v1.BackchannelAuthenticationIssueRequest]) (*connect.Response[v1.BackchannelAuthenticationIssueResponse], error) {
+	return c.backchannelAuthenticationIssue.CallUnary(ctx, req)
+}
+
+// BackchannelAuthenticationCancel calls oppb.v1.ProviderService.BackchannelAuthenticationCancel.
+func (c *providerServiceClient) BackchannelAuthenticationCancel(ctx context.Context, req *connect.Request[v1.BackchannelAuthenticationCancelRequest]) (*connect.Response[v1.BackchannelAuthenticationCancelResponse], error) {
+	return c.backchannelAuthenticationCancel.CallUnary(ctx, req)
+}
+
 // ProviderServiceHandler is an implementation of the oppb.v1.ProviderService service.
 type ProviderServiceHandler interface {
 	Discovery(context.Context, *connect.Request[v1.DiscoveryRequest]) (*connect.Response[v1.DiscoveryResponse], error)
@@ -299,6 +459,16 @@ type ProviderServiceHandler interface {
 	RegistrationCreate(context.Context, *connect.Request[v1.RegistrationCreateRequest]) (*connect.Response[v1.RegistrationCreateResponse], error)
 	RegistrationDelete(context.Context, *connect.Request[v1.RegistrationDeleteRequest]) (*connect.Response[v1.RegistrationDeleteResponse], error)
 	RegistrationGet(context.Context, *connect.Request[v1.RegistrationGetRequest]) (*connect.Response[v1.RegistrationGetResponse], error)
+	Revocation(context.Context, *connect.Request[v1.RevocationRequest]) (*connect.Response[v1.RevocationResponse], error)
+	Introspection(context.Context, *connect.Request[v1.IntrospectionRequest]) (*connect.Response[v1.IntrospectionResponse], error)
+	EndSession(context.Context, *connect.Request[v1.EndSessionRequest]) (*connect.Response[v1.EndSessionResponse], error)
+	EndSessionConfirm(context.Context, *connect.Request[v1.EndSessionConfirmRequest]) (*connect.Response[v1.EndSessionConfirmResponse], error)
+	CheckSessionIframe(context.Context, *connect.Request[v1.CheckSessionIframeRequest]) (*connect.Response[v1.CheckSessionIframeResponse], error)
+	DeviceAuthorization(context.Context, *connect.Request[v1.DeviceAuthorizationRequest]) (*connect.Response[v1.DeviceAuthorizationResponse], error)
+	DeviceVerification(context.Context, *connect.Request[v1.DeviceVerificationRequest]) (*connect.Response[v1.DeviceVerificationResponse], error)
+	BackchannelAuthentication(context.Context, *connect.Request[v1.BackchannelAuthenticationRequest]) (*connect.Response[v1.BackchannelAuthenticationResponse], error)
+	BackchannelAuthenticationIssue(context.Context, *connect.Request[v1.BackchannelAuthenticationIssueRequest]) (*connect.Response[v1.BackchannelAuthenticationIssueResponse], error)
+	BackchannelAuthenticationCancel(context.Context, *connect.Request[v1.BackchannelAuthenticationCancelRequest]) (*connect.Response[v1.BackchannelAuthenticationCancelResponse], error)
 }
 
 // NewProviderServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -386,6 +556,66 @@ func NewProviderServiceHandler(svc ProviderServiceHandler, opts ...connect.Handl
 		connect.WithSchema(providerServiceMethods.ByName("RegistrationGet")),
 		connect.WithHandlerOptions(opts...),
 	)
+	providerServiceRevocationHandler := connect.NewUnaryHandler(
+		ProviderServiceRevocationProcedure,
+		svc.Revocation,
+		connect.WithSchema(providerServiceMethods.ByName("Revocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceIntrospectionHandler := connect.NewUnaryHandler(
+		ProviderServiceIntrospectionProcedure,
+		svc.Introspection,
+		connect.WithSchema(providerServiceMethods.ByName("Introspection")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceEndSessionHandler := connect.NewUnaryHandler(
+		ProviderServiceEndSessionProcedure,
+		svc.EndSession,
+		connect.WithSchema(providerServiceMethods.ByName("EndSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceEndSessionConfirmHandler := connect.NewUnaryHandler(
+		ProviderServiceEndSessionConfirmProcedure,
+		svc.EndSessionConfirm,
+		connect.WithSchema(providerServiceMethods.ByName("EndSessionConfirm")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceCheckSessionIframeHandler := connect.NewUnaryHandler(
+		ProviderServiceCheckSessionIframeProcedure,
+		svc.CheckSessionIframe,
+		connect.WithSchema(providerServiceMethods.ByName("CheckSessionIframe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceDeviceAuthorizationHandler := connect.NewUnaryHandler(
+		ProviderServiceDeviceAuthorizationProcedure,
+		svc.DeviceAuthorization,
+		connect.WithSchema(providerServiceMethods.ByName("DeviceAuthorization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceDeviceVerificationHandler := connect.NewUnaryHandler(
+		ProviderServiceDeviceVerificationProcedure,
+		svc.DeviceVerification,
+		connect.WithSchema(providerServiceMethods.ByName("DeviceVerification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceBackchannelAuthenticationHandler := connect.NewUnaryHandler(
+		ProviderServiceBackchannelAuthenticationProcedure,
+		svc.BackchannelAuthentication,
+		connect.WithSchema(providerServiceMethods.ByName("BackchannelAuthentication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceBackchannelAuthenticationIssueHandler := connect.NewUnaryHandler(
+		ProviderServiceBackchannelAuthenticationIssueProcedure,
+		svc.BackchannelAuthenticationIssue,
+		connect.WithSchema(providerServiceMethods.ByName("BackchannelAuthenticationIssue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	providerServiceBackchannelAuthenticationCancelHandler := connect.NewUnaryHandler(
+		ProviderServiceBackchannelAuthenticationCancelProcedure,
+		svc.BackchannelAuthenticationCancel,
+		connect.WithSchema(providerServiceMethods.ByName("BackchannelAuthenticationCancel")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/oppb.v1.ProviderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProviderServiceDiscoveryProcedure:
@@ -414,6 +644,26 @@ func NewProviderServiceHandler(svc ProviderServiceHandler, opts ...connect.Handl
 			providerServiceRegistrationDeleteHandler.ServeHTTP(w, r)
 		case ProviderServiceRegistrationGetProcedure:
 			providerServiceRegistrationGetHandler.ServeHTTP(w, r)
+		case ProviderServiceRevocationProcedure:
+			providerServiceRevocationHandler.ServeHTTP(w, r)
+		case ProviderServiceIntrospectionProcedure:
+			providerServiceIntrospectionHandler.ServeHTTP(w, r)
+		case ProviderServiceEndSessionProcedure:
+			providerServiceEndSessionHandler.ServeHTTP(w, r)
+		case ProviderServiceEndSessionConfirmProcedure:
+			providerServiceEndSessionConfirmHandler.ServeHTTP(w, r)
+		case ProviderServiceCheckSessionIframeProcedure:
+			providerServiceCheckSessionIframeHandler.ServeHTTP(w, r)
+		case ProviderServiceDeviceAuthorizationProcedure:
+			providerServiceDeviceAuthorizationHandler.ServeHTTP(w, r)
+		case ProviderServiceDeviceVerificationProcedure:
+			providerServiceDeviceVerificationHandler.ServeHTTP(w, r)
+		case ProviderServiceBackchannelAuthenticationProcedure:
+			providerServiceBackchannelAuthenticationHandler.ServeHTTP(w, r)
+		case ProviderServiceBackchannelAuthenticationIssueProcedure:
+			providerServiceBackchannelAuthenticationIssueHandler.ServeHTTP(w, r)
+		case ProviderServiceBackchannelAuthenticationCancelProcedure:
+			providerServiceBackchannelAuthenticationCancelHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -474,3 +724,43 @@ func (UnimplementedProviderServiceHandler) RegistrationDelete(context.Context, *
 func (UnimplementedProviderServiceHandler) RegistrationGet(context.Context, *connect.Request[v1.RegistrationGetRequest]) (*connect.Response[v1.RegistrationGetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.RegistrationGet is not implemented"))
 }
+
+func (UnimplementedProviderServiceHandler) Revocation(context.Context, *connect.Request[v1.RevocationRequest]) (*connect.Response[v1.RevocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.Revocation is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) Introspection(context.Context, *connect.Request[v1.IntrospectionRequest]) (*connect.Response[v1.IntrospectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.Introspection is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) EndSession(context.Context, *connect.Request[v1.EndSessionRequest]) (*connect.Response[v1.EndSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.EndSession is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) EndSessionConfirm(context.Context, *connect.Request[v1.EndSessionConfirmRequest]) (*connect.Response[v1.EndSessionConfirmResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.EndSessionConfirm is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) CheckSessionIframe(context.Context, *connect.Request[v1.CheckSessionIframeRequest]) (*connect.Response[v1.CheckSessionIframeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.CheckSessionIframe is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) DeviceAuthorization(context.Context, *connect.Request[v1.DeviceAuthorizationRequest]) (*connect.Response[v1.DeviceAuthorizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.DeviceAuthorization is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) DeviceVerification(context.Context, *connect.Request[v1.DeviceVerificationRequest]) (*connect.Response[v1.DeviceVerificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.DeviceVerification is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) BackchannelAuthentication(context.Context, *connect.Request[v1.BackchannelAuthenticationRequest]) (*connect.Response[v1.BackchannelAuthenticationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.BackchannelAuthentication is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) BackchannelAuthenticationIssue(context.Context, *connect.Request[v1.BackchannelAuthenticationIssueRequest]) (*connect.Response[v1.BackchannelAuthenticationIssueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.BackchannelAuthenticationIssue is not implemented"))
+}
+
+func (UnimplementedProviderServiceHandler) BackchannelAuthenticationCancel(context.Context, *connect.Request[v1.BackchannelAuthenticationCancelRequest]) (*connect.Response[v1.BackchannelAuthenticationCancelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oppb.v1.ProviderService.BackchannelAuthenticationCancel is not implemented"))
+}
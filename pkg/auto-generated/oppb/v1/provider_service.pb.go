@@ -203,31 +203,26 @@ func (x *JwksResponse) GetKeys() []*Jwk {
 	return nil
 }
 
-type AuthorizationRequest struct {
+type CheckSessionIframeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      map[string]string      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	Form          string                 `protobuf:"bytes,5,opt,name=form,proto3" json:"form,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationRequest) Reset() {
-	*x = AuthorizationRequest{}
+func (x *CheckSessionIframeRequest) Reset() {
+	*x = CheckSessionIframeRequest{}
 	mi := &file_oppb_v1_provider_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationRequest) String() string {
+func (x *CheckSessionIframeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationRequest) ProtoMessage() {}
+func (*CheckSessionIframeRequest) ProtoMessage() {}
 
-func (x *AuthorizationRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckSessionIframeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_oppb_v1_provider_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -239,78 +234,84 @@ func (x *AuthorizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationRequest.ProtoReflect.Descriptor instead.
-func (*AuthorizationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckSessionIframeRequest.ProtoReflect.Descriptor instead.
+func (*CheckSessionIframeRequest) Descriptor() ([]byte, []int) {
 	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AuthorizationRequest) GetSessions() map[string]string {
-	if x != nil {
-		return x.Sessions
-	}
-	return nil
+type CheckSessionIframeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationRequest) GetContentType() string {
-	if x != nil {
-		return x.ContentType
-	}
-	return ""
+func (x *CheckSessionIframeResponse) Reset() {
+	*x = CheckSessionIframeResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
+func (x *CheckSessionIframeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AuthorizationRequest) GetUrl() string {
+func (*CheckSessionIframeResponse) ProtoMessage() {}
+
+func (x *CheckSessionIframeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[5]
 	if x != nil {
-		return x.Url
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AuthorizationRequest) GetForm() string {
+// Deprecated: Use CheckSessionIframeResponse.ProtoReflect.Descriptor instead.
+func (*CheckSessionIframeResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckSessionIframeResponse) GetContent() string {
 	if x != nil {
-		return x.Form
+		return x.Content
 	}
 	return ""
 }
 
-type AuthorizationResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to AuthorizationResponseOneof:
-	//
-	//	*AuthorizationResponse_Fail
-	//	*AuthorizationResponse_Login
-	//	*AuthorizationResponse_Issue
-	//	*AuthorizationResponse_Redirect
-	//	*AuthorizationResponse_Html
-	AuthorizationResponseOneof isAuthorizationResponse_AuthorizationResponseOneof `protobuf_oneof:"authorization_response_oneof"`
-	Params                     *AuthorizationParameters                           `protobuf:"bytes,10,opt,name=params,proto3" json:"params,omitempty"`
-	Client                     *ClientMeta                                        `protobuf:"bytes,11,opt,name=client,proto3" json:"client,omitempty"`
-	ClientAttribute            *ClientAttribute                                   `protobuf:"bytes,12,opt,name=client_attribute,json=clientAttribute,proto3" json:"client_attribute,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+type DeviceAuthorizationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// tls_client_certificate was presented in the TLS handshake with the SDK,
+	// in which the client proved possession of the private key
+	TlsClientCertificateFromHandshake bool `protobuf:"varint,6,opt,name=tls_client_certificate_from_handshake,json=tlsClientCertificateFromHandshake,proto3" json:"tls_client_certificate_from_handshake,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
 }
 
-func (x *AuthorizationResponse) Reset() {
-	*x = AuthorizationResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[5]
+func (x *DeviceAuthorizationRequest) Reset() {
+	*x = DeviceAuthorizationRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationResponse) String() string {
+func (x *DeviceAuthorizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationResponse) ProtoMessage() {}
+func (*DeviceAuthorizationRequest) ProtoMessage() {}
 
-func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[5]
+func (x *DeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,143 +322,159 @@ func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*DeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AuthorizationResponse) GetAuthorizationResponseOneof() isAuthorizationResponse_AuthorizationResponseOneof {
+func (x *DeviceAuthorizationRequest) GetBasicAuth() *BasicAuth {
 	if x != nil {
-		return x.AuthorizationResponseOneof
+		return x.BasicAuth
 	}
 	return nil
 }
 
-func (x *AuthorizationResponse) GetFail() *AuthorizationFailResponse {
+func (x *DeviceAuthorizationRequest) GetContentType() string {
 	if x != nil {
-		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Fail); ok {
-			return x.Fail
-		}
+		return x.ContentType
 	}
-	return nil
+	return ""
 }
 
-func (x *AuthorizationResponse) GetLogin() *AuthorizationNextActionLogin {
+func (x *DeviceAuthorizationRequest) GetMethod() string {
 	if x != nil {
-		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Login); ok {
-			return x.Login
-		}
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *AuthorizationResponse) GetIssue() *AuthorizationNextActionIssue {
+func (x *DeviceAuthorizationRequest) GetForm() string {
 	if x != nil {
-		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Issue); ok {
-			return x.Issue
-		}
+		return x.Form
 	}
-	return nil
+	return ""
 }
 
-func (x *AuthorizationResponse) GetRedirect() *AuthorizationRedirectResponse {
+func (x *DeviceAuthorizationRequest) GetTlsClientCertificate() string {
 	if x != nil {
-		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Redirect); ok {
-			return x.Redirect
-		}
+		return x.TlsClientCertificate
 	}
-	return nil
+	return ""
 }
 
-func (x *AuthorizationResponse) GetHtml() *AuthorizationHtmlResponse {
+func (x *DeviceAuthorizationRequest) GetTlsClientCertificateFromHandshake() bool {
 	if x != nil {
-		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Html); ok {
-			return x.Html
-		}
+		return x.TlsClientCertificateFromHandshake
 	}
-	return nil
+	return false
 }
 
-func (x *AuthorizationResponse) GetParams() *AuthorizationParameters {
+type DeviceAuthorizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to DeviceAuthorizationResponseOneof:
+	//
+	//	*DeviceAuthorizationResponse_Success
+	//	*DeviceAuthorizationResponse_Fail
+	DeviceAuthorizationResponseOneof isDeviceAuthorizationResponse_DeviceAuthorizationResponseOneof `protobuf_oneof:"device_authorization_response_oneof"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *DeviceAuthorizationResponse) Reset() {
+	*x = DeviceAuthorizationResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *DeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[7]
 	if x != nil {
-		return x.Params
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AuthorizationResponse) GetClient() *ClientMeta {
+// Deprecated: Use DeviceAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*DeviceAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeviceAuthorizationResponse) GetDeviceAuthorizationResponseOneof() isDeviceAuthorizationResponse_DeviceAuthorizationResponseOneof {
 	if x != nil {
-		return x.Client
+		return x.DeviceAuthorizationResponseOneof
 	}
 	return nil
 }
 
-func (x *AuthorizationResponse) GetClientAttribute() *ClientAttribute {
+func (x *DeviceAuthorizationResponse) GetSuccess() *DeviceAuthorizationSuccessResponse {
 	if x != nil {
-		return x.ClientAttribute
+		if x, ok := x.DeviceAuthorizationResponseOneof.(*DeviceAuthorizationResponse_Success); ok {
+			return x.Success
+		}
 	}
 	return nil
 }
 
-type isAuthorizationResponse_AuthorizationResponseOneof interface {
-	isAuthorizationResponse_AuthorizationResponseOneof()
+func (x *DeviceAuthorizationResponse) GetFail() *TokenFailResponse {
+	if x != nil {
+		if x, ok := x.DeviceAuthorizationResponseOneof.(*DeviceAuthorizationResponse_Fail); ok {
+			return x.Fail
+		}
+	}
+	return nil
 }
 
-type AuthorizationResponse_Fail struct {
-	Fail *AuthorizationFailResponse `protobuf:"bytes,1,opt,name=fail,proto3,oneof"`
+type isDeviceAuthorizationResponse_DeviceAuthorizationResponseOneof interface {
+	isDeviceAuthorizationResponse_DeviceAuthorizationResponseOneof()
 }
 
-type AuthorizationResponse_Login struct {
-	Login *AuthorizationNextActionLogin `protobuf:"bytes,2,opt,name=login,proto3,oneof"`
+type DeviceAuthorizationResponse_Success struct {
+	Success *DeviceAuthorizationSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
 }
 
-type AuthorizationResponse_Issue struct {
-	Issue *AuthorizationNextActionIssue `protobuf:"bytes,3,opt,name=issue,proto3,oneof"`
+type DeviceAuthorizationResponse_Fail struct {
+	Fail *TokenFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
 }
 
-type AuthorizationResponse_Redirect struct {
-	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,4,opt,name=redirect,proto3,oneof"`
+func (*DeviceAuthorizationResponse_Success) isDeviceAuthorizationResponse_DeviceAuthorizationResponseOneof() {
 }
 
-type AuthorizationResponse_Html struct {
-	Html *AuthorizationHtmlResponse `protobuf:"bytes,5,opt,name=html,proto3,oneof"`
+func (*DeviceAuthorizationResponse_Fail) isDeviceAuthorizationResponse_DeviceAuthorizationResponseOneof() {
 }
 
-func (*AuthorizationResponse_Fail) isAuthorizationResponse_AuthorizationResponseOneof() {}
-
-func (*AuthorizationResponse_Login) isAuthorizationResponse_AuthorizationResponseOneof() {}
-
-func (*AuthorizationResponse_Issue) isAuthorizationResponse_AuthorizationResponseOneof() {}
-
-func (*AuthorizationResponse_Redirect) isAuthorizationResponse_AuthorizationResponseOneof() {}
-
-func (*AuthorizationResponse_Html) isAuthorizationResponse_AuthorizationResponseOneof() {}
-
-type AuthorizationIssueRequest struct {
+type DeviceVerificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	Claims        string                 `protobuf:"bytes,4,opt,name=claims,proto3" json:"claims,omitempty"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationIssueRequest) Reset() {
-	*x = AuthorizationIssueRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[6]
+func (x *DeviceVerificationRequest) Reset() {
+	*x = DeviceVerificationRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationIssueRequest) String() string {
+func (x *DeviceVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationIssueRequest) ProtoMessage() {}
+func (*DeviceVerificationRequest) ProtoMessage() {}
 
-func (x *AuthorizationIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[6]
+func (x *DeviceVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,65 +485,44 @@ func (x *AuthorizationIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationIssueRequest.ProtoReflect.Descriptor instead.
-func (*AuthorizationIssueRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *AuthorizationIssueRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *AuthorizationIssueRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *AuthorizationIssueRequest) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
+// Deprecated: Use DeviceVerificationRequest.ProtoReflect.Descriptor instead.
+func (*DeviceVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AuthorizationIssueRequest) GetClaims() string {
+func (x *DeviceVerificationRequest) GetUserCode() string {
 	if x != nil {
-		return x.Claims
+		return x.UserCode
 	}
 	return ""
 }
 
-type AuthorizationIssueResponse struct {
+type DeviceVerificationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to AuthorizationIssueResponseOneof:
+	// Types that are valid to be assigned to DeviceVerificationResponseOneof:
 	//
-	//	*AuthorizationIssueResponse_Redirect
-	//	*AuthorizationIssueResponse_Html
-	AuthorizationIssueResponseOneof isAuthorizationIssueResponse_AuthorizationIssueResponseOneof `protobuf_oneof:"authorization_issue_response_oneof"`
+	//	*DeviceVerificationResponse_Html
+	//	*DeviceVerificationResponse_Login
+	DeviceVerificationResponseOneof isDeviceVerificationResponse_DeviceVerificationResponseOneof `protobuf_oneof:"device_verification_response_oneof"`
 	unknownFields                   protoimpl.UnknownFields
 	sizeCache                       protoimpl.SizeCache
 }
 
-func (x *AuthorizationIssueResponse) Reset() {
-	*x = AuthorizationIssueResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[7]
+func (x *DeviceVerificationResponse) Reset() {
+	*x = DeviceVerificationResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationIssueResponse) String() string {
+func (x *DeviceVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationIssueResponse) ProtoMessage() {}
+func (*DeviceVerificationResponse) ProtoMessage() {}
 
-func (x *AuthorizationIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[7]
+func (x *DeviceVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,76 +533,83 @@ func (x *AuthorizationIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationIssueResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationIssueResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeviceVerificationResponse.ProtoReflect.Descriptor instead.
+func (*DeviceVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AuthorizationIssueResponse) GetAuthorizationIssueResponseOneof() isAuthorizationIssueResponse_AuthorizationIssueResponseOneof {
+func (x *DeviceVerificationResponse) GetDeviceVerificationResponseOneof() isDeviceVerificationResponse_DeviceVerificationResponseOneof {
 	if x != nil {
-		return x.AuthorizationIssueResponseOneof
+		return x.DeviceVerificationResponseOneof
 	}
 	return nil
 }
 
-func (x *AuthorizationIssueResponse) GetRedirect() *AuthorizationRedirectResponse {
+func (x *DeviceVerificationResponse) GetHtml() *AuthorizationHtmlResponse {
 	if x != nil {
-		if x, ok := x.AuthorizationIssueResponseOneof.(*AuthorizationIssueResponse_Redirect); ok {
-			return x.Redirect
+		if x, ok := x.DeviceVerificationResponseOneof.(*DeviceVerificationResponse_Html); ok {
+			return x.Html
 		}
 	}
 	return nil
 }
 
-func (x *AuthorizationIssueResponse) GetHtml() *AuthorizationHtmlResponse {
+func (x *DeviceVerificationResponse) GetLogin() *AuthorizationNextActionLogin {
 	if x != nil {
-		if x, ok := x.AuthorizationIssueResponseOneof.(*AuthorizationIssueResponse_Html); ok {
-			return x.Html
+		if x, ok := x.DeviceVerificationResponseOneof.(*DeviceVerificationResponse_Login); ok {
+			return x.Login
 		}
 	}
 	return nil
 }
 
-type isAuthorizationIssueResponse_AuthorizationIssueResponseOneof interface {
-	isAuthorizationIssueResponse_AuthorizationIssueResponseOneof()
+type isDeviceVerificationResponse_DeviceVerificationResponseOneof interface {
+	isDeviceVerificationResponse_DeviceVerificationResponseOneof()
 }
 
-type AuthorizationIssueResponse_Redirect struct {
-	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,1,opt,name=redirect,proto3,oneof"`
+type DeviceVerificationResponse_Html struct {
+	Html *AuthorizationHtmlResponse `protobuf:"bytes,1,opt,name=html,proto3,oneof"`
 }
 
-type AuthorizationIssueResponse_Html struct {
-	Html *AuthorizationHtmlResponse `protobuf:"bytes,2,opt,name=html,proto3,oneof"`
+type DeviceVerificationResponse_Login struct {
+	Login *AuthorizationNextActionLogin `protobuf:"bytes,2,opt,name=login,proto3,oneof"`
 }
 
-func (*AuthorizationIssueResponse_Redirect) isAuthorizationIssueResponse_AuthorizationIssueResponseOneof() {
+func (*DeviceVerificationResponse_Html) isDeviceVerificationResponse_DeviceVerificationResponseOneof() {
 }
 
-func (*AuthorizationIssueResponse_Html) isAuthorizationIssueResponse_AuthorizationIssueResponseOneof() {
+func (*DeviceVerificationResponse_Login) isDeviceVerificationResponse_DeviceVerificationResponseOneof() {
 }
 
-type AuthorizationCancelRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BackchannelAuthenticationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// tls_client_certificate was presented in the TLS handshake with the SDK,
+	// in which the client proved possession of the private key
+	TlsClientCertificateFromHandshake bool `protobuf:"varint,6,opt,name=tls_client_certificate_from_handshake,json=tlsClientCertificateFromHandshake,proto3" json:"tls_client_certificate_from_handshake,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
 }
 
-func (x *AuthorizationCancelRequest) Reset() {
-	*x = AuthorizationCancelRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[8]
+func (x *BackchannelAuthenticationRequest) Reset() {
+	*x = BackchannelAuthenticationRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationCancelRequest) String() string {
+func (x *BackchannelAuthenticationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationCancelRequest) ProtoMessage() {}
+func (*BackchannelAuthenticationRequest) ProtoMessage() {}
 
-func (x *AuthorizationCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[8]
+func (x *BackchannelAuthenticationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,44 +620,81 @@ func (x *AuthorizationCancelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationCancelRequest.ProtoReflect.Descriptor instead.
-func (*AuthorizationCancelRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BackchannelAuthenticationRequest.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AuthorizationCancelRequest) GetRequestId() string {
+func (x *BackchannelAuthenticationRequest) GetBasicAuth() *BasicAuth {
 	if x != nil {
-		return x.RequestId
+		return x.BasicAuth
+	}
+	return nil
+}
+
+func (x *BackchannelAuthenticationRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-type AuthorizationCancelResponse struct {
+func (x *BackchannelAuthenticationRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationRequest) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationRequest) GetTlsClientCertificateFromHandshake() bool {
+	if x != nil {
+		return x.TlsClientCertificateFromHandshake
+	}
+	return false
+}
+
+type BackchannelAuthenticationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to AuthorizationCancelResponseOneof:
+	// Types that are valid to be assigned to BackchannelAuthenticationResponseOneof:
 	//
-	//	*AuthorizationCancelResponse_Redirect
-	//	*AuthorizationCancelResponse_Html
-	AuthorizationCancelResponseOneof isAuthorizationCancelResponse_AuthorizationCancelResponseOneof `protobuf_oneof:"authorization_cancel_response_oneof"`
-	unknownFields                    protoimpl.UnknownFields
-	sizeCache                        protoimpl.SizeCache
+	//	*BackchannelAuthenticationResponse_Success
+	//	*BackchannelAuthenticationResponse_Fail
+	BackchannelAuthenticationResponseOneof isBackchannelAuthenticationResponse_BackchannelAuthenticationResponseOneof `protobuf_oneof:"backchannel_authentication_response_oneof"`
+	// information used to notify the end-user (set with success)
+	Notify        *BackchannelAuthenticationNotify `protobuf:"bytes,10,opt,name=notify,proto3" json:"notify,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationCancelResponse) Reset() {
-	*x = AuthorizationCancelResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[9]
+func (x *BackchannelAuthenticationResponse) Reset() {
+	*x = BackchannelAuthenticationResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationCancelResponse) String() string {
+func (x *BackchannelAuthenticationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationCancelResponse) ProtoMessage() {}
+func (*BackchannelAuthenticationResponse) ProtoMessage() {}
 
-func (x *AuthorizationCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[9]
+func (x *BackchannelAuthenticationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,77 +705,85 @@ func (x *AuthorizationCancelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationCancelResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationCancelResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BackchannelAuthenticationResponse.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AuthorizationCancelResponse) GetAuthorizationCancelResponseOneof() isAuthorizationCancelResponse_AuthorizationCancelResponseOneof {
+func (x *BackchannelAuthenticationResponse) GetBackchannelAuthenticationResponseOneof() isBackchannelAuthenticationResponse_BackchannelAuthenticationResponseOneof {
 	if x != nil {
-		return x.AuthorizationCancelResponseOneof
+		return x.BackchannelAuthenticationResponseOneof
 	}
 	return nil
 }
 
-func (x *AuthorizationCancelResponse) GetRedirect() *AuthorizationRedirectResponse {
+func (x *BackchannelAuthenticationResponse) GetSuccess() *BackchannelAuthenticationSuccessResponse {
 	if x != nil {
-		if x, ok := x.AuthorizationCancelResponseOneof.(*AuthorizationCancelResponse_Redirect); ok {
-			return x.Redirect
+		if x, ok := x.BackchannelAuthenticationResponseOneof.(*BackchannelAuthenticationResponse_Success); ok {
+			return x.Success
 		}
 	}
 	return nil
 }
 
-func (x *AuthorizationCancelResponse) GetHtml() *AuthorizationHtmlResponse {
+func (x *BackchannelAuthenticationResponse) GetFail() *TokenFailResponse {
 	if x != nil {
-		if x, ok := x.AuthorizationCancelResponseOneof.(*AuthorizationCancelResponse_Html); ok {
-			return x.Html
+		if x, ok := x.BackchannelAuthenticationResponseOneof.(*BackchannelAuthenticationResponse_Fail); ok {
+			return x.Fail
 		}
 	}
 	return nil
 }
 
-type isAuthorizationCancelResponse_AuthorizationCancelResponseOneof interface {
-	isAuthorizationCancelResponse_AuthorizationCancelResponseOneof()
+func (x *BackchannelAuthenticationResponse) GetNotify() *BackchannelAuthenticationNotify {
+	if x != nil {
+		return x.Notify
+	}
+	return nil
 }
 
-type AuthorizationCancelResponse_Redirect struct {
-	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,1,opt,name=redirect,proto3,oneof"`
+type isBackchannelAuthenticationResponse_BackchannelAuthenticationResponseOneof interface {
+	isBackchannelAuthenticationResponse_BackchannelAuthenticationResponseOneof()
 }
 
-type AuthorizationCancelResponse_Html struct {
-	Html *AuthorizationHtmlResponse `protobuf:"bytes,2,opt,name=html,proto3,oneof"`
+type BackchannelAuthenticationResponse_Success struct {
+	Success *BackchannelAuthenticationSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
 }
 
-func (*AuthorizationCancelResponse_Redirect) isAuthorizationCancelResponse_AuthorizationCancelResponseOneof() {
+type BackchannelAuthenticationResponse_Fail struct {
+	Fail *TokenFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
 }
 
-func (*AuthorizationCancelResponse_Html) isAuthorizationCancelResponse_AuthorizationCancelResponseOneof() {
+func (*BackchannelAuthenticationResponse_Success) isBackchannelAuthenticationResponse_BackchannelAuthenticationResponseOneof() {
 }
 
-type StartSessionRequest struct {
+func (*BackchannelAuthenticationResponse_Fail) isBackchannelAuthenticationResponse_BackchannelAuthenticationResponseOneof() {
+}
+
+type BackchannelAuthenticationIssueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	AuthReqId     string                 `protobuf:"bytes,1,opt,name=auth_req_id,json=authReqId,proto3" json:"auth_req_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Claims        string                 `protobuf:"bytes,3,opt,name=claims,proto3" json:"claims,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartSessionRequest) Reset() {
-	*x = StartSessionRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[10]
+func (x *BackchannelAuthenticationIssueRequest) Reset() {
+	*x = BackchannelAuthenticationIssueRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartSessionRequest) String() string {
+func (x *BackchannelAuthenticationIssueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSessionRequest) ProtoMessage() {}
+func (*BackchannelAuthenticationIssueRequest) ProtoMessage() {}
 
-func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[10]
+func (x *BackchannelAuthenticationIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,48 +794,53 @@ func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
-func (*StartSessionRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BackchannelAuthenticationIssueRequest.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationIssueRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StartSessionRequest) GetSubject() string {
+func (x *BackchannelAuthenticationIssueRequest) GetAuthReqId() string {
+	if x != nil {
+		return x.AuthReqId
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationIssueRequest) GetSubject() string {
 	if x != nil {
 		return x.Subject
 	}
 	return ""
 }
 
-func (x *StartSessionRequest) GetRequestId() string {
+func (x *BackchannelAuthenticationIssueRequest) GetClaims() string {
 	if x != nil {
-		return x.RequestId
+		return x.Claims
 	}
 	return ""
 }
 
-type StartSessionResponse struct {
+type BackchannelAuthenticationIssueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartSessionResponse) Reset() {
-	*x = StartSessionResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[11]
+func (x *BackchannelAuthenticationIssueResponse) Reset() {
+	*x = BackchannelAuthenticationIssueResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartSessionResponse) String() string {
+func (x *BackchannelAuthenticationIssueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSessionResponse) ProtoMessage() {}
+func (*BackchannelAuthenticationIssueResponse) ProtoMessage() {}
 
-func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[11]
+func (x *BackchannelAuthenticationIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,51 +851,35 @@ func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
-func (*StartSessionResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *StartSessionResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *StartSessionResponse) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+// Deprecated: Use BackchannelAuthenticationIssueResponse.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationIssueResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{13}
 }
 
-type TokenRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
-	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
-	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type BackchannelAuthenticationCancelRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AuthReqId string                 `protobuf:"bytes,1,opt,name=auth_req_id,json=authReqId,proto3" json:"auth_req_id,omitempty"`
+	// discard the request without notifying the client (e.g. unknown user)
+	Discard       bool `protobuf:"varint,2,opt,name=discard,proto3" json:"discard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenRequest) Reset() {
-	*x = TokenRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[12]
+func (x *BackchannelAuthenticationCancelRequest) Reset() {
+	*x = BackchannelAuthenticationCancelRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenRequest) String() string {
+func (x *BackchannelAuthenticationCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenRequest) ProtoMessage() {}
+func (*BackchannelAuthenticationCancelRequest) ProtoMessage() {}
 
-func (x *TokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[12]
+func (x *BackchannelAuthenticationCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,72 +890,87 @@ func (x *TokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenRequest.ProtoReflect.Descriptor instead.
-func (*TokenRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BackchannelAuthenticationCancelRequest.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationCancelRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TokenRequest) GetBasicAuth() *BasicAuth {
+func (x *BackchannelAuthenticationCancelRequest) GetAuthReqId() string {
 	if x != nil {
-		return x.BasicAuth
+		return x.AuthReqId
 	}
-	return nil
+	return ""
 }
 
-func (x *TokenRequest) GetContentType() string {
+func (x *BackchannelAuthenticationCancelRequest) GetDiscard() bool {
 	if x != nil {
-		return x.ContentType
+		return x.Discard
 	}
-	return ""
+	return false
 }
 
-func (x *TokenRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
+type BackchannelAuthenticationCancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenRequest) GetForm() string {
-	if x != nil {
-		return x.Form
-	}
-	return ""
+func (x *BackchannelAuthenticationCancelResponse) Reset() {
+	*x = BackchannelAuthenticationCancelResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenRequest) GetTlsClientCertificate() string {
+func (x *BackchannelAuthenticationCancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackchannelAuthenticationCancelResponse) ProtoMessage() {}
+
+func (x *BackchannelAuthenticationCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[15]
 	if x != nil {
-		return x.TlsClientCertificate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type TokenResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to TokenResponseOneof:
-	//
-	//	*TokenResponse_Success
-	//	*TokenResponse_Fail
-	TokenResponseOneof isTokenResponse_TokenResponseOneof `protobuf_oneof:"token_response_oneof"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// Deprecated: Use BackchannelAuthenticationCancelResponse.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationCancelResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TokenResponse) Reset() {
-	*x = TokenResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[13]
+type AuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      map[string]string      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Form          string                 `protobuf:"bytes,5,opt,name=form,proto3" json:"form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationRequest) Reset() {
+	*x = AuthorizationRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenResponse) String() string {
+func (x *AuthorizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenResponse) ProtoMessage() {}
+func (*AuthorizationRequest) ProtoMessage() {}
 
-func (x *TokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[13]
+func (x *AuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,78 +981,2201 @@ func (x *TokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
-func (*TokenResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{13}
-}
+// Deprecated: Use AuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{16}
+}
 
-func (x *TokenResponse) GetTokenResponseOneof() isTokenResponse_TokenResponseOneof {
+func (x *AuthorizationRequest) GetSessions() map[string]string {
 	if x != nil {
-		return x.TokenResponseOneof
+		return x.Sessions
 	}
 	return nil
 }
 
-func (x *TokenResponse) GetSuccess() *TokenSuccessResponse {
+func (x *AuthorizationRequest) GetContentType() string {
 	if x != nil {
-		if x, ok := x.TokenResponseOneof.(*TokenResponse_Success); ok {
-			return x.Success
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AuthorizationRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuthorizationRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AuthorizationRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+type AuthorizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to AuthorizationResponseOneof:
+	//
+	//	*AuthorizationResponse_Fail
+	//	*AuthorizationResponse_Login
+	//	*AuthorizationResponse_Issue
+	//	*AuthorizationResponse_Redirect
+	//	*AuthorizationResponse_Html
+	//	*AuthorizationResponse_Consent
+	AuthorizationResponseOneof isAuthorizationResponse_AuthorizationResponseOneof `protobuf_oneof:"authorization_response_oneof"`
+	Params                     *AuthorizationParameters                           `protobuf:"bytes,10,opt,name=params,proto3" json:"params,omitempty"`
+	Client                     *ClientMeta                                        `protobuf:"bytes,11,opt,name=client,proto3" json:"client,omitempty"`
+	ClientAttribute            *ClientAttribute                                   `protobuf:"bytes,12,opt,name=client_attribute,json=clientAttribute,proto3" json:"client_attribute,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AuthorizationResponse) Reset() {
+	*x = AuthorizationResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationResponse) ProtoMessage() {}
+
+func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuthorizationResponse) GetAuthorizationResponseOneof() isAuthorizationResponse_AuthorizationResponseOneof {
+	if x != nil {
+		return x.AuthorizationResponseOneof
 	}
 	return nil
 }
 
-func (x *TokenResponse) GetFail() *TokenFailResponse {
+func (x *AuthorizationResponse) GetFail() *AuthorizationFailResponse {
 	if x != nil {
-		if x, ok := x.TokenResponseOneof.(*TokenResponse_Fail); ok {
+		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Fail); ok {
 			return x.Fail
 		}
 	}
 	return nil
 }
 
-type isTokenResponse_TokenResponseOneof interface {
-	isTokenResponse_TokenResponseOneof()
+func (x *AuthorizationResponse) GetLogin() *AuthorizationNextActionLogin {
+	if x != nil {
+		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Login); ok {
+			return x.Login
+		}
+	}
+	return nil
 }
 
-type TokenResponse_Success struct {
-	Success *TokenSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+func (x *AuthorizationResponse) GetIssue() *AuthorizationNextActionIssue {
+	if x != nil {
+		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Issue); ok {
+			return x.Issue
+		}
+	}
+	return nil
 }
 
-type TokenResponse_Fail struct {
-	Fail *TokenFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
+func (x *AuthorizationResponse) GetRedirect() *AuthorizationRedirectResponse {
+	if x != nil {
+		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Redirect); ok {
+			return x.Redirect
+		}
+	}
+	return nil
 }
 
-func (*TokenResponse_Success) isTokenResponse_TokenResponseOneof() {}
+func (x *AuthorizationResponse) GetHtml() *AuthorizationHtmlResponse {
+	if x != nil {
+		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Html); ok {
+			return x.Html
+		}
+	}
+	return nil
+}
 
-func (*TokenResponse_Fail) isTokenResponse_TokenResponseOneof() {}
+func (x *AuthorizationResponse) GetConsent() *AuthorizationNextActionConsent {
+	if x != nil {
+		if x, ok := x.AuthorizationResponseOneof.(*AuthorizationResponse_Consent); ok {
+			return x.Consent
+		}
+	}
+	return nil
+}
+
+func (x *AuthorizationResponse) GetParams() *AuthorizationParameters {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *AuthorizationResponse) GetClient() *ClientMeta {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *AuthorizationResponse) GetClientAttribute() *ClientAttribute {
+	if x != nil {
+		return x.ClientAttribute
+	}
+	return nil
+}
+
+type isAuthorizationResponse_AuthorizationResponseOneof interface {
+	isAuthorizationResponse_AuthorizationResponseOneof()
+}
+
+type AuthorizationResponse_Fail struct {
+	Fail *AuthorizationFailResponse `protobuf:"bytes,1,opt,name=fail,proto3,oneof"`
+}
+
+type AuthorizationResponse_Login struct {
+	Login *AuthorizationNextActionLogin `protobuf:"bytes,2,opt,name=login,proto3,oneof"`
+}
+
+type AuthorizationResponse_Issue struct {
+	Issue *AuthorizationNextActionIssue `protobuf:"bytes,3,opt,name=issue,proto3,oneof"`
+}
+
+type AuthorizationResponse_Redirect struct {
+	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,4,opt,name=redirect,proto3,oneof"`
+}
+
+type AuthorizationResponse_Html struct {
+	Html *AuthorizationHtmlResponse `protobuf:"bytes,5,opt,name=html,proto3,oneof"`
+}
+
+type AuthorizationResponse_Consent struct {
+	Consent *AuthorizationNextActionConsent `protobuf:"bytes,6,opt,name=consent,proto3,oneof"`
+}
+
+func (*AuthorizationResponse_Fail) isAuthorizationResponse_AuthorizationResponseOneof() {}
+
+func (*AuthorizationResponse_Login) isAuthorizationResponse_AuthorizationResponseOneof() {}
+
+func (*AuthorizationResponse_Issue) isAuthorizationResponse_AuthorizationResponseOneof() {}
+
+func (*AuthorizationResponse_Redirect) isAuthorizationResponse_AuthorizationResponseOneof() {}
+
+func (*AuthorizationResponse_Html) isAuthorizationResponse_AuthorizationResponseOneof() {}
+
+func (*AuthorizationResponse_Consent) isAuthorizationResponse_AuthorizationResponseOneof() {}
+
+type AuthorizationIssueRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SessionId string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Subject   string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Claims    string                 `protobuf:"bytes,4,opt,name=claims,proto3" json:"claims,omitempty"`
+	// enriched authorization_details (json array). the requested value is used if omitted.
+	AuthorizationDetails string `protobuf:"bytes,5,opt,name=authorization_details,json=authorizationDetails,proto3" json:"authorization_details,omitempty"`
+	// the end-user approved the consent requested with AuthorizationNextActionConsent
+	ConsentGranted bool `protobuf:"varint,6,opt,name=consent_granted,json=consentGranted,proto3" json:"consent_granted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuthorizationIssueRequest) Reset() {
+	*x = AuthorizationIssueRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationIssueRequest) ProtoMessage() {}
+
+func (x *AuthorizationIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationIssueRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizationIssueRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuthorizationIssueRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuthorizationIssueRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AuthorizationIssueRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuthorizationIssueRequest) GetClaims() string {
+	if x != nil {
+		return x.Claims
+	}
+	return ""
+}
+
+func (x *AuthorizationIssueRequest) GetAuthorizationDetails() string {
+	if x != nil {
+		return x.AuthorizationDetails
+	}
+	return ""
+}
+
+func (x *AuthorizationIssueRequest) GetConsentGranted() bool {
+	if x != nil {
+		return x.ConsentGranted
+	}
+	return false
+}
+
+type AuthorizationIssueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to AuthorizationIssueResponseOneof:
+	//
+	//	*AuthorizationIssueResponse_Redirect
+	//	*AuthorizationIssueResponse_Html
+	//	*AuthorizationIssueResponse_Consent
+	AuthorizationIssueResponseOneof isAuthorizationIssueResponse_AuthorizationIssueResponseOneof `protobuf_oneof:"authorization_issue_response_oneof"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *AuthorizationIssueResponse) Reset() {
+	*x = AuthorizationIssueResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationIssueResponse) ProtoMessage() {}
+
+func (x *AuthorizationIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationIssueResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationIssueResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuthorizationIssueResponse) GetAuthorizationIssueResponseOneof() isAuthorizationIssueResponse_AuthorizationIssueResponseOneof {
+	if x != nil {
+		return x.AuthorizationIssueResponseOneof
+	}
+	return nil
+}
+
+func (x *AuthorizationIssueResponse) GetRedirect() *AuthorizationRedirectResponse {
+	if x != nil {
+		if x, ok := x.AuthorizationIssueResponseOneof.(*AuthorizationIssueResponse_Redirect); ok {
+			return x.Redirect
+		}
+	}
+	return nil
+}
+
+func (x *AuthorizationIssueResponse) GetHtml() *AuthorizationHtmlResponse {
+	if x != nil {
+		if x, ok := x.AuthorizationIssueResponseOneof.(*AuthorizationIssueResponse_Html); ok {
+			return x.Html
+		}
+	}
+	return nil
+}
+
+func (x *AuthorizationIssueResponse) GetConsent() *AuthorizationNextActionConsent {
+	if x != nil {
+		if x, ok := x.AuthorizationIssueResponseOneof.(*AuthorizationIssueResponse_Consent); ok {
+			return x.Consent
+		}
+	}
+	return nil
+}
+
+type isAuthorizationIssueResponse_AuthorizationIssueResponseOneof interface {
+	isAuthorizationIssueResponse_AuthorizationIssueResponseOneof()
+}
+
+type AuthorizationIssueResponse_Redirect struct {
+	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,1,opt,name=redirect,proto3,oneof"`
+}
+
+type AuthorizationIssueResponse_Html struct {
+	Html *AuthorizationHtmlResponse `protobuf:"bytes,2,opt,name=html,proto3,oneof"`
+}
+
+type AuthorizationIssueResponse_Consent struct {
+	Consent *AuthorizationNextActionConsent `protobuf:"bytes,3,opt,name=consent,proto3,oneof"`
+}
+
+func (*AuthorizationIssueResponse_Redirect) isAuthorizationIssueResponse_AuthorizationIssueResponseOneof() {
+}
+
+func (*AuthorizationIssueResponse_Html) isAuthorizationIssueResponse_AuthorizationIssueResponseOneof() {
+}
+
+func (*AuthorizationIssueResponse_Consent) isAuthorizationIssueResponse_AuthorizationIssueResponseOneof() {
+}
+
+type AuthorizationCancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationCancelRequest) Reset() {
+	*x = AuthorizationCancelRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationCancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationCancelRequest) ProtoMessage() {}
+
+func (x *AuthorizationCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationCancelRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizationCancelRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuthorizationCancelRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AuthorizationCancelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to AuthorizationCancelResponseOneof:
+	//
+	//	*AuthorizationCancelResponse_Redirect
+	//	*AuthorizationCancelResponse_Html
+	AuthorizationCancelResponseOneof isAuthorizationCancelResponse_AuthorizationCancelResponseOneof `protobuf_oneof:"authorization_cancel_response_oneof"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *AuthorizationCancelResponse) Reset() {
+	*x = AuthorizationCancelResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationCancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationCancelResponse) ProtoMessage() {}
+
+func (x *AuthorizationCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationCancelResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationCancelResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuthorizationCancelResponse) GetAuthorizationCancelResponseOneof() isAuthorizationCancelResponse_AuthorizationCancelResponseOneof {
+	if x != nil {
+		return x.AuthorizationCancelResponseOneof
+	}
+	return nil
+}
+
+func (x *AuthorizationCancelResponse) GetRedirect() *AuthorizationRedirectResponse {
+	if x != nil {
+		if x, ok := x.AuthorizationCancelResponseOneof.(*AuthorizationCancelResponse_Redirect); ok {
+			return x.Redirect
+		}
+	}
+	return nil
+}
+
+func (x *AuthorizationCancelResponse) GetHtml() *AuthorizationHtmlResponse {
+	if x != nil {
+		if x, ok := x.AuthorizationCancelResponseOneof.(*AuthorizationCancelResponse_Html); ok {
+			return x.Html
+		}
+	}
+	return nil
+}
+
+type isAuthorizationCancelResponse_AuthorizationCancelResponseOneof interface {
+	isAuthorizationCancelResponse_AuthorizationCancelResponseOneof()
+}
+
+type AuthorizationCancelResponse_Redirect struct {
+	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,1,opt,name=redirect,proto3,oneof"`
+}
+
+type AuthorizationCancelResponse_Html struct {
+	Html *AuthorizationHtmlResponse `protobuf:"bytes,2,opt,name=html,proto3,oneof"`
+}
+
+func (*AuthorizationCancelResponse_Redirect) isAuthorizationCancelResponse_AuthorizationCancelResponseOneof() {
+}
+
+func (*AuthorizationCancelResponse_Html) isAuthorizationCancelResponse_AuthorizationCancelResponseOneof() {
+}
+
+type StartSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSessionRequest) Reset() {
+	*x = StartSessionRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionRequest) ProtoMessage() {}
+
+func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StartSessionRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type StartSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// https://openid.net/specs/openid-connect-session-1_0.html#OPiframe
+	// JS-readable cookie used by the check_session_iframe
+	BrowserStateName  string `protobuf:"bytes,3,opt,name=browser_state_name,json=browserStateName,proto3" json:"browser_state_name,omitempty"`
+	BrowserStateValue string `protobuf:"bytes,4,opt,name=browser_state_value,json=browserStateValue,proto3" json:"browser_state_value,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartSessionResponse) Reset() {
+	*x = StartSessionResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionResponse) ProtoMessage() {}
+
+func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartSessionResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetBrowserStateName() string {
+	if x != nil {
+		return x.BrowserStateName
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetBrowserStateValue() string {
+	if x != nil {
+		return x.BrowserStateValue
+	}
+	return ""
+}
+
+type TokenRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.1
+	Dpop string `protobuf:"bytes,6,opt,name=dpop,proto3" json:"dpop,omitempty"`
+	// tls_client_certificate was presented in the TLS handshake with the SDK,
+	// in which the client proved possession of the private key
+	TlsClientCertificateFromHandshake bool `protobuf:"varint,7,opt,name=tls_client_certificate_from_handshake,json=tlsClientCertificateFromHandshake,proto3" json:"tls_client_certificate_from_handshake,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *TokenRequest) Reset() {
+	*x = TokenRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRequest) ProtoMessage() {}
+
+func (x *TokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRequest.ProtoReflect.Descriptor instead.
+func (*TokenRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TokenRequest) GetBasicAuth() *BasicAuth {
+	if x != nil {
+		return x.BasicAuth
+	}
+	return nil
+}
+
+func (x *TokenRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetDpop() string {
+	if x != nil {
+		return x.Dpop
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetTlsClientCertificateFromHandshake() bool {
+	if x != nil {
+		return x.TlsClientCertificateFromHandshake
+	}
+	return false
+}
+
+type TokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to TokenResponseOneof:
+	//
+	//	*TokenResponse_Success
+	//	*TokenResponse_Fail
+	TokenResponseOneof isTokenResponse_TokenResponseOneof `protobuf_oneof:"token_response_oneof"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+	DpopNonce     string `protobuf:"bytes,3,opt,name=dpop_nonce,json=dpopNonce,proto3" json:"dpop_nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenResponse) Reset() {
+	*x = TokenResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenResponse) ProtoMessage() {}
+
+func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TokenResponse) GetTokenResponseOneof() isTokenResponse_TokenResponseOneof {
+	if x != nil {
+		return x.TokenResponseOneof
+	}
+	return nil
+}
+
+func (x *TokenResponse) GetSuccess() *TokenSuccessResponse {
+	if x != nil {
+		if x, ok := x.TokenResponseOneof.(*TokenResponse_Success); ok {
+			return x.Success
+		}
+	}
+	return nil
+}
+
+func (x *TokenResponse) GetFail() *TokenFailResponse {
+	if x != nil {
+		if x, ok := x.TokenResponseOneof.(*TokenResponse_Fail); ok {
+			return x.Fail
+		}
+	}
+	return nil
+}
+
+func (x *TokenResponse) GetDpopNonce() string {
+	if x != nil {
+		return x.DpopNonce
+	}
+	return ""
+}
+
+type isTokenResponse_TokenResponseOneof interface {
+	isTokenResponse_TokenResponseOneof()
+}
+
+type TokenResponse_Success struct {
+	Success *TokenSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type TokenResponse_Fail struct {
+	Fail *TokenFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
+}
+
+func (*TokenResponse_Success) isTokenResponse_TokenResponseOneof() {}
+
+func (*TokenResponse_Fail) isTokenResponse_TokenResponseOneof() {}
+
+type UserinfoRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Authorization        string                 `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.1
+	Dpop          string `protobuf:"bytes,6,opt,name=dpop,proto3" json:"dpop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserinfoRequest) Reset() {
+	*x = UserinfoRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserinfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserinfoRequest) ProtoMessage() {}
+
+func (x *UserinfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserinfoRequest.ProtoReflect.Descriptor instead.
+func (*UserinfoRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserinfoRequest) GetAuthorization() string {
+	if x != nil {
+		return x.Authorization
+	}
+	return ""
+}
+
+func (x *UserinfoRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UserinfoRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *UserinfoRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *UserinfoRequest) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *UserinfoRequest) GetDpop() string {
+	if x != nil {
+		return x.Dpop
+	}
+	return ""
+}
+
+type UserinfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Headers       map[string]string      `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserinfoResponse) Reset() {
+	*x = UserinfoResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserinfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserinfoResponse) ProtoMessage() {}
+
+func (x *UserinfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserinfoResponse.ProtoReflect.Descriptor instead.
+func (*UserinfoResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserinfoResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *UserinfoResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UserinfoResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type PushedAuthorizationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.1
+	Dpop string `protobuf:"bytes,6,opt,name=dpop,proto3" json:"dpop,omitempty"`
+	// tls_client_certificate was presented in the TLS handshake with the SDK,
+	// in which the client proved possession of the private key
+	TlsClientCertificateFromHandshake bool `protobuf:"varint,7,opt,name=tls_client_certificate_from_handshake,json=tlsClientCertificateFromHandshake,proto3" json:"tls_client_certificate_from_handshake,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *PushedAuthorizationRequest) Reset() {
+	*x = PushedAuthorizationRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushedAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushedAuthorizationRequest) ProtoMessage() {}
+
+func (x *PushedAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushedAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*PushedAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PushedAuthorizationRequest) GetBasicAuth() *BasicAuth {
+	if x != nil {
+		return x.BasicAuth
+	}
+	return nil
+}
+
+func (x *PushedAuthorizationRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PushedAuthorizationRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PushedAuthorizationRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *PushedAuthorizationRequest) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *PushedAuthorizationRequest) GetDpop() string {
+	if x != nil {
+		return x.Dpop
+	}
+	return ""
+}
+
+func (x *PushedAuthorizationRequest) GetTlsClientCertificateFromHandshake() bool {
+	if x != nil {
+		return x.TlsClientCertificateFromHandshake
+	}
+	return false
+}
+
+type PushedAuthorizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to PushedAuthorizationResponseOneof:
+	//
+	//	*PushedAuthorizationResponse_Success
+	//	*PushedAuthorizationResponse_Fail
+	PushedAuthorizationResponseOneof isPushedAuthorizationResponse_PushedAuthorizationResponseOneof `protobuf_oneof:"pushed_authorization_response_oneof"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+	DpopNonce     string `protobuf:"bytes,3,opt,name=dpop_nonce,json=dpopNonce,proto3" json:"dpop_nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushedAuthorizationResponse) Reset() {
+	*x = PushedAuthorizationResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushedAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushedAuthorizationResponse) ProtoMessage() {}
+
+func (x *PushedAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushedAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*PushedAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PushedAuthorizationResponse) GetPushedAuthorizationResponseOneof() isPushedAuthorizationResponse_PushedAuthorizationResponseOneof {
+	if x != nil {
+		return x.PushedAuthorizationResponseOneof
+	}
+	return nil
+}
+
+func (x *PushedAuthorizationResponse) GetSuccess() *PushedAuthorizationSuccessResponse {
+	if x != nil {
+		if x, ok := x.PushedAuthorizationResponseOneof.(*PushedAuthorizationResponse_Success); ok {
+			return x.Success
+		}
+	}
+	return nil
+}
+
+func (x *PushedAuthorizationResponse) GetFail() *PushedAuthorizationFailResponse {
+	if x != nil {
+		if x, ok := x.PushedAuthorizationResponseOneof.(*PushedAuthorizationResponse_Fail); ok {
+			return x.Fail
+		}
+	}
+	return nil
+}
+
+func (x *PushedAuthorizationResponse) GetDpopNonce() string {
+	if x != nil {
+		return x.DpopNonce
+	}
+	return ""
+}
+
+type isPushedAuthorizationResponse_PushedAuthorizationResponseOneof interface {
+	isPushedAuthorizationResponse_PushedAuthorizationResponseOneof()
+}
+
+type PushedAuthorizationResponse_Success struct {
+	Success *PushedAuthorizationSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type PushedAuthorizationResponse_Fail struct {
+	Fail *PushedAuthorizationFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
+}
+
+func (*PushedAuthorizationResponse_Success) isPushedAuthorizationResponse_PushedAuthorizationResponseOneof() {
+}
+
+func (*PushedAuthorizationResponse_Fail) isPushedAuthorizationResponse_PushedAuthorizationResponseOneof() {
+}
+
+type RequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRequest) Reset() {
+	*x = RequestRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRequest) ProtoMessage() {}
+
+func (x *RequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRequest.ProtoReflect.Descriptor instead.
+func (*RequestRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RequestResponse struct {
+	state      protoimpl.MessageState   `protogen:"open.v1"`
+	Client     *ClientMeta              `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	AuthParams *AuthorizationParameters `protobuf:"bytes,2,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+	// end-user whose consent is requested (set after AuthorizationNextActionConsent)
+	Subject       string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	SessionId     string `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestResponse) Reset() {
+	*x = RequestResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestResponse) ProtoMessage() {}
+
+func (x *RequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestResponse.ProtoReflect.Descriptor instead.
+func (*RequestResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequestResponse) GetClient() *ClientMeta {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *RequestResponse) GetAuthParams() *AuthorizationParameters {
+	if x != nil {
+		return x.AuthParams
+	}
+	return nil
+}
+
+func (x *RequestResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RequestResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevocationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	// tls_client_certificate was presented in the TLS handshake with the SDK,
+	// in which the client proved possession of the private key
+	TlsClientCertificateFromHandshake bool `protobuf:"varint,6,opt,name=tls_client_certificate_from_handshake,json=tlsClientCertificateFromHandshake,proto3" json:"tls_client_certificate_from_handshake,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *RevocationRequest) Reset() {
+	*x = RevocationRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevocationRequest) ProtoMessage() {}
+
+func (x *RevocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevocationRequest.ProtoReflect.Descriptor instead.
+func (*RevocationRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevocationRequest) GetBasicAuth() *BasicAuth {
+	if x != nil {
+		return x.BasicAuth
+	}
+	return nil
+}
+
+func (x *RevocationRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RevocationRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RevocationRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *RevocationRequest) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *RevocationRequest) GetTlsClientCertificateFromHandshake() bool {
+	if x != nil {
+		return x.TlsClientCertificateFromHandshake
+	}
+	return false
+}
+
+type RevocationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to RevocationResponseOneof:
+	//
+	//	*RevocationResponse_Success
+	//	*RevocationResponse_Fail
+	RevocationResponseOneof isRevocationResponse_RevocationResponseOneof `protobuf_oneof:"revocation_response_oneof"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RevocationResponse) Reset() {
+	*x = RevocationResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevocationResponse) ProtoMessage() {}
+
+func (x *RevocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevocationResponse.ProtoReflect.Descriptor instead.
+func (*RevocationResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevocationResponse) GetRevocationResponseOneof() isRevocationResponse_RevocationResponseOneof {
+	if x != nil {
+		return x.RevocationResponseOneof
+	}
+	return nil
+}
+
+func (x *RevocationResponse) GetSuccess() *RevocationSuccessResponse {
+	if x != nil {
+		if x, ok := x.RevocationResponseOneof.(*RevocationResponse_Success); ok {
+			return x.Success
+		}
+	}
+	return nil
+}
+
+func (x *RevocationResponse) GetFail() *RevocationFailResponse {
+	if x != nil {
+		if x, ok := x.RevocationResponseOneof.(*RevocationResponse_Fail); ok {
+			return x.Fail
+		}
+	}
+	return nil
+}
+
+type isRevocationResponse_RevocationResponseOneof interface {
+	isRevocationResponse_RevocationResponseOneof()
+}
+
+type RevocationResponse_Success struct {
+	Success *RevocationSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type RevocationResponse_Fail struct {
+	Fail *RevocationFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
+}
+
+func (*RevocationResponse_Success) isRevocationResponse_RevocationResponseOneof() {}
+
+func (*RevocationResponse_Fail) isRevocationResponse_RevocationResponseOneof() {}
+
+type IntrospectionRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
+	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
+	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+	Accept               string                 `protobuf:"bytes,6,opt,name=accept,proto3" json:"accept,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.1
+	Dpop string `protobuf:"bytes,7,opt,name=dpop,proto3" json:"dpop,omitempty"`
+	// tls_client_certificate was presented in the TLS handshake with the SDK,
+	// in which the client proved possession of the private key
+	TlsClientCertificateFromHandshake bool `protobuf:"varint,8,opt,name=tls_client_certificate_from_handshake,json=tlsClientCertificateFromHandshake,proto3" json:"tls_client_certificate_from_handshake,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *IntrospectionRequest) Reset() {
+	*x = IntrospectionRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectionRequest) ProtoMessage() {}
+
+func (x *IntrospectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectionRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectionRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *IntrospectionRequest) GetBasicAuth() *BasicAuth {
+	if x != nil {
+		return x.BasicAuth
+	}
+	return nil
+}
+
+func (x *IntrospectionRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *IntrospectionRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *IntrospectionRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+func (x *IntrospectionRequest) GetTlsClientCertificate() string {
+	if x != nil {
+		return x.TlsClientCertificate
+	}
+	return ""
+}
+
+func (x *IntrospectionRequest) GetAccept() string {
+	if x != nil {
+		return x.Accept
+	}
+	return ""
+}
+
+func (x *IntrospectionRequest) GetDpop() string {
+	if x != nil {
+		return x.Dpop
+	}
+	return ""
+}
+
+func (x *IntrospectionRequest) GetTlsClientCertificateFromHandshake() bool {
+	if x != nil {
+		return x.TlsClientCertificateFromHandshake
+	}
+	return false
+}
+
+type IntrospectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Headers       map[string]string      `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectionResponse) Reset() {
+	*x = IntrospectionResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectionResponse) ProtoMessage() {}
+
+func (x *IntrospectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectionResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectionResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IntrospectionResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *IntrospectionResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *IntrospectionResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type EndSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      map[string]string      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Form          string                 `protobuf:"bytes,5,opt,name=form,proto3" json:"form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSessionRequest) Reset() {
+	*x = EndSessionRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionRequest) ProtoMessage() {}
+
+func (x *EndSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionRequest.ProtoReflect.Descriptor instead.
+func (*EndSessionRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EndSessionRequest) GetSessions() map[string]string {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *EndSessionRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *EndSessionRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *EndSessionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EndSessionRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+type EndSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to EndSessionResponseOneof:
+	//
+	//	*EndSessionResponse_Fail
+	//	*EndSessionResponse_Confirm
+	EndSessionResponseOneof isEndSessionResponse_EndSessionResponseOneof `protobuf_oneof:"end_session_response_oneof"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EndSessionResponse) Reset() {
+	*x = EndSessionResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionResponse) ProtoMessage() {}
+
+func (x *EndSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionResponse.ProtoReflect.Descriptor instead.
+func (*EndSessionResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EndSessionResponse) GetEndSessionResponseOneof() isEndSessionResponse_EndSessionResponseOneof {
+	if x != nil {
+		return x.EndSessionResponseOneof
+	}
+	return nil
+}
+
+func (x *EndSessionResponse) GetFail() *EndSessionFailResponse {
+	if x != nil {
+		if x, ok := x.EndSessionResponseOneof.(*EndSessionResponse_Fail); ok {
+			return x.Fail
+		}
+	}
+	return nil
+}
+
+func (x *EndSessionResponse) GetConfirm() *EndSessionNextActionConfirm {
+	if x != nil {
+		if x, ok := x.EndSessionResponseOneof.(*EndSessionResponse_Confirm); ok {
+			return x.Confirm
+		}
+	}
+	return nil
+}
+
+type isEndSessionResponse_EndSessionResponseOneof interface {
+	isEndSessionResponse_EndSessionResponseOneof()
+}
+
+type EndSessionResponse_Fail struct {
+	Fail *EndSessionFailResponse `protobuf:"bytes,1,opt,name=fail,proto3,oneof"`
+}
+
+type EndSessionResponse_Confirm struct {
+	Confirm *EndSessionNextActionConfirm `protobuf:"bytes,2,opt,name=confirm,proto3,oneof"`
+}
+
+func (*EndSessionResponse_Fail) isEndSessionResponse_EndSessionResponseOneof() {}
+
+func (*EndSessionResponse_Confirm) isEndSessionResponse_EndSessionResponseOneof() {}
+
+type EndSessionConfirmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogoutId      string                 `protobuf:"bytes,1,opt,name=logout_id,json=logoutId,proto3" json:"logout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSessionConfirmRequest) Reset() {
+	*x = EndSessionConfirmRequest{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionConfirmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionConfirmRequest) ProtoMessage() {}
+
+func (x *EndSessionConfirmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionConfirmRequest.ProtoReflect.Descriptor instead.
+func (*EndSessionConfirmRequest) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EndSessionConfirmRequest) GetLogoutId() string {
+	if x != nil {
+		return x.LogoutId
+	}
+	return ""
+}
+
+type EndSessionConfirmResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to EndSessionConfirmResponseOneof:
+	//
+	//	*EndSessionConfirmResponse_Redirect
+	//	*EndSessionConfirmResponse_Html
+	EndSessionConfirmResponseOneof isEndSessionConfirmResponse_EndSessionConfirmResponseOneof `protobuf_oneof:"end_session_confirm_response_oneof"`
+	// names of the session cookies to be cleared
+	SessionNames             []string                   `protobuf:"bytes,10,rep,name=session_names,json=sessionNames,proto3" json:"session_names,omitempty"`
+	BackchannelLogoutResults []*BackchannelLogoutResult `protobuf:"bytes,11,rep,name=backchannel_logout_results,json=backchannelLogoutResults,proto3" json:"backchannel_logout_results,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *EndSessionConfirmResponse) Reset() {
+	*x = EndSessionConfirmResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionConfirmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionConfirmResponse) ProtoMessage() {}
+
+func (x *EndSessionConfirmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionConfirmResponse.ProtoReflect.Descriptor instead.
+func (*EndSessionConfirmResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EndSessionConfirmResponse) GetEndSessionConfirmResponseOneof() isEndSessionConfirmResponse_EndSessionConfirmResponseOneof {
+	if x != nil {
+		return x.EndSessionConfirmResponseOneof
+	}
+	return nil
+}
+
+func (x *EndSessionConfirmResponse) GetRedirect() *AuthorizationRedirectResponse {
+	if x != nil {
+		if x, ok := x.EndSessionConfirmResponseOneof.(*EndSessionConfirmResponse_Redirect); ok {
+			return x.Redirect
+		}
+	}
+	return nil
+}
+
+func (x *EndSessionConfirmResponse) GetHtml() *AuthorizationHtmlResponse {
+	if x != nil {
+		if x, ok := x.EndSessionConfirmResponseOneof.(*EndSessionConfirmResponse_Html); ok {
+			return x.Html
+		}
+	}
+	return nil
+}
+
+func (x *EndSessionConfirmResponse) GetSessionNames() []string {
+	if x != nil {
+		return x.SessionNames
+	}
+	return nil
+}
+
+func (x *EndSessionConfirmResponse) GetBackchannelLogoutResults() []*BackchannelLogoutResult {
+	if x != nil {
+		return x.BackchannelLogoutResults
+	}
+	return nil
+}
+
+type isEndSessionConfirmResponse_EndSessionConfirmResponseOneof interface {
+	isEndSessionConfirmResponse_EndSessionConfirmResponseOneof()
+}
+
+type EndSessionConfirmResponse_Redirect struct {
+	Redirect *AuthorizationRedirectResponse `protobuf:"bytes,1,opt,name=redirect,proto3,oneof"`
+}
+
+type EndSessionConfirmResponse_Html struct {
+	Html *AuthorizationHtmlResponse `protobuf:"bytes,2,opt,name=html,proto3,oneof"`
+}
+
+func (*EndSessionConfirmResponse_Redirect) isEndSessionConfirmResponse_EndSessionConfirmResponseOneof() {
+}
+
+func (*EndSessionConfirmResponse_Html) isEndSessionConfirmResponse_EndSessionConfirmResponseOneof() {}
+
+type AuthorizationFailResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	StatusCode    int32                       `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         *AuthorizationErrorResponse `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationFailResponse) Reset() {
+	*x = AuthorizationFailResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationFailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationFailResponse) ProtoMessage() {}
+
+func (x *AuthorizationFailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationFailResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationFailResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AuthorizationFailResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *AuthorizationFailResponse) GetError() *AuthorizationErrorResponse {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// https://openid.net/specs/openid-connect-core-1_0.html#AuthError
+type AuthorizationErrorResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Error            string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorDescription string                 `protobuf:"bytes,2,opt,name=error_description,json=errorDescription,proto3" json:"error_description,omitempty"`
+	ErrorUri         string                 `protobuf:"bytes,3,opt,name=error_uri,json=errorUri,proto3" json:"error_uri,omitempty"`
+	State            string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuthorizationErrorResponse) Reset() {
+	*x = AuthorizationErrorResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationErrorResponse) ProtoMessage() {}
+
+func (x *AuthorizationErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationErrorResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationErrorResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AuthorizationErrorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuthorizationErrorResponse) GetErrorDescription() string {
+	if x != nil {
+		return x.ErrorDescription
+	}
+	return ""
+}
+
+func (x *AuthorizationErrorResponse) GetErrorUri() string {
+	if x != nil {
+		return x.ErrorUri
+	}
+	return ""
+}
+
+func (x *AuthorizationErrorResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type AuthorizationNextActionLogin struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	RequestId     string                   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Client        *ClientMeta              `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	AuthParams    *AuthorizationParameters `protobuf:"bytes,3,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationNextActionLogin) Reset() {
+	*x = AuthorizationNextActionLogin{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationNextActionLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationNextActionLogin) ProtoMessage() {}
+
+func (x *AuthorizationNextActionLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationNextActionLogin.ProtoReflect.Descriptor instead.
+func (*AuthorizationNextActionLogin) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuthorizationNextActionLogin) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuthorizationNextActionLogin) GetClient() *ClientMeta {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *AuthorizationNextActionLogin) GetAuthParams() *AuthorizationParameters {
+	if x != nil {
+		return x.AuthParams
+	}
+	return nil
+}
+
+type AuthorizationNextActionIssue struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	RequestId     string                   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SessionId     string                   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Subject       string                   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Client        *ClientMeta              `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	AuthParams    *AuthorizationParameters `protobuf:"bytes,5,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationNextActionIssue) Reset() {
+	*x = AuthorizationNextActionIssue{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationNextActionIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationNextActionIssue) ProtoMessage() {}
+
+func (x *AuthorizationNextActionIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationNextActionIssue.ProtoReflect.Descriptor instead.
+func (*AuthorizationNextActionIssue) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AuthorizationNextActionIssue) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuthorizationNextActionIssue) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AuthorizationNextActionIssue) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuthorizationNextActionIssue) GetClient() *ClientMeta {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *AuthorizationNextActionIssue) GetAuthParams() *AuthorizationParameters {
+	if x != nil {
+		return x.AuthParams
+	}
+	return nil
+}
 
-type UserinfoRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Authorization        string                 `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
-	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type AuthorizationNextActionConsent struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	RequestId     string                   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SessionId     string                   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Subject       string                   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Client        *ClientMeta              `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	AuthParams    *AuthorizationParameters `protobuf:"bytes,5,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserinfoRequest) Reset() {
-	*x = UserinfoRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[14]
+func (x *AuthorizationNextActionConsent) Reset() {
+	*x = AuthorizationNextActionConsent{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserinfoRequest) String() string {
+func (x *AuthorizationNextActionConsent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserinfoRequest) ProtoMessage() {}
+func (*AuthorizationNextActionConsent) ProtoMessage() {}
 
-func (x *UserinfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[14]
+func (x *AuthorizationNextActionConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,70 +3186,68 @@ func (x *UserinfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserinfoRequest.ProtoReflect.Descriptor instead.
-func (*UserinfoRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AuthorizationNextActionConsent.ProtoReflect.Descriptor instead.
+func (*AuthorizationNextActionConsent) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UserinfoRequest) GetAuthorization() string {
+func (x *AuthorizationNextActionConsent) GetRequestId() string {
 	if x != nil {
-		return x.Authorization
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *UserinfoRequest) GetContentType() string {
+func (x *AuthorizationNextActionConsent) GetSessionId() string {
 	if x != nil {
-		return x.ContentType
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *UserinfoRequest) GetMethod() string {
+func (x *AuthorizationNextActionConsent) GetSubject() string {
 	if x != nil {
-		return x.Method
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *UserinfoRequest) GetForm() string {
+func (x *AuthorizationNextActionConsent) GetClient() *ClientMeta {
 	if x != nil {
-		return x.Form
+		return x.Client
 	}
-	return ""
+	return nil
 }
 
-func (x *UserinfoRequest) GetTlsClientCertificate() string {
+func (x *AuthorizationNextActionConsent) GetAuthParams() *AuthorizationParameters {
 	if x != nil {
-		return x.TlsClientCertificate
+		return x.AuthParams
 	}
-	return ""
+	return nil
 }
 
-type UserinfoResponse struct {
+type AuthorizationRedirectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Headers       map[string]string      `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserinfoResponse) Reset() {
-	*x = UserinfoResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[15]
+func (x *AuthorizationRedirectResponse) Reset() {
+	*x = AuthorizationRedirectResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserinfoResponse) String() string {
+func (x *AuthorizationRedirectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserinfoResponse) ProtoMessage() {}
+func (*AuthorizationRedirectResponse) ProtoMessage() {}
 
-func (x *UserinfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[15]
+func (x *AuthorizationRedirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,58 +3258,95 @@ func (x *UserinfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserinfoResponse.ProtoReflect.Descriptor instead.
-func (*UserinfoResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AuthorizationRedirectResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationRedirectResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UserinfoResponse) GetHeaders() map[string]string {
+func (x *AuthorizationRedirectResponse) GetUrl() string {
 	if x != nil {
-		return x.Headers
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *UserinfoResponse) GetStatusCode() int32 {
+type AuthorizationHtmlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationHtmlResponse) Reset() {
+	*x = AuthorizationHtmlResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationHtmlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationHtmlResponse) ProtoMessage() {}
+
+func (x *AuthorizationHtmlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[46]
 	if x != nil {
-		return x.StatusCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserinfoResponse) GetBody() string {
+// Deprecated: Use AuthorizationHtmlResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationHtmlResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AuthorizationHtmlResponse) GetContent() string {
 	if x != nil {
-		return x.Body
+		return x.Content
 	}
 	return ""
 }
 
-type PushedAuthorizationRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	BasicAuth            *BasicAuth             `protobuf:"bytes,1,opt,name=basic_auth,json=basicAuth,proto3" json:"basic_auth,omitempty"`
-	ContentType          string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Form                 string                 `protobuf:"bytes,4,opt,name=form,proto3" json:"form,omitempty"`
-	TlsClientCertificate string                 `protobuf:"bytes,5,opt,name=tls_client_certificate,json=tlsClientCertificate,proto3" json:"tls_client_certificate,omitempty"`
+type TokenSuccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// https://www.rfc-editor.org/rfc/rfc6749.html#section-5.1
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,proto3" json:"access_token,omitempty"`
+	TokenType    string `protobuf:"bytes,2,opt,name=token_type,proto3" json:"token_type,omitempty"`
+	ExpiresIn    int32  `protobuf:"varint,3,opt,name=expires_in,proto3" json:"expires_in,omitempty"`
+	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	Scope        string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	// https://openid.net/specs/openid-connect-core-1_0.html#TokenResponse
+	IdToken string `protobuf:"bytes,6,opt,name=id_token,proto3" json:"id_token,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8693.html#section-2.2.1
+	IssuedTokenType string `protobuf:"bytes,7,opt,name=issued_token_type,proto3" json:"issued_token_type,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-7 (json array)
+	AuthorizationDetails string `protobuf:"bytes,8,opt,name=authorization_details,proto3" json:"authorization_details,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *PushedAuthorizationRequest) Reset() {
-	*x = PushedAuthorizationRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[16]
+func (x *TokenSuccessResponse) Reset() {
+	*x = TokenSuccessResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushedAuthorizationRequest) String() string {
+func (x *TokenSuccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushedAuthorizationRequest) ProtoMessage() {}
+func (*TokenSuccessResponse) ProtoMessage() {}
 
-func (x *PushedAuthorizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[16]
+func (x *TokenSuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,72 +3357,95 @@ func (x *PushedAuthorizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushedAuthorizationRequest.ProtoReflect.Descriptor instead.
-func (*PushedAuthorizationRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TokenSuccessResponse.ProtoReflect.Descriptor instead.
+func (*TokenSuccessResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PushedAuthorizationRequest) GetBasicAuth() *BasicAuth {
+func (x *TokenSuccessResponse) GetAccessToken() string {
 	if x != nil {
-		return x.BasicAuth
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-func (x *PushedAuthorizationRequest) GetContentType() string {
+func (x *TokenSuccessResponse) GetTokenType() string {
 	if x != nil {
-		return x.ContentType
+		return x.TokenType
 	}
 	return ""
 }
 
-func (x *PushedAuthorizationRequest) GetMethod() string {
+func (x *TokenSuccessResponse) GetExpiresIn() int32 {
 	if x != nil {
-		return x.Method
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *TokenSuccessResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *PushedAuthorizationRequest) GetForm() string {
+func (x *TokenSuccessResponse) GetScope() string {
 	if x != nil {
-		return x.Form
+		return x.Scope
 	}
 	return ""
 }
 
-func (x *PushedAuthorizationRequest) GetTlsClientCertificate() string {
+func (x *TokenSuccessResponse) GetIdToken() string {
 	if x != nil {
-		return x.TlsClientCertificate
+		return x.IdToken
 	}
 	return ""
 }
 
-type PushedAuthorizationResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to PushedAuthorizationResponseOneof:
-	//
-	//	*PushedAuthorizationResponse_Success
-	//	*PushedAuthorizationResponse_Fail
-	PushedAuthorizationResponseOneof isPushedAuthorizationResponse_PushedAuthorizationResponseOneof `protobuf_oneof:"pushed_authorization_response_oneof"`
-	unknownFields                    protoimpl.UnknownFields
-	sizeCache                        protoimpl.SizeCache
+func (x *TokenSuccessResponse) GetIssuedTokenType() string {
+	if x != nil {
+		return x.IssuedTokenType
+	}
+	return ""
 }
 
-func (x *PushedAuthorizationResponse) Reset() {
-	*x = PushedAuthorizationResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[17]
+func (x *TokenSuccessResponse) GetAuthorizationDetails() string {
+	if x != nil {
+		return x.AuthorizationDetails
+	}
+	return ""
+}
+
+type DeviceAuthorizationSuccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.2
+	DeviceCode              string `protobuf:"bytes,1,opt,name=device_code,proto3" json:"device_code,omitempty"`
+	UserCode                string `protobuf:"bytes,2,opt,name=user_code,proto3" json:"user_code,omitempty"`
+	VerificationUri         string `protobuf:"bytes,3,opt,name=verification_uri,proto3" json:"verification_uri,omitempty"`
+	VerificationUriComplete string `protobuf:"bytes,4,opt,name=verification_uri_complete,proto3" json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int32  `protobuf:"varint,5,opt,name=expires_in,proto3" json:"expires_in,omitempty"`
+	Interval                int32  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DeviceAuthorizationSuccessResponse) Reset() {
+	*x = DeviceAuthorizationSuccessResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushedAuthorizationResponse) String() string {
+func (x *DeviceAuthorizationSuccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushedAuthorizationResponse) ProtoMessage() {}
+func (*DeviceAuthorizationSuccessResponse) ProtoMessage() {}
 
-func (x *PushedAuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[17]
+func (x *DeviceAuthorizationSuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,121 +3456,78 @@ func (x *PushedAuthorizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushedAuthorizationResponse.ProtoReflect.Descriptor instead.
-func (*PushedAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *PushedAuthorizationResponse) GetPushedAuthorizationResponseOneof() isPushedAuthorizationResponse_PushedAuthorizationResponseOneof {
-	if x != nil {
-		return x.PushedAuthorizationResponseOneof
-	}
-	return nil
+// Deprecated: Use DeviceAuthorizationSuccessResponse.ProtoReflect.Descriptor instead.
+func (*DeviceAuthorizationSuccessResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PushedAuthorizationResponse) GetSuccess() *PushedAuthorizationSuccessResponse {
+func (x *DeviceAuthorizationSuccessResponse) GetDeviceCode() string {
 	if x != nil {
-		if x, ok := x.PushedAuthorizationResponseOneof.(*PushedAuthorizationResponse_Success); ok {
-			return x.Success
-		}
+		return x.DeviceCode
 	}
-	return nil
+	return ""
 }
 
-func (x *PushedAuthorizationResponse) GetFail() *PushedAuthorizationFailResponse {
+func (x *DeviceAuthorizationSuccessResponse) GetUserCode() string {
 	if x != nil {
-		if x, ok := x.PushedAuthorizationResponseOneof.(*PushedAuthorizationResponse_Fail); ok {
-			return x.Fail
-		}
+		return x.UserCode
 	}
-	return nil
-}
-
-type isPushedAuthorizationResponse_PushedAuthorizationResponseOneof interface {
-	isPushedAuthorizationResponse_PushedAuthorizationResponseOneof()
-}
-
-type PushedAuthorizationResponse_Success struct {
-	Success *PushedAuthorizationSuccessResponse `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
-}
-
-type PushedAuthorizationResponse_Fail struct {
-	Fail *PushedAuthorizationFailResponse `protobuf:"bytes,2,opt,name=fail,proto3,oneof"`
-}
-
-func (*PushedAuthorizationResponse_Success) isPushedAuthorizationResponse_PushedAuthorizationResponseOneof() {
-}
-
-func (*PushedAuthorizationResponse_Fail) isPushedAuthorizationResponse_PushedAuthorizationResponseOneof() {
-}
-
-type RequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RequestRequest) Reset() {
-	*x = RequestRequest{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *RequestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DeviceAuthorizationSuccessResponse) GetVerificationUri() string {
+	if x != nil {
+		return x.VerificationUri
+	}
+	return ""
 }
 
-func (*RequestRequest) ProtoMessage() {}
-
-func (x *RequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[18]
+func (x *DeviceAuthorizationSuccessResponse) GetVerificationUriComplete() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.VerificationUriComplete
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RequestRequest.ProtoReflect.Descriptor instead.
-func (*RequestRequest) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{18}
+func (x *DeviceAuthorizationSuccessResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
 }
 
-func (x *RequestRequest) GetRequestId() string {
+func (x *DeviceAuthorizationSuccessResponse) GetInterval() int32 {
 	if x != nil {
-		return x.RequestId
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-type RequestResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Client        *ClientMeta              `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	AuthParams    *AuthorizationParameters `protobuf:"bytes,2,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+type BackchannelAuthenticationSuccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.3
+	AuthReqId     string `protobuf:"bytes,1,opt,name=auth_req_id,proto3" json:"auth_req_id,omitempty"`
+	ExpiresIn     int32  `protobuf:"varint,2,opt,name=expires_in,proto3" json:"expires_in,omitempty"`
+	Interval      int32  `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestResponse) Reset() {
-	*x = RequestResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[19]
+func (x *BackchannelAuthenticationSuccessResponse) Reset() {
+	*x = BackchannelAuthenticationSuccessResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestResponse) String() string {
+func (x *BackchannelAuthenticationSuccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestResponse) ProtoMessage() {}
+func (*BackchannelAuthenticationSuccessResponse) ProtoMessage() {}
 
-func (x *RequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[19]
+func (x *BackchannelAuthenticationSuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,48 +3538,64 @@ func (x *RequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestResponse.ProtoReflect.Descriptor instead.
-func (*RequestResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use BackchannelAuthenticationSuccessResponse.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationSuccessResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *RequestResponse) GetClient() *ClientMeta {
+func (x *BackchannelAuthenticationSuccessResponse) GetAuthReqId() string {
 	if x != nil {
-		return x.Client
+		return x.AuthReqId
 	}
-	return nil
+	return ""
 }
 
-func (x *RequestResponse) GetAuthParams() *AuthorizationParameters {
+func (x *BackchannelAuthenticationSuccessResponse) GetExpiresIn() int32 {
 	if x != nil {
-		return x.AuthParams
+		return x.ExpiresIn
 	}
-	return nil
+	return 0
 }
 
-type AuthorizationFailResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	StatusCode    int32                       `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Error         *AuthorizationErrorResponse `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BackchannelAuthenticationSuccessResponse) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
 }
 
-func (x *AuthorizationFailResponse) Reset() {
-	*x = AuthorizationFailResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[20]
+type BackchannelAuthenticationNotify struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthReqId      string                 `protobuf:"bytes,1,opt,name=auth_req_id,json=authReqId,proto3" json:"auth_req_id,omitempty"`
+	ClientId       string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Client         *ClientMeta            `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Scopes         []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AcrValues      []string               `protobuf:"bytes,5,rep,name=acr_values,json=acrValues,proto3" json:"acr_values,omitempty"`
+	LoginHint      string                 `protobuf:"bytes,6,opt,name=login_hint,json=loginHint,proto3" json:"login_hint,omitempty"`
+	LoginHintToken string                 `protobuf:"bytes,7,opt,name=login_hint_token,json=loginHintToken,proto3" json:"login_hint_token,omitempty"`
+	// subject of id_token_hint
+	Subject        string `protobuf:"bytes,8,opt,name=subject,proto3" json:"subject,omitempty"`
+	BindingMessage string `protobuf:"bytes,9,opt,name=binding_message,json=bindingMessage,proto3" json:"binding_message,omitempty"`
+	UserCode       string `protobuf:"bytes,10,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BackchannelAuthenticationNotify) Reset() {
+	*x = BackchannelAuthenticationNotify{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationFailResponse) String() string {
+func (x *BackchannelAuthenticationNotify) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationFailResponse) ProtoMessage() {}
+func (*BackchannelAuthenticationNotify) ProtoMessage() {}
 
-func (x *AuthorizationFailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[20]
+func (x *BackchannelAuthenticationNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,118 +3606,104 @@ func (x *AuthorizationFailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationFailResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationFailResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use BackchannelAuthenticationNotify.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationNotify) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AuthorizationFailResponse) GetStatusCode() int32 {
+func (x *BackchannelAuthenticationNotify) GetAuthReqId() string {
 	if x != nil {
-		return x.StatusCode
+		return x.AuthReqId
 	}
-	return 0
+	return ""
 }
 
-func (x *AuthorizationFailResponse) GetError() *AuthorizationErrorResponse {
+func (x *BackchannelAuthenticationNotify) GetClientId() string {
 	if x != nil {
-		return x.Error
+		return x.ClientId
 	}
-	return nil
-}
-
-// https://openid.net/specs/openid-connect-core-1_0.html#AuthError
-type AuthorizationErrorResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Error            string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	ErrorDescription string                 `protobuf:"bytes,2,opt,name=error_description,json=errorDescription,proto3" json:"error_description,omitempty"`
-	ErrorUri         string                 `protobuf:"bytes,3,opt,name=error_uri,json=errorUri,proto3" json:"error_uri,omitempty"`
-	State            string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	return ""
 }
 
-func (x *AuthorizationErrorResponse) Reset() {
-	*x = AuthorizationErrorResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BackchannelAuthenticationNotify) GetClient() *ClientMeta {
+	if x != nil {
+		return x.Client
+	}
+	return nil
 }
 
-func (x *AuthorizationErrorResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BackchannelAuthenticationNotify) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
 }
 
-func (*AuthorizationErrorResponse) ProtoMessage() {}
-
-func (x *AuthorizationErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[21]
+func (x *BackchannelAuthenticationNotify) GetAcrValues() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AcrValues
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AuthorizationErrorResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationErrorResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{21}
+func (x *BackchannelAuthenticationNotify) GetLoginHint() string {
+	if x != nil {
+		return x.LoginHint
+	}
+	return ""
 }
 
-func (x *AuthorizationErrorResponse) GetError() string {
+func (x *BackchannelAuthenticationNotify) GetLoginHintToken() string {
 	if x != nil {
-		return x.Error
+		return x.LoginHintToken
 	}
 	return ""
 }
 
-func (x *AuthorizationErrorResponse) GetErrorDescription() string {
+func (x *BackchannelAuthenticationNotify) GetSubject() string {
 	if x != nil {
-		return x.ErrorDescription
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *AuthorizationErrorResponse) GetErrorUri() string {
+func (x *BackchannelAuthenticationNotify) GetBindingMessage() string {
 	if x != nil {
-		return x.ErrorUri
+		return x.BindingMessage
 	}
 	return ""
 }
 
-func (x *AuthorizationErrorResponse) GetState() string {
+func (x *BackchannelAuthenticationNotify) GetUserCode() string {
 	if x != nil {
-		return x.State
+		return x.UserCode
 	}
 	return ""
 }
 
-type AuthorizationNextActionLogin struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	RequestId     string                   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Client        *ClientMeta              `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
-	AuthParams    *AuthorizationParameters `protobuf:"bytes,3,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+type TokenFailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         *OauthError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationNextActionLogin) Reset() {
-	*x = AuthorizationNextActionLogin{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[22]
+func (x *TokenFailResponse) Reset() {
+	*x = TokenFailResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationNextActionLogin) String() string {
+func (x *TokenFailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationNextActionLogin) ProtoMessage() {}
+func (*TokenFailResponse) ProtoMessage() {}
 
-func (x *AuthorizationNextActionLogin) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[22]
+func (x *TokenFailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,58 +3714,49 @@ func (x *AuthorizationNextActionLogin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationNextActionLogin.ProtoReflect.Descriptor instead.
-func (*AuthorizationNextActionLogin) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *AuthorizationNextActionLogin) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
+// Deprecated: Use TokenFailResponse.ProtoReflect.Descriptor instead.
+func (*TokenFailResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *AuthorizationNextActionLogin) GetClient() *ClientMeta {
+func (x *TokenFailResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Client
+		return x.StatusCode
 	}
-	return nil
+	return 0
 }
 
-func (x *AuthorizationNextActionLogin) GetAuthParams() *AuthorizationParameters {
+func (x *TokenFailResponse) GetError() *OauthError {
 	if x != nil {
-		return x.AuthParams
+		return x.Error
 	}
 	return nil
 }
 
-type AuthorizationNextActionIssue struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	RequestId     string                   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	SessionId     string                   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Subject       string                   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	Client        *ClientMeta              `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	AuthParams    *AuthorizationParameters `protobuf:"bytes,5,opt,name=auth_params,json=authParams,proto3" json:"auth_params,omitempty"`
+type PushedAuthorizationSuccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	RequestUri    string                 `protobuf:"bytes,2,opt,name=request_uri,json=requestUri,proto3" json:"request_uri,omitempty"`
+	ExpiresIn     int32                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationNextActionIssue) Reset() {
-	*x = AuthorizationNextActionIssue{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[23]
+func (x *PushedAuthorizationSuccessResponse) Reset() {
+	*x = PushedAuthorizationSuccessResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationNextActionIssue) String() string {
+func (x *PushedAuthorizationSuccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationNextActionIssue) ProtoMessage() {}
+func (*PushedAuthorizationSuccessResponse) ProtoMessage() {}
 
-func (x *AuthorizationNextActionIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[23]
+func (x *PushedAuthorizationSuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,68 +3767,55 @@ func (x *AuthorizationNextActionIssue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationNextActionIssue.ProtoReflect.Descriptor instead.
-func (*AuthorizationNextActionIssue) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *AuthorizationNextActionIssue) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
+// Deprecated: Use PushedAuthorizationSuccessResponse.ProtoReflect.Descriptor instead.
+func (*PushedAuthorizationSuccessResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AuthorizationNextActionIssue) GetSessionId() string {
+func (x *PushedAuthorizationSuccessResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.StatusCode
 	}
-	return ""
+	return 0
 }
 
-func (x *AuthorizationNextActionIssue) GetSubject() string {
+func (x *PushedAuthorizationSuccessResponse) GetRequestUri() string {
 	if x != nil {
-		return x.Subject
+		return x.RequestUri
 	}
 	return ""
 }
 
-func (x *AuthorizationNextActionIssue) GetClient() *ClientMeta {
-	if x != nil {
-		return x.Client
-	}
-	return nil
-}
-
-func (x *AuthorizationNextActionIssue) GetAuthParams() *AuthorizationParameters {
+func (x *PushedAuthorizationSuccessResponse) GetExpiresIn() int32 {
 	if x != nil {
-		return x.AuthParams
+		return x.ExpiresIn
 	}
-	return nil
+	return 0
 }
 
-type AuthorizationRedirectResponse struct {
+type PushedAuthorizationFailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         *OauthError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationRedirectResponse) Reset() {
-	*x = AuthorizationRedirectResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[24]
+func (x *PushedAuthorizationFailResponse) Reset() {
+	*x = PushedAuthorizationFailResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationRedirectResponse) String() string {
+func (x *PushedAuthorizationFailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationRedirectResponse) ProtoMessage() {}
+func (*PushedAuthorizationFailResponse) ProtoMessage() {}
 
-func (x *AuthorizationRedirectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[24]
+func (x *PushedAuthorizationFailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,40 +3826,47 @@ func (x *AuthorizationRedirectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationRedirectResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationRedirectResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PushedAuthorizationFailResponse.ProtoReflect.Descriptor instead.
+func (*PushedAuthorizationFailResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PushedAuthorizationFailResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
 }
 
-func (x *AuthorizationRedirectResponse) GetUrl() string {
+func (x *PushedAuthorizationFailResponse) GetError() *OauthError {
 	if x != nil {
-		return x.Url
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type AuthorizationHtmlResponse struct {
+type RevocationSuccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationHtmlResponse) Reset() {
-	*x = AuthorizationHtmlResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[25]
+func (x *RevocationSuccessResponse) Reset() {
+	*x = RevocationSuccessResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationHtmlResponse) String() string {
+func (x *RevocationSuccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationHtmlResponse) ProtoMessage() {}
+func (*RevocationSuccessResponse) ProtoMessage() {}
 
-func (x *AuthorizationHtmlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[25]
+func (x *RevocationSuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,47 +3877,41 @@ func (x *AuthorizationHtmlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationHtmlResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationHtmlResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RevocationSuccessResponse.ProtoReflect.Descriptor instead.
+func (*RevocationSuccessResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AuthorizationHtmlResponse) GetContent() string {
+func (x *RevocationSuccessResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Content
+		return x.StatusCode
 	}
-	return ""
+	return 0
 }
 
-type TokenSuccessResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// https://www.rfc-editor.org/rfc/rfc6749.html#section-5.1
-	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,proto3" json:"access_token,omitempty"`
-	TokenType    string `protobuf:"bytes,2,opt,name=token_type,proto3" json:"token_type,omitempty"`
-	ExpiresIn    int32  `protobuf:"varint,3,opt,name=expires_in,proto3" json:"expires_in,omitempty"`
-	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
-	Scope        string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
-	// https://openid.net/specs/openid-connect-core-1_0.html#TokenResponse
-	IdToken       string `protobuf:"bytes,6,opt,name=id_token,proto3" json:"id_token,omitempty"`
+type RevocationFailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         *OauthError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenSuccessResponse) Reset() {
-	*x = TokenSuccessResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[26]
+func (x *RevocationFailResponse) Reset() {
+	*x = RevocationFailResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenSuccessResponse) String() string {
+func (x *RevocationFailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenSuccessResponse) ProtoMessage() {}
+func (*RevocationFailResponse) ProtoMessage() {}
 
-func (x *TokenSuccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[26]
+func (x *RevocationFailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,54 +3922,26 @@ func (x *TokenSuccessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenSuccessResponse.ProtoReflect.Descriptor instead.
-func (*TokenSuccessResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *TokenSuccessResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *TokenSuccessResponse) GetTokenType() string {
-	if x != nil {
-		return x.TokenType
-	}
-	return ""
+// Deprecated: Use RevocationFailResponse.ProtoReflect.Descriptor instead.
+func (*RevocationFailResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *TokenSuccessResponse) GetExpiresIn() int32 {
+func (x *RevocationFailResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.ExpiresIn
+		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *TokenSuccessResponse) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
-}
-
-func (x *TokenSuccessResponse) GetScope() string {
-	if x != nil {
-		return x.Scope
-	}
-	return ""
-}
-
-func (x *TokenSuccessResponse) GetIdToken() string {
+func (x *RevocationFailResponse) GetError() *OauthError {
 	if x != nil {
-		return x.IdToken
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type TokenFailResponse struct {
+type EndSessionFailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
 	Error         *OauthError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -1806,21 +3949,21 @@ type TokenFailResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenFailResponse) Reset() {
-	*x = TokenFailResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[27]
+func (x *EndSessionFailResponse) Reset() {
+	*x = EndSessionFailResponse{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenFailResponse) String() string {
+func (x *EndSessionFailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenFailResponse) ProtoMessage() {}
+func (*EndSessionFailResponse) ProtoMessage() {}
 
-func (x *TokenFailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[27]
+func (x *EndSessionFailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,49 +3974,54 @@ func (x *TokenFailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenFailResponse.ProtoReflect.Descriptor instead.
-func (*TokenFailResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use EndSessionFailResponse.ProtoReflect.Descriptor instead.
+func (*EndSessionFailResponse) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *TokenFailResponse) GetStatusCode() int32 {
+func (x *EndSessionFailResponse) GetStatusCode() int32 {
 	if x != nil {
 		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *TokenFailResponse) GetError() *OauthError {
+func (x *EndSessionFailResponse) GetError() *OauthError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type PushedAuthorizationSuccessResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	RequestUri    string                 `protobuf:"bytes,2,opt,name=request_uri,json=requestUri,proto3" json:"request_uri,omitempty"`
-	ExpiresIn     int32                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// https://openid.net/specs/openid-connect-rpinitiated-1_0.html#RPLogout
+type EndSessionNextActionConfirm struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LogoutId              string                 `protobuf:"bytes,1,opt,name=logout_id,json=logoutId,proto3" json:"logout_id,omitempty"`
+	Client                *ClientMeta            `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	ClientId              string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	PostLogoutRedirectUri string                 `protobuf:"bytes,4,opt,name=post_logout_redirect_uri,json=postLogoutRedirectUri,proto3" json:"post_logout_redirect_uri,omitempty"`
+	LogoutHint            string                 `protobuf:"bytes,5,opt,name=logout_hint,json=logoutHint,proto3" json:"logout_hint,omitempty"`
+	UiLocales             []string               `protobuf:"bytes,6,rep,name=ui_locales,json=uiLocales,proto3" json:"ui_locales,omitempty"`
+	Subject               string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *PushedAuthorizationSuccessResponse) Reset() {
-	*x = PushedAuthorizationSuccessResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[28]
+func (x *EndSessionNextActionConfirm) Reset() {
+	*x = EndSessionNextActionConfirm{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushedAuthorizationSuccessResponse) String() string {
+func (x *EndSessionNextActionConfirm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushedAuthorizationSuccessResponse) ProtoMessage() {}
+func (*EndSessionNextActionConfirm) ProtoMessage() {}
 
-func (x *PushedAuthorizationSuccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[28]
+func (x *EndSessionNextActionConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,55 +4032,87 @@ func (x *PushedAuthorizationSuccessResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushedAuthorizationSuccessResponse.ProtoReflect.Descriptor instead.
-func (*PushedAuthorizationSuccessResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use EndSessionNextActionConfirm.ProtoReflect.Descriptor instead.
+func (*EndSessionNextActionConfirm) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *PushedAuthorizationSuccessResponse) GetStatusCode() int32 {
+func (x *EndSessionNextActionConfirm) GetLogoutId() string {
 	if x != nil {
-		return x.StatusCode
+		return x.LogoutId
 	}
-	return 0
+	return ""
 }
 
-func (x *PushedAuthorizationSuccessResponse) GetRequestUri() string {
+func (x *EndSessionNextActionConfirm) GetClient() *ClientMeta {
 	if x != nil {
-		return x.RequestUri
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EndSessionNextActionConfirm) GetClientId() string {
+	if x != nil {
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *PushedAuthorizationSuccessResponse) GetExpiresIn() int32 {
+func (x *EndSessionNextActionConfirm) GetPostLogoutRedirectUri() string {
 	if x != nil {
-		return x.ExpiresIn
+		return x.PostLogoutRedirectUri
 	}
-	return 0
+	return ""
 }
 
-type PushedAuthorizationFailResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Error         *OauthError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EndSessionNextActionConfirm) GetLogoutHint() string {
+	if x != nil {
+		return x.LogoutHint
+	}
+	return ""
 }
 
-func (x *PushedAuthorizationFailResponse) Reset() {
-	*x = PushedAuthorizationFailResponse{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[29]
+func (x *EndSessionNextActionConfirm) GetUiLocales() []string {
+	if x != nil {
+		return x.UiLocales
+	}
+	return nil
+}
+
+func (x *EndSessionNextActionConfirm) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest
+type BackchannelLogoutResult struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ClientId             string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	BackchannelLogoutUri string                 `protobuf:"bytes,2,opt,name=backchannel_logout_uri,json=backchannelLogoutUri,proto3" json:"backchannel_logout_uri,omitempty"`
+	StatusCode           int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error                string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts             int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BackchannelLogoutResult) Reset() {
+	*x = BackchannelLogoutResult{}
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushedAuthorizationFailResponse) String() string {
+func (x *BackchannelLogoutResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushedAuthorizationFailResponse) ProtoMessage() {}
+func (*BackchannelLogoutResult) ProtoMessage() {}
 
-func (x *PushedAuthorizationFailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[29]
+func (x *BackchannelLogoutResult) ProtoReflect() protoreflect.Message {
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1943,23 +4123,44 @@ func (x *PushedAuthorizationFailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushedAuthorizationFailResponse.ProtoReflect.Descriptor instead.
-func (*PushedAuthorizationFailResponse) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use BackchannelLogoutResult.ProtoReflect.Descriptor instead.
+func (*BackchannelLogoutResult) Descriptor() ([]byte, []int) {
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *PushedAuthorizationFailResponse) GetStatusCode() int32 {
+func (x *BackchannelLogoutResult) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *BackchannelLogoutResult) GetBackchannelLogoutUri() string {
+	if x != nil {
+		return x.BackchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *BackchannelLogoutResult) GetStatusCode() int32 {
 	if x != nil {
 		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *PushedAuthorizationFailResponse) GetError() *OauthError {
+func (x *BackchannelLogoutResult) GetError() string {
 	if x != nil {
 		return x.Error
 	}
-	return nil
+	return ""
+}
+
+func (x *BackchannelLogoutResult) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
 type OauthError struct {
@@ -1974,7 +4175,7 @@ type OauthError struct {
 
 func (x *OauthError) Reset() {
 	*x = OauthError{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[30]
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1986,7 +4187,7 @@ func (x *OauthError) String() string {
 func (*OauthError) ProtoMessage() {}
 
 func (x *OauthError) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[30]
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,7 +4200,7 @@ func (x *OauthError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OauthError.ProtoReflect.Descriptor instead.
 func (*OauthError) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{30}
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *OauthError) GetError() string {
@@ -2033,7 +4234,7 @@ type BasicAuth struct {
 
 func (x *BasicAuth) Reset() {
 	*x = BasicAuth{}
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[31]
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2045,7 +4246,7 @@ func (x *BasicAuth) String() string {
 func (*BasicAuth) ProtoMessage() {}
 
 func (x *BasicAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_oppb_v1_provider_service_proto_msgTypes[31]
+	mi := &file_oppb_v1_provider_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,7 +4259,7 @@ func (x *BasicAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicAuth.ProtoReflect.Descriptor instead.
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{31}
+	return file_oppb_v1_provider_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *BasicAuth) GetUsername() string {
@@ -2085,7 +4286,51 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\acontent\x18\x01 \x01(\tR\acontent\"\r\n" +
 	"\vJwksRequest\"0\n" +
 	"\fJwksResponse\x12 \n" +
-	"\x04keys\x18\x01 \x03(\v2\f.oppb.v1.JwkR\x04keys\"\xfd\x01\n" +
+	"\x04keys\x18\x01 \x03(\v2\f.oppb.v1.JwkR\x04keys\"\x1b\n" +
+	"\x19CheckSessionIframeRequest\"6\n" +
+	"\x1aCheckSessionIframeResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\xa6\x02\n" +
+	"\x1aDeviceAuthorizationRequest\x121\n" +
+	"\n" +
+	"basic_auth\x18\x01 \x01(\v2\x12.oppb.v1.BasicAuthR\tbasicAuth\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12P\n" +
+	"%tls_client_certificate_from_handshake\x18\x06 \x01(\bR!tlsClientCertificateFromHandshake\"\xbf\x01\n" +
+	"\x1bDeviceAuthorizationResponse\x12G\n" +
+	"\asuccess\x18\x01 \x01(\v2+.oppb.v1.DeviceAuthorizationSuccessResponseH\x00R\asuccess\x120\n" +
+	"\x04fail\x18\x02 \x01(\v2\x1a.oppb.v1.TokenFailResponseH\x00R\x04failB%\n" +
+	"#device_authorization_response_oneof\"8\n" +
+	"\x19DeviceVerificationRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"\xbb\x01\n" +
+	"\x1aDeviceVerificationResponse\x128\n" +
+	"\x04html\x18\x01 \x01(\v2\".oppb.v1.AuthorizationHtmlResponseH\x00R\x04html\x12=\n" +
+	"\x05login\x18\x02 \x01(\v2%.oppb.v1.AuthorizationNextActionLoginH\x00R\x05loginB$\n" +
+	"\"device_verification_response_oneof\"\xac\x02\n" +
+	" BackchannelAuthenticationRequest\x121\n" +
+	"\n" +
+	"basic_auth\x18\x01 \x01(\v2\x12.oppb.v1.BasicAuthR\tbasicAuth\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12P\n" +
+	"%tls_client_certificate_from_handshake\x18\x06 \x01(\bR!tlsClientCertificateFromHandshake\"\x93\x02\n" +
+	"!BackchannelAuthenticationResponse\x12M\n" +
+	"\asuccess\x18\x01 \x01(\v21.oppb.v1.BackchannelAuthenticationSuccessResponseH\x00R\asuccess\x120\n" +
+	"\x04fail\x18\x02 \x01(\v2\x1a.oppb.v1.TokenFailResponseH\x00R\x04fail\x12@\n" +
+	"\x06notify\x18\n" +
+	" \x01(\v2(.oppb.v1.BackchannelAuthenticationNotifyR\x06notifyB+\n" +
+	")backchannel_authentication_response_oneof\"y\n" +
+	"%BackchannelAuthenticationIssueRequest\x12\x1e\n" +
+	"\vauth_req_id\x18\x01 \x01(\tR\tauthReqId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x16\n" +
+	"\x06claims\x18\x03 \x01(\tR\x06claims\"(\n" +
+	"&BackchannelAuthenticationIssueResponse\"b\n" +
+	"&BackchannelAuthenticationCancelRequest\x12\x1e\n" +
+	"\vauth_req_id\x18\x01 \x01(\tR\tauthReqId\x12\x18\n" +
+	"\adiscard\x18\x02 \x01(\bR\adiscard\")\n" +
+	"'BackchannelAuthenticationCancelResponse\"\xfd\x01\n" +
 	"\x14AuthorizationRequest\x12G\n" +
 	"\bsessions\x18\x01 \x03(\v2+.oppb.v1.AuthorizationRequest.SessionsEntryR\bsessions\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
@@ -2094,28 +4339,32 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\x04form\x18\x05 \x01(\tR\x04form\x1a;\n" +
 	"\rSessionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x04\n" +
 	"\x15AuthorizationResponse\x128\n" +
 	"\x04fail\x18\x01 \x01(\v2\".oppb.v1.AuthorizationFailResponseH\x00R\x04fail\x12=\n" +
 	"\x05login\x18\x02 \x01(\v2%.oppb.v1.AuthorizationNextActionLoginH\x00R\x05login\x12=\n" +
 	"\x05issue\x18\x03 \x01(\v2%.oppb.v1.AuthorizationNextActionIssueH\x00R\x05issue\x12D\n" +
 	"\bredirect\x18\x04 \x01(\v2&.oppb.v1.AuthorizationRedirectResponseH\x00R\bredirect\x128\n" +
-	"\x04html\x18\x05 \x01(\v2\".oppb.v1.AuthorizationHtmlResponseH\x00R\x04html\x128\n" +
+	"\x04html\x18\x05 \x01(\v2\".oppb.v1.AuthorizationHtmlResponseH\x00R\x04html\x12C\n" +
+	"\aconsent\x18\x06 \x01(\v2'.oppb.v1.AuthorizationNextActionConsentH\x00R\aconsent\x128\n" +
 	"\x06params\x18\n" +
 	" \x01(\v2 .oppb.v1.AuthorizationParametersR\x06params\x12+\n" +
 	"\x06client\x18\v \x01(\v2\x13.oppb.v1.ClientMetaR\x06client\x12C\n" +
 	"\x10client_attribute\x18\f \x01(\v2\x18.oppb.v1.ClientAttributeR\x0fclientAttributeB\x1e\n" +
-	"\x1cauthorization_response_oneof\"\x8b\x01\n" +
+	"\x1cauthorization_response_oneof\"\xe9\x01\n" +
 	"\x19AuthorizationIssueRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x18\n" +
 	"\asubject\x18\x03 \x01(\tR\asubject\x12\x16\n" +
-	"\x06claims\x18\x04 \x01(\tR\x06claims\"\xc2\x01\n" +
+	"\x06claims\x18\x04 \x01(\tR\x06claims\x123\n" +
+	"\x15authorization_details\x18\x05 \x01(\tR\x14authorizationDetails\x12'\n" +
+	"\x0fconsent_granted\x18\x06 \x01(\bR\x0econsentGranted\"\x87\x02\n" +
 	"\x1aAuthorizationIssueResponse\x12D\n" +
 	"\bredirect\x18\x01 \x01(\v2&.oppb.v1.AuthorizationRedirectResponseH\x00R\bredirect\x128\n" +
-	"\x04html\x18\x02 \x01(\v2\".oppb.v1.AuthorizationHtmlResponseH\x00R\x04htmlB$\n" +
+	"\x04html\x18\x02 \x01(\v2\".oppb.v1.AuthorizationHtmlResponseH\x00R\x04html\x12C\n" +
+	"\aconsent\x18\x03 \x01(\v2'.oppb.v1.AuthorizationNextActionConsentH\x00R\aconsentB$\n" +
 	"\"authorization_issue_response_oneof\";\n" +
 	"\x1aAuthorizationCancelRequest\x12\x1d\n" +
 	"\n" +
@@ -2127,27 +4376,34 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\x13StartSessionRequest\x12\x18\n" +
 	"\asubject\x18\x01 \x01(\tR\asubject\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x02 \x01(\tR\trequestId\"@\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"\x9e\x01\n" +
 	"\x14StartSessionResponse\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\xc6\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12,\n" +
+	"\x12browser_state_name\x18\x03 \x01(\tR\x10browserStateName\x12.\n" +
+	"\x13browser_state_value\x18\x04 \x01(\tR\x11browserStateValue\"\xac\x02\n" +
 	"\fTokenRequest\x121\n" +
 	"\n" +
 	"basic_auth\x18\x01 \x01(\v2\x12.oppb.v1.BasicAuthR\tbasicAuth\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
 	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
 	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
-	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\"\x94\x01\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12\x12\n" +
+	"\x04dpop\x18\x06 \x01(\tR\x04dpop\x12P\n" +
+	"%tls_client_certificate_from_handshake\x18\a \x01(\bR!tlsClientCertificateFromHandshake\"\xb3\x01\n" +
 	"\rTokenResponse\x129\n" +
 	"\asuccess\x18\x01 \x01(\v2\x1d.oppb.v1.TokenSuccessResponseH\x00R\asuccess\x120\n" +
-	"\x04fail\x18\x02 \x01(\v2\x1a.oppb.v1.TokenFailResponseH\x00R\x04failB\x16\n" +
-	"\x14token_response_oneof\"\xbc\x01\n" +
+	"\x04fail\x18\x02 \x01(\v2\x1a.oppb.v1.TokenFailResponseH\x00R\x04fail\x12\x1d\n" +
+	"\n" +
+	"dpop_nonce\x18\x03 \x01(\tR\tdpopNonceB\x16\n" +
+	"\x14token_response_oneof\"\xd0\x01\n" +
 	"\x0fUserinfoRequest\x12$\n" +
 	"\rauthorization\x18\x01 \x01(\tR\rauthorization\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
 	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
 	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
-	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\"\xc5\x01\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12\x12\n" +
+	"\x04dpop\x18\x06 \x01(\tR\x04dpop\"\xc5\x01\n" +
 	"\x10UserinfoResponse\x12@\n" +
 	"\aheaders\x18\x01 \x03(\v2&.oppb.v1.UserinfoResponse.HeadersEntryR\aheaders\x12\x1f\n" +
 	"\vstatus_code\x18\x02 \x01(\x05R\n" +
@@ -2155,25 +4411,84 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd4\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x02\n" +
 	"\x1aPushedAuthorizationRequest\x121\n" +
 	"\n" +
 	"basic_auth\x18\x01 \x01(\v2\x12.oppb.v1.BasicAuthR\tbasicAuth\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
 	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
 	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
-	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\"\xcd\x01\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12\x12\n" +
+	"\x04dpop\x18\x06 \x01(\tR\x04dpop\x12P\n" +
+	"%tls_client_certificate_from_handshake\x18\a \x01(\bR!tlsClientCertificateFromHandshake\"\xec\x01\n" +
 	"\x1bPushedAuthorizationResponse\x12G\n" +
 	"\asuccess\x18\x01 \x01(\v2+.oppb.v1.PushedAuthorizationSuccessResponseH\x00R\asuccess\x12>\n" +
-	"\x04fail\x18\x02 \x01(\v2(.oppb.v1.PushedAuthorizationFailResponseH\x00R\x04failB%\n" +
+	"\x04fail\x18\x02 \x01(\v2(.oppb.v1.PushedAuthorizationFailResponseH\x00R\x04fail\x12\x1d\n" +
+	"\n" +
+	"dpop_nonce\x18\x03 \x01(\tR\tdpopNonceB%\n" +
 	"#pushed_authorization_response_oneof\"/\n" +
 	"\x0eRequestRequest\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"\x81\x01\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\xba\x01\n" +
 	"\x0fRequestResponse\x12+\n" +
 	"\x06client\x18\x01 \x01(\v2\x13.oppb.v1.ClientMetaR\x06client\x12A\n" +
 	"\vauth_params\x18\x02 \x01(\v2 .oppb.v1.AuthorizationParametersR\n" +
-	"authParams\"w\n" +
+	"authParams\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\"\x9d\x02\n" +
+	"\x11RevocationRequest\x121\n" +
+	"\n" +
+	"basic_auth\x18\x01 \x01(\v2\x12.oppb.v1.BasicAuthR\tbasicAuth\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12P\n" +
+	"%tls_client_certificate_from_handshake\x18\x06 \x01(\bR!tlsClientCertificateFromHandshake\"\xa8\x01\n" +
+	"\x12RevocationResponse\x12>\n" +
+	"\asuccess\x18\x01 \x01(\v2\".oppb.v1.RevocationSuccessResponseH\x00R\asuccess\x125\n" +
+	"\x04fail\x18\x02 \x01(\v2\x1f.oppb.v1.RevocationFailResponseH\x00R\x04failB\x1b\n" +
+	"\x19revocation_response_oneof\"\xcc\x02\n" +
+	"\x14IntrospectionRequest\x121\n" +
+	"\n" +
+	"basic_auth\x18\x01 \x01(\v2\x12.oppb.v1.BasicAuthR\tbasicAuth\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04form\x18\x04 \x01(\tR\x04form\x124\n" +
+	"\x16tls_client_certificate\x18\x05 \x01(\tR\x14tlsClientCertificate\x12\x16\n" +
+	"\x06accept\x18\x06 \x01(\tR\x06accept\x12\x12\n" +
+	"\x04dpop\x18\a \x01(\tR\x04dpop\x12P\n" +
+	"%tls_client_certificate_from_handshake\x18\b \x01(\bR!tlsClientCertificateFromHandshake\"\xcf\x01\n" +
+	"\x15IntrospectionResponse\x12E\n" +
+	"\aheaders\x18\x01 \x03(\v2+.oppb.v1.IntrospectionResponse.HeadersEntryR\aheaders\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf7\x01\n" +
+	"\x11EndSessionRequest\x12D\n" +
+	"\bsessions\x18\x01 \x03(\v2(.oppb.v1.EndSessionRequest.SessionsEntryR\bsessions\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x12\n" +
+	"\x04form\x18\x05 \x01(\tR\x04form\x1a;\n" +
+	"\rSessionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xab\x01\n" +
+	"\x12EndSessionResponse\x125\n" +
+	"\x04fail\x18\x01 \x01(\v2\x1f.oppb.v1.EndSessionFailResponseH\x00R\x04fail\x12@\n" +
+	"\aconfirm\x18\x02 \x01(\v2$.oppb.v1.EndSessionNextActionConfirmH\x00R\aconfirmB\x1c\n" +
+	"\x1aend_session_response_oneof\"7\n" +
+	"\x18EndSessionConfirmRequest\x12\x1b\n" +
+	"\tlogout_id\x18\x01 \x01(\tR\blogoutId\"\xc6\x02\n" +
+	"\x19EndSessionConfirmResponse\x12D\n" +
+	"\bredirect\x18\x01 \x01(\v2&.oppb.v1.AuthorizationRedirectResponseH\x00R\bredirect\x128\n" +
+	"\x04html\x18\x02 \x01(\v2\".oppb.v1.AuthorizationHtmlResponseH\x00R\x04html\x12#\n" +
+	"\rsession_names\x18\n" +
+	" \x03(\tR\fsessionNames\x12^\n" +
+	"\x1abackchannel_logout_results\x18\v \x03(\v2 .oppb.v1.BackchannelLogoutResultR\x18backchannelLogoutResultsB$\n" +
+	"\"end_session_confirm_response_oneof\"w\n" +
 	"\x19AuthorizationFailResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x129\n" +
@@ -2197,11 +4512,20 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\asubject\x18\x03 \x01(\tR\asubject\x12+\n" +
 	"\x06client\x18\x04 \x01(\v2\x13.oppb.v1.ClientMetaR\x06client\x12A\n" +
 	"\vauth_params\x18\x05 \x01(\v2 .oppb.v1.AuthorizationParametersR\n" +
+	"authParams\"\xe8\x01\n" +
+	"\x1eAuthorizationNextActionConsent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12+\n" +
+	"\x06client\x18\x04 \x01(\v2\x13.oppb.v1.ClientMetaR\x06client\x12A\n" +
+	"\vauth_params\x18\x05 \x01(\v2 .oppb.v1.AuthorizationParametersR\n" +
 	"authParams\"1\n" +
 	"\x1dAuthorizationRedirectResponse\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"5\n" +
 	"\x19AuthorizationHtmlResponse\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\"\xd2\x01\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\xb6\x02\n" +
 	"\x14TokenSuccessResponse\x12\"\n" +
 	"\faccess_token\x18\x01 \x01(\tR\faccess_token\x12\x1e\n" +
 	"\n" +
@@ -2212,7 +4536,38 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"expires_in\x12$\n" +
 	"\rrefresh_token\x18\x04 \x01(\tR\rrefresh_token\x12\x14\n" +
 	"\x05scope\x18\x05 \x01(\tR\x05scope\x12\x1a\n" +
-	"\bid_token\x18\x06 \x01(\tR\bid_token\"_\n" +
+	"\bid_token\x18\x06 \x01(\tR\bid_token\x12,\n" +
+	"\x11issued_token_type\x18\a \x01(\tR\x11issued_token_type\x124\n" +
+	"\x15authorization_details\x18\b \x01(\tR\x15authorization_details\"\x8a\x02\n" +
+	"\"DeviceAuthorizationSuccessResponse\x12 \n" +
+	"\vdevice_code\x18\x01 \x01(\tR\vdevice_code\x12\x1c\n" +
+	"\tuser_code\x18\x02 \x01(\tR\tuser_code\x12*\n" +
+	"\x10verification_uri\x18\x03 \x01(\tR\x10verification_uri\x12<\n" +
+	"\x19verification_uri_complete\x18\x04 \x01(\tR\x19verification_uri_complete\x12\x1e\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x05R\n" +
+	"expires_in\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\x05R\binterval\"\x88\x01\n" +
+	"(BackchannelAuthenticationSuccessResponse\x12 \n" +
+	"\vauth_req_id\x18\x01 \x01(\tR\vauth_req_id\x12\x1e\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05R\n" +
+	"expires_in\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\x05R\binterval\"\xeb\x02\n" +
+	"\x1fBackchannelAuthenticationNotify\x12\x1e\n" +
+	"\vauth_req_id\x18\x01 \x01(\tR\tauthReqId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12+\n" +
+	"\x06client\x18\x03 \x01(\v2\x13.oppb.v1.ClientMetaR\x06client\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"acr_values\x18\x05 \x03(\tR\tacrValues\x12\x1d\n" +
+	"\n" +
+	"login_hint\x18\x06 \x01(\tR\tloginHint\x12(\n" +
+	"\x10login_hint_token\x18\a \x01(\tR\x0eloginHintToken\x12\x18\n" +
+	"\asubject\x18\b \x01(\tR\asubject\x12'\n" +
+	"\x0fbinding_message\x18\t \x01(\tR\x0ebindingMessage\x12\x1b\n" +
+	"\tuser_code\x18\n" +
+	" \x01(\tR\buserCode\"_\n" +
 	"\x11TokenFailResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12)\n" +
@@ -2227,7 +4582,35 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\x1fPushedAuthorizationFailResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12)\n" +
-	"\x05error\x18\x02 \x01(\v2\x13.oppb.v1.OauthErrorR\x05error\"n\n" +
+	"\x05error\x18\x02 \x01(\v2\x13.oppb.v1.OauthErrorR\x05error\"<\n" +
+	"\x19RevocationSuccessResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\"d\n" +
+	"\x16RevocationFailResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12)\n" +
+	"\x05error\x18\x02 \x01(\v2\x13.oppb.v1.OauthErrorR\x05error\"d\n" +
+	"\x16EndSessionFailResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12)\n" +
+	"\x05error\x18\x02 \x01(\v2\x13.oppb.v1.OauthErrorR\x05error\"\x97\x02\n" +
+	"\x1bEndSessionNextActionConfirm\x12\x1b\n" +
+	"\tlogout_id\x18\x01 \x01(\tR\blogoutId\x12+\n" +
+	"\x06client\x18\x02 \x01(\v2\x13.oppb.v1.ClientMetaR\x06client\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x127\n" +
+	"\x18post_logout_redirect_uri\x18\x04 \x01(\tR\x15postLogoutRedirectUri\x12\x1f\n" +
+	"\vlogout_hint\x18\x05 \x01(\tR\n" +
+	"logoutHint\x12\x1d\n" +
+	"\n" +
+	"ui_locales\x18\x06 \x03(\tR\tuiLocales\x12\x18\n" +
+	"\asubject\x18\a \x01(\tR\asubject\"\xbf\x01\n" +
+	"\x17BackchannelLogoutResult\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x124\n" +
+	"\x16backchannel_logout_uri\x18\x02 \x01(\tR\x14backchannelLogoutUri\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\"n\n" +
 	"\n" +
 	"OauthError\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x12,\n" +
@@ -2235,7 +4618,7 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\terror_uri\x18\x03 \x01(\tR\terror_uri\"C\n" +
 	"\tBasicAuth\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword2\x95\b\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword2\xee\x0f\n" +
 	"\x0fProviderService\x12B\n" +
 	"\tDiscovery\x12\x19.oppb.v1.DiscoveryRequest\x1a\x1a.oppb.v1.DiscoveryResponse\x123\n" +
 	"\x04Jwks\x12\x14.oppb.v1.JwksRequest\x1a\x15.oppb.v1.JwksResponse\x12N\n" +
@@ -2249,7 +4632,19 @@ const file_oppb_v1_provider_service_proto_rawDesc = "" +
 	"\aRequest\x12\x17.oppb.v1.RequestRequest\x1a\x18.oppb.v1.RequestResponse\x12]\n" +
 	"\x12RegistrationCreate\x12\".oppb.v1.RegistrationCreateRequest\x1a#.oppb.v1.RegistrationCreateResponse\x12]\n" +
 	"\x12RegistrationDelete\x12\".oppb.v1.RegistrationDeleteRequest\x1a#.oppb.v1.RegistrationDeleteResponse\x12T\n" +
-	"\x0fRegistrationGet\x12\x1f.oppb.v1.RegistrationGetRequest\x1a .oppb.v1.RegistrationGetResponseB\x9a\x01\n" +
+	"\x0fRegistrationGet\x12\x1f.oppb.v1.RegistrationGetRequest\x1a .oppb.v1.RegistrationGetResponse\x12E\n" +
+	"\n" +
+	"Revocation\x12\x1a.oppb.v1.RevocationRequest\x1a\x1b.oppb.v1.RevocationResponse\x12N\n" +
+	"\rIntrospection\x12\x1d.oppb.v1.IntrospectionRequest\x1a\x1e.oppb.v1.IntrospectionResponse\x12E\n" +
+	"\n" +
+	"EndSession\x12\x1a.oppb.v1.EndSessionRequest\x1a\x1b.oppb.v1.EndSessionResponse\x12Z\n" +
+	"\x11EndSessionConfirm\x12!.oppb.v1.EndSessionConfirmRequest\x1a\".oppb.v1.EndSessionConfirmResponse\x12]\n" +
+	"\x12CheckSessionIframe\x12\".oppb.v1.CheckSessionIframeRequest\x1a#.oppb.v1.CheckSessionIframeResponse\x12`\n" +
+	"\x13DeviceAuthorization\x12#.oppb.v1.DeviceAuthorizationRequest\x1a$.oppb.v1.DeviceAuthorizationResponse\x12]\n" +
+	"\x12DeviceVerification\x12\".oppb.v1.DeviceVerificationRequest\x1a#.oppb.v1.DeviceVerificationResponse\x12r\n" +
+	"\x19BackchannelAuthentication\x12).oppb.v1.BackchannelAuthenticationRequest\x1a*.oppb.v1.BackchannelAuthenticationResponse\x12\x81\x01\n" +
+	"\x1eBackchannelAuthenticationIssue\x12..oppb.v1.BackchannelAuthenticationIssueRequest\x1a/.oppb.v1.BackchannelAuthenticationIssueResponse\x12\x84\x01\n" +
+	"\x1fBackchannelAuthenticationCancel\x12/.oppb.v1.BackchannelAuthenticationCancelRequest\x1a0.oppb.v1.BackchannelAuthenticationCancelResponseB\x9a\x01\n" +
 	"\vcom.oppb.v1B\x14ProviderServiceProtoP\x01Z8github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1;oppb\xa2\x02\x03OXX\xaa\x02\aOppb.V1\xca\x02\aOppb\\V1\xe2\x02\x13Oppb\\V1\\GPBMetadata\xea\x02\bOppb::V1b\x06proto3"
 
 var (
@@ -2264,115 +4659,194 @@ func file_oppb_v1_provider_service_proto_rawDescGZIP() []byte {
 	return file_oppb_v1_provider_service_proto_rawDescData
 }
 
-var file_oppb_v1_provider_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_oppb_v1_provider_service_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_oppb_v1_provider_service_proto_goTypes = []any{
-	(*DiscoveryRequest)(nil),                   // 0: oppb.v1.DiscoveryRequest
-	(*DiscoveryResponse)(nil),                  // 1: oppb.v1.DiscoveryResponse
-	(*JwksRequest)(nil),                        // 2: oppb.v1.JwksRequest
-	(*JwksResponse)(nil),                       // 3: oppb.v1.JwksResponse
-	(*AuthorizationRequest)(nil),               // 4: oppb.v1.AuthorizationRequest
-	(*AuthorizationResponse)(nil),              // 5: oppb.v1.AuthorizationResponse
-	(*AuthorizationIssueRequest)(nil),          // 6: oppb.v1.AuthorizationIssueRequest
-	(*AuthorizationIssueResponse)(nil),         // 7: oppb.v1.AuthorizationIssueResponse
-	(*AuthorizationCancelRequest)(nil),         // 8: oppb.v1.AuthorizationCancelRequest
-	(*AuthorizationCancelResponse)(nil),        // 9: oppb.v1.AuthorizationCancelResponse
-	(*StartSessionRequest)(nil),                // 10: oppb.v1.StartSessionRequest
-	(*StartSessionResponse)(nil),               // 11: oppb.v1.StartSessionResponse
-	(*TokenRequest)(nil),                       // 12: oppb.v1.TokenRequest
-	(*TokenResponse)(nil),                      // 13: oppb.v1.TokenResponse
-	(*UserinfoRequest)(nil),                    // 14: oppb.v1.UserinfoRequest
-	(*UserinfoResponse)(nil),                   // 15: oppb.v1.UserinfoResponse
-	(*PushedAuthorizationRequest)(nil),         // 16: oppb.v1.PushedAuthorizationRequest
-	(*PushedAuthorizationResponse)(nil),        // 17: oppb.v1.PushedAuthorizationResponse
-	(*RequestRequest)(nil),                     // 18: oppb.v1.RequestRequest
-	(*RequestResponse)(nil),                    // 19: oppb.v1.RequestResponse
-	(*AuthorizationFailResponse)(nil),          // 20: oppb.v1.AuthorizationFailResponse
-	(*AuthorizationErrorResponse)(nil),         // 21: oppb.v1.AuthorizationErrorResponse
-	(*AuthorizationNextActionLogin)(nil),       // 22: oppb.v1.AuthorizationNextActionLogin
-	(*AuthorizationNextActionIssue)(nil),       // 23: oppb.v1.AuthorizationNextActionIssue
-	(*AuthorizationRedirectResponse)(nil),      // 24: oppb.v1.AuthorizationRedirectResponse
-	(*AuthorizationHtmlResponse)(nil),          // 25: oppb.v1.AuthorizationHtmlResponse
-	(*TokenSuccessResponse)(nil),               // 26: oppb.v1.TokenSuccessResponse
-	(*TokenFailResponse)(nil),                  // 27: oppb.v1.TokenFailResponse
-	(*PushedAuthorizationSuccessResponse)(nil), // 28: oppb.v1.PushedAuthorizationSuccessResponse
-	(*PushedAuthorizationFailResponse)(nil),    // 29: oppb.v1.PushedAuthorizationFailResponse
-	(*OauthError)(nil),                         // 30: oppb.v1.OauthError
-	(*BasicAuth)(nil),                          // 31: oppb.v1.BasicAuth
-	nil,                                        // 32: oppb.v1.AuthorizationRequest.SessionsEntry
-	nil,                                        // 33: oppb.v1.UserinfoResponse.HeadersEntry
-	(*Jwk)(nil),                                // 34: oppb.v1.Jwk
-	(*AuthorizationParameters)(nil),            // 35: oppb.v1.AuthorizationParameters
-	(*ClientMeta)(nil),                         // 36: oppb.v1.ClientMeta
-	(*ClientAttribute)(nil),                    // 37: oppb.v1.ClientAttribute
-	(*RegistrationCreateRequest)(nil),          // 38: oppb.v1.RegistrationCreateRequest
-	(*RegistrationDeleteRequest)(nil),          // 39: oppb.v1.RegistrationDeleteRequest
-	(*RegistrationGetRequest)(nil),             // 40: oppb.v1.RegistrationGetRequest
-	(*RegistrationCreateResponse)(nil),         // 41: oppb.v1.RegistrationCreateResponse
-	(*RegistrationDeleteResponse)(nil),         // 42: oppb.v1.RegistrationDeleteResponse
-	(*RegistrationGetResponse)(nil),            // 43: oppb.v1.RegistrationGetResponse
+	(*DiscoveryRequest)(nil),                         // 0: oppb.v1.DiscoveryRequest
+	(*DiscoveryResponse)(nil),                        // 1: oppb.v1.DiscoveryResponse
+	(*JwksRequest)(nil),                              // 2: oppb.v1.JwksRequest
+	(*JwksResponse)(nil),                             // 3: oppb.v1.JwksResponse
+	(*CheckSessionIframeRequest)(nil),                // 4: oppb.v1.CheckSessionIframeRequest
+	(*CheckSessionIframeResponse)(nil),               // 5: oppb.v1.CheckSessionIframeResponse
+	(*DeviceAuthorizationRequest)(nil),               // 6: oppb.v1.DeviceAuthorizationRequest
+	(*DeviceAuthorizationResponse)(nil),              // 7: oppb.v1.DeviceAuthorizationResponse
+	(*DeviceVerificationRequest)(nil),                // 8: oppb.v1.DeviceVerificationRequest
+	(*DeviceVerificationResponse)(nil),               // 9: oppb.v1.DeviceVerificationResponse
+	(*BackchannelAuthenticationRequest)(nil),         // 10: oppb.v1.BackchannelAuthenticationRequest
+	(*BackchannelAuthenticationResponse)(nil),        // 11: oppb.v1.BackchannelAuthenticationResponse
+	(*BackchannelAuthenticationIssueRequest)(nil),    // 12: oppb.v1.BackchannelAuthenticationIssueRequest
+	(*BackchannelAuthenticationIssueResponse)(nil),   // 13: oppb.v1.BackchannelAuthenticationIssueResponse
+	(*BackchannelAuthenticationCancelRequest)(nil),   // 14: oppb.v1.BackchannelAuthenticationCancelRequest
+	(*BackchannelAuthenticationCancelResponse)(nil),  // 15: oppb.v1.BackchannelAuthenticationCancelResponse
+	(*AuthorizationRequest)(nil),                     // 16: oppb.v1.AuthorizationRequest
+	(*AuthorizationResponse)(nil),                    // 17: oppb.v1.AuthorizationResponse
+	(*AuthorizationIssueRequest)(nil),                // 18: oppb.v1.AuthorizationIssueRequest
+	(*AuthorizationIssueResponse)(nil),               // 19: oppb.v1.AuthorizationIssueResponse
+	(*AuthorizationCancelRequest)(nil),               // 20: oppb.v1.AuthorizationCancelRequest
+	(*AuthorizationCancelResponse)(nil),              // 21: oppb.v1.AuthorizationCancelResponse
+	(*StartSessionRequest)(nil),                      // 22: oppb.v1.StartSessionRequest
+	(*StartSessionResponse)(nil),                     // 23: oppb.v1.StartSessionResponse
+	(*TokenRequest)(nil),                             // 24: oppb.v1.TokenRequest
+	(*TokenResponse)(nil),                            // 25: oppb.v1.TokenResponse
+	(*UserinfoRequest)(nil),                          // 26: oppb.v1.UserinfoRequest
+	(*UserinfoResponse)(nil),                         // 27: oppb.v1.UserinfoResponse
+	(*PushedAuthorizationRequest)(nil),               // 28: oppb.v1.PushedAuthorizationRequest
+	(*PushedAuthorizationResponse)(nil),              // 29: oppb.v1.PushedAuthorizationResponse
+	(*RequestRequest)(nil),                           // 30: oppb.v1.RequestRequest
+	(*RequestResponse)(nil),                          // 31: oppb.v1.RequestResponse
+	(*RevocationRequest)(nil),                        // 32: oppb.v1.RevocationRequest
+	(*RevocationResponse)(nil),                       // 33: oppb.v1.RevocationResponse
+	(*IntrospectionRequest)(nil),                     // 34: oppb.v1.IntrospectionRequest
+	(*IntrospectionResponse)(nil),                    // 35: oppb.v1.IntrospectionResponse
+	(*EndSessionRequest)(nil),                        // 36: oppb.v1.EndSessionRequest
+	(*EndSessionResponse)(nil),                       // 37: oppb.v1.EndSessionResponse
+	(*EndSessionConfirmRequest)(nil),                 // 38: oppb.v1.EndSessionConfirmRequest
+	(*EndSessionConfirmResponse)(nil),                // 39: oppb.v1.EndSessionConfirmResponse
+	(*AuthorizationFailResponse)(nil),                // 40: oppb.v1.AuthorizationFailResponse
+	(*AuthorizationErrorResponse)(nil),               // 41: oppb.v1.AuthorizationErrorResponse
+	(*AuthorizationNextActionLogin)(nil),             // 42: oppb.v1.AuthorizationNextActionLogin
+	(*AuthorizationNextActionIssue)(nil),             // 43: oppb.v1.AuthorizationNextActionIssue
+	(*AuthorizationNextActionConsent)(nil),           // 44: oppb.v1.AuthorizationNextActionConsent
+	(*AuthorizationRedirectResponse)(nil),            // 45: oppb.v1.AuthorizationRedirectResponse
+	(*AuthorizationHtmlResponse)(nil),                // 46: oppb.v1.AuthorizationHtmlResponse
+	(*TokenSuccessResponse)(nil),                     // 47: oppb.v1.TokenSuccessResponse
+	(*DeviceAuthorizationSuccessResponse)(nil),       // 48: oppb.v1.DeviceAuthorizationSuccessResponse
+	(*BackchannelAuthenticationSuccessResponse)(nil), // 49: oppb.v1.BackchannelAuthenticationSuccessResponse
+	(*BackchannelAuthenticationNotify)(nil),          // 50: oppb.v1.BackchannelAuthenticationNotify
+	(*TokenFailResponse)(nil),                        // 51: oppb.v1.TokenFailResponse
+	(*PushedAuthorizationSuccessResponse)(nil),       // 52: oppb.v1.PushedAuthorizationSuccessResponse
+	(*PushedAuthorizationFailResponse)(nil),          // 53: oppb.v1.PushedAuthorizationFailResponse
+	(*RevocationSuccessResponse)(nil),                // 54: oppb.v1.RevocationSuccessResponse
+	(*RevocationFailResponse)(nil),                   // 55: oppb.v1.RevocationFailResponse
+	(*EndSessionFailResponse)(nil),                   // 56: oppb.v1.EndSessionFailResponse
+	(*EndSessionNextActionConfirm)(nil),              // 57: oppb.v1.EndSessionNextActionConfirm
+	(*BackchannelLogoutResult)(nil),                  // 58: oppb.v1.BackchannelLogoutResult
+	(*OauthError)(nil),                               // 59: oppb.v1.OauthError
+	(*BasicAuth)(nil),                                // 60: oppb.v1.BasicAuth
+	nil,                                              // 61: oppb.v1.AuthorizationRequest.SessionsEntry
+	nil,                                              // 62: oppb.v1.UserinfoResponse.HeadersEntry
+	nil,                                              // 63: oppb.v1.IntrospectionResponse.HeadersEntry
+	nil,                                              // 64: oppb.v1.EndSessionRequest.SessionsEntry
+	(*Jwk)(nil),                                      // 65: oppb.v1.Jwk
+	(*AuthorizationParameters)(nil),                  // 66: oppb.v1.AuthorizationParameters
+	(*ClientMeta)(nil),                               // 67: oppb.v1.ClientMeta
+	(*ClientAttribute)(nil),                          // 68: oppb.v1.ClientAttribute
+	(*RegistrationCreateRequest)(nil),                // 69: oppb.v1.RegistrationCreateRequest
+	(*RegistrationDeleteRequest)(nil),                // 70: oppb.v1.RegistrationDeleteRequest
+	(*RegistrationGetRequest)(nil),                   // 71: oppb.v1.RegistrationGetRequest
+	(*RegistrationCreateResponse)(nil),               // 72: oppb.v1.RegistrationCreateResponse
+	(*RegistrationDeleteResponse)(nil),               // 73: oppb.v1.RegistrationDeleteResponse
+	(*RegistrationGetResponse)(nil),                  // 74: oppb.v1.RegistrationGetResponse
 }
 var file_oppb_v1_provider_service_proto_depIdxs = []int32{
-	34, // 0: oppb.v1.JwksResponse.keys:type_name -> oppb.v1.Jwk
-	32, // 1: oppb.v1.AuthorizationRequest.sessions:type_name -> oppb.v1.AuthorizationRequest.SessionsEntry
-	20, // 2: oppb.v1.AuthorizationResponse.fail:type_name -> oppb.v1.AuthorizationFailResponse
-	22, // 3: oppb.v1.AuthorizationResponse.login:type_name -> oppb.v1.AuthorizationNextActionLogin
-	23, // 4: oppb.v1.AuthorizationResponse.issue:type_name -> oppb.v1.AuthorizationNextActionIssue
-	24, // 5: oppb.v1.AuthorizationResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
-	25, // 6: oppb.v1.AuthorizationResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
-	35, // 7: oppb.v1.AuthorizationResponse.params:type_name -> oppb.v1.AuthorizationParameters
-	36, // 8: oppb.v1.AuthorizationResponse.client:type_name -> oppb.v1.ClientMeta
-	37, // 9: oppb.v1.AuthorizationResponse.client_attribute:type_name -> oppb.v1.ClientAttribute
-	24, // 10: oppb.v1.AuthorizationIssueResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
-	25, // 11: oppb.v1.AuthorizationIssueResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
-	24, // 12: oppb.v1.AuthorizationCancelResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
-	25, // 13: oppb.v1.AuthorizationCancelResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
-	31, // 14: oppb.v1.TokenRequest.basic_auth:type_name -> oppb.v1.BasicAuth
-	26, // 15: oppb.v1.TokenResponse.success:type_name -> oppb.v1.TokenSuccessResponse
-	27, // 16: oppb.v1.TokenResponse.fail:type_name -> oppb.v1.TokenFailResponse
-	33, // 17: oppb.v1.UserinfoResponse.headers:type_name -> oppb.v1.UserinfoResponse.HeadersEntry
-	31, // 18: oppb.v1.PushedAuthorizationRequest.basic_auth:type_name -> oppb.v1.BasicAuth
-	28, // 19: oppb.v1.PushedAuthorizationResponse.success:type_name -> oppb.v1.PushedAuthorizationSuccessResponse
-	29, // 20: oppb.v1.PushedAuthorizationResponse.fail:type_name -> oppb.v1.PushedAuthorizationFailResponse
-	36, // 21: oppb.v1.RequestResponse.client:type_name -> oppb.v1.ClientMeta
-	35, // 22: oppb.v1.RequestResponse.auth_params:type_name -> oppb.v1.AuthorizationParameters
-	21, // 23: oppb.v1.AuthorizationFailResponse.error:type_name -> oppb.v1.AuthorizationErrorResponse
-	36, // 24: oppb.v1.AuthorizationNextActionLogin.client:type_name -> oppb.v1.ClientMeta
-	35, // 25: oppb.v1.AuthorizationNextActionLogin.auth_params:type_name -> oppb.v1.AuthorizationParameters
-	36, // 26: oppb.v1.AuthorizationNextActionIssue.client:type_name -> oppb.v1.ClientMeta
-	35, // 27: oppb.v1.AuthorizationNextActionIssue.auth_params:type_name -> oppb.v1.AuthorizationParameters
-	30, // 28: oppb.v1.TokenFailResponse.error:type_name -> oppb.v1.OauthError
-	30, // 29: oppb.v1.PushedAuthorizationFailResponse.error:type_name -> oppb.v1.OauthError
-	0,  // 30: oppb.v1.ProviderService.Discovery:input_type -> oppb.v1.DiscoveryRequest
-	2,  // 31: oppb.v1.ProviderService.Jwks:input_type -> oppb.v1.JwksRequest
-	4,  // 32: oppb.v1.ProviderService.Authorization:input_type -> oppb.v1.AuthorizationRequest
-	6,  // 33: oppb.v1.ProviderService.AuthorizationIssue:input_type -> oppb.v1.AuthorizationIssueRequest
-	8,  // 34: oppb.v1.ProviderService.AuthorizationCancel:input_type -> oppb.v1.AuthorizationCancelRequest
-	10, // 35: oppb.v1.ProviderService.StartSession:input_type -> oppb.v1.StartSessionRequest
-	12, // 36: oppb.v1.ProviderService.Token:input_type -> oppb.v1.TokenRequest
-	14, // 37: oppb.v1.ProviderService.Userinfo:input_type -> oppb.v1.UserinfoRequest
-	16, // 38: oppb.v1.ProviderService.PushedAuthorization:input_type -> oppb.v1.PushedAuthorizationRequest
-	18, // 39: oppb.v1.ProviderService.Request:input_type -> oppb.v1.RequestRequest
-	38, // 40: oppb.v1.ProviderService.RegistrationCreate:input_type -> oppb.v1.RegistrationCreateRequest
-	39, // 41: oppb.v1.ProviderService.RegistrationDelete:input_type -> oppb.v1.RegistrationDeleteRequest
-	40, // 42: oppb.v1.ProviderService.RegistrationGet:input_type -> oppb.v1.RegistrationGetRequest
-	1,  // 43: oppb.v1.ProviderService.Discovery:output_type -> oppb.v1.DiscoveryResponse
-	3,  // 44: oppb.v1.ProviderService.Jwks:output_type -> oppb.v1.JwksResponse
-	5,  // 45: oppb.v1.ProviderService.Authorization:output_type -> oppb.v1.AuthorizationResponse
-	7,  // 46: oppb.v1.ProviderService.AuthorizationIssue:output_type -> oppb.v1.AuthorizationIssueResponse
-	9,  // 47: oppb.v1.ProviderService.AuthorizationCancel:output_type -> oppb.v1.AuthorizationCancelResponse
-	11, // 48: oppb.v1.ProviderService.StartSession:output_type -> oppb.v1.StartSessionResponse
-	13, // 49: oppb.v1.ProviderService.Token:output_type -> oppb.v1.TokenResponse
-	15, // 50: oppb.v1.ProviderService.Userinfo:output_type -> oppb.v1.UserinfoResponse
-	17, // 51: oppb.v1.ProviderService.PushedAuthorization:output_type -> oppb.v1.PushedAuthorizationResponse
-	19, // 52: oppb.v1.ProviderService.Request:output_type -> oppb.v1.RequestResponse
-	41, // 53: oppb.v1.ProviderService.RegistrationCreate:output_type -> oppb.v1.RegistrationCreateResponse
-	42, // 54: oppb.v1.ProviderService.RegistrationDelete:output_type -> oppb.v1.RegistrationDeleteResponse
-	43, // 55: oppb.v1.ProviderService.RegistrationGet:output_type -> oppb.v1.RegistrationGetResponse
-	43, // [43:56] is the sub-list for method output_type
-	30, // [30:43] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	65, // 0: oppb.v1.JwksResponse.keys:type_name -> oppb.v1.Jwk
+	60, // 1: oppb.v1.DeviceAuthorizationRequest.basic_auth:type_name -> oppb.v1.BasicAuth
+	48, // 2: oppb.v1.DeviceAuthorizationResponse.success:type_name -> oppb.v1.DeviceAuthorizationSuccessResponse
+	51, // 3: oppb.v1.DeviceAuthorizationResponse.fail:type_name -> oppb.v1.TokenFailResponse
+	46, // 4: oppb.v1.DeviceVerificationResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
+	42, // 5: oppb.v1.DeviceVerificationResponse.login:type_name -> oppb.v1.AuthorizationNextActionLogin
+	60, // 6: oppb.v1.BackchannelAuthenticationRequest.basic_auth:type_name -> oppb.v1.BasicAuth
+	49, // 7: oppb.v1.BackchannelAuthenticationResponse.success:type_name -> oppb.v1.BackchannelAuthenticationSuccessResponse
+	51, // 8: oppb.v1.BackchannelAuthenticationResponse.fail:type_name -> oppb.v1.TokenFailResponse
+	50, // 9: oppb.v1.BackchannelAuthenticationResponse.notify:type_name -> oppb.v1.BackchannelAuthenticationNotify
+	61, // 10: oppb.v1.AuthorizationRequest.sessions:type_name -> oppb.v1.AuthorizationRequest.SessionsEntry
+	40, // 11: oppb.v1.AuthorizationResponse.fail:type_name -> oppb.v1.AuthorizationFailResponse
+	42, // 12: oppb.v1.AuthorizationResponse.login:type_name -> oppb.v1.AuthorizationNextActionLogin
+	43, // 13: oppb.v1.AuthorizationResponse.issue:type_name -> oppb.v1.AuthorizationNextActionIssue
+	45, // 14: oppb.v1.AuthorizationResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
+	46, // 15: oppb.v1.AuthorizationResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
+	44, // 16: oppb.v1.AuthorizationResponse.consent:type_name -> oppb.v1.AuthorizationNextActionConsent
+	66, // 17: oppb.v1.AuthorizationResponse.params:type_name -> oppb.v1.AuthorizationParameters
+	67, // 18: oppb.v1.AuthorizationResponse.client:type_name -> oppb.v1.ClientMeta
+	68, // 19: oppb.v1.AuthorizationResponse.client_attribute:type_name -> oppb.v1.ClientAttribute
+	45, // 20: oppb.v1.AuthorizationIssueResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
+	46, // 21: oppb.v1.AuthorizationIssueResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
+	44, // 22: oppb.v1.AuthorizationIssueResponse.consent:type_name -> oppb.v1.AuthorizationNextActionConsent
+	45, // 23: oppb.v1.AuthorizationCancelResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
+	46, // 24: oppb.v1.AuthorizationCancelResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
+	60, // 25: oppb.v1.TokenRequest.basic_auth:type_name -> oppb.v1.BasicAuth
+	47, // 26: oppb.v1.TokenResponse.success:type_name -> oppb.v1.TokenSuccessResponse
+	51, // 27: oppb.v1.TokenResponse.fail:type_name -> oppb.v1.TokenFailResponse
+	62, // 28: oppb.v1.UserinfoResponse.headers:type_name -> oppb.v1.UserinfoResponse.HeadersEntry
+	60, // 29: oppb.v1.PushedAuthorizationRequest.basic_auth:type_name -> oppb.v1.BasicAuth
+	52, // 30: oppb.v1.PushedAuthorizationResponse.success:type_name -> oppb.v1.PushedAuthorizationSuccessResponse
+	53, // 31: oppb.v1.PushedAuthorizationResponse.fail:type_name -> oppb.v1.PushedAuthorizationFailResponse
+	67, // 32: oppb.v1.RequestResponse.client:type_name -> oppb.v1.ClientMeta
+	66, // 33: oppb.v1.RequestResponse.auth_params:type_name -> oppb.v1.AuthorizationParameters
+	60, // 34: oppb.v1.RevocationRequest.basic_auth:type_name -> oppb.v1.BasicAuth
+	54, // 35: oppb.v1.RevocationResponse.success:type_name -> oppb.v1.RevocationSuccessResponse
+	55, // 36: oppb.v1.RevocationResponse.fail:type_name -> oppb.v1.RevocationFailResponse
+	60, // 37: oppb.v1.IntrospectionRequest.basic_auth:type_name -> oppb.v1.BasicAuth
+	63, // 38: oppb.v1.IntrospectionResponse.headers:type_name -> oppb.v1.IntrospectionResponse.HeadersEntry
+	64, // 39: oppb.v1.EndSessionRequest.sessions:type_name -> oppb.v1.EndSessionRequest.SessionsEntry
+	56, // 40: oppb.v1.EndSessionResponse.fail:type_name -> oppb.v1.EndSessionFailResponse
+	57, // 41: oppb.v1.EndSessionResponse.confirm:type_name -> oppb.v1.EndSessionNextActionConfirm
+	45, // 42: oppb.v1.EndSessionConfirmResponse.redirect:type_name -> oppb.v1.AuthorizationRedirectResponse
+	46, // 43: oppb.v1.EndSessionConfirmResponse.html:type_name -> oppb.v1.AuthorizationHtmlResponse
+	58, // 44: oppb.v1.EndSessionConfirmResponse.backchannel_logout_results:type_name -> oppb.v1.BackchannelLogoutResult
+	41, // 45: oppb.v1.AuthorizationFailResponse.error:type_name -> oppb.v1.AuthorizationErrorResponse
+	67, // 46: oppb.v1.AuthorizationNextActionLogin.client:type_name -> oppb.v1.ClientMeta
+	66, // 47: oppb.v1.AuthorizationNextActionLogin.auth_params:type_name -> oppb.v1.AuthorizationParameters
+	67, // 48: oppb.v1.AuthorizationNextActionIssue.client:type_name -> oppb.v1.ClientMeta
+	66, // 49: oppb.v1.AuthorizationNextActionIssue.auth_params:type_name -> oppb.v1.AuthorizationParameters
+	67, // 50: oppb.v1.AuthorizationNextActionConsent.client:type_name -> oppb.v1.ClientMeta
+	66, // 51: oppb.v1.AuthorizationNextActionConsent.auth_params:type_name -> oppb.v1.AuthorizationParameters
+	67, // 52: oppb.v1.BackchannelAuthenticationNotify.client:type_name -> oppb.v1.ClientMeta
+	59, // 53: oppb.v1.TokenFailResponse.error:type_name -> oppb.v1.OauthError
+	59, // 54: oppb.v1.PushedAuthorizationFailResponse.error:type_name -> oppb.v1.OauthError
+	59, // 55: oppb.v1.RevocationFailResponse.error:type_name -> oppb.v1.OauthError
+	59, // 56: oppb.v1.EndSessionFailResponse.error:type_name -> oppb.v1.OauthError
+	67, // 57: oppb.v1.EndSessionNextActionConfirm.client:type_name -> oppb.v1.ClientMeta
+	0,  // 58: oppb.v1.ProviderService.Discovery:input_type -> oppb.v1.DiscoveryRequest
+	2,  // 59: oppb.v1.ProviderService.Jwks:input_type -> oppb.v1.JwksRequest
+	16, // 60: oppb.v1.ProviderService.Authorization:input_type -> oppb.v1.AuthorizationRequest
+	18, // 61: oppb.v1.ProviderService.AuthorizationIssue:input_type -> oppb.v1.AuthorizationIssueRequest
+	20, // 62: oppb.v1.ProviderService.AuthorizationCancel:input_type -> oppb.v1.AuthorizationCancelRequest
+	22, // 63: oppb.v1.ProviderService.StartSession:input_type -> oppb.v1.StartSessionRequest
+	24, // 64: oppb.v1.ProviderService.Token:input_type -> oppb.v1.TokenRequest
+	26, // 65: oppb.v1.ProviderService.Userinfo:input_type -> oppb.v1.UserinfoRequest
+	28, // 66: oppb.v1.ProviderService.PushedAuthorization:input_type -> oppb.v1.PushedAuthorizationRequest
+	30, // 67: oppb.v1.ProviderService.Request:input_type -> oppb.v1.RequestRequest
+	69, // 68: oppb.v1.ProviderService.RegistrationCreate:input_type -> oppb.v1.RegistrationCreateRequest
+	70, // 69: oppb.v1.ProviderService.RegistrationDelete:input_type -> oppb.v1.RegistrationDeleteRequest
+	71, // 70: oppb.v1.ProviderService.RegistrationGet:input_type -> oppb.v1.RegistrationGetRequest
+	32, // 71: oppb.v1.ProviderService.Revocation:input_type -> oppb.v1.RevocationRequest
+	34, // 72: oppb.v1.ProviderService.Introspection:input_type -> oppb.v1.IntrospectionRequest
+	36, // 73: oppb.v1.ProviderService.EndSession:input_type -> oppb.v1.EndSessionRequest
+	38, // 74: oppb.v1.ProviderService.EndSessionConfirm:input_type -> oppb.v1.EndSessionConfirmRequest
+	4,  // 75: oppb.v1.ProviderService.CheckSessionIframe:input_type -> oppb.v1.CheckSessionIframeRequest
+	6,  // 76: oppb.v1.ProviderService.DeviceAuthorization:input_type -> oppb.v1.DeviceAuthorizationRequest
+	8,  // 77: oppb.v1.ProviderService.DeviceVerification:input_type -> oppb.v1.DeviceVerificationRequest
+	10, // 78: oppb.v1.ProviderService.BackchannelAuthentication:input_type -> oppb.v1.BackchannelAuthenticationRequest
+	12, // 79: oppb.v1.ProviderService.BackchannelAuthenticationIssue:input_type -> oppb.v1.BackchannelAuthenticationIssueRequest
+	14, // 80: oppb.v1.ProviderService.BackchannelAuthenticationCancel:input_type -> oppb.v1.BackchannelAuthenticationCancelRequest
+	1,  // 81: oppb.v1.ProviderService.Discovery:output_type -> oppb.v1.DiscoveryResponse
+	3,  // 82: oppb.v1.ProviderService.Jwks:output_type -> oppb.v1.JwksResponse
+	17, // 83: oppb.v1.ProviderService.Authorization:output_type -> oppb.v1.AuthorizationResponse
+	19, // 84: oppb.v1.ProviderService.AuthorizationIssue:output_type -> oppb.v1.AuthorizationIssueResponse
+	21, // 85: oppb.v1.ProviderService.AuthorizationCancel:output_type -> oppb.v1.AuthorizationCancelResponse
+	23, // 86: oppb.v1.ProviderService.StartSession:output_type -> oppb.v1.StartSessionResponse
+	25, // 87: oppb.v1.ProviderService.Token:output_type -> oppb.v1.TokenResponse
+	27, // 88: oppb.v1.ProviderService.Userinfo:output_type -> oppb.v1.UserinfoResponse
+	29, // 89: oppb.v1.ProviderService.PushedAuthorization:output_type -> oppb.v1.PushedAuthorizationResponse
+	31, // 90: oppb.v1.ProviderService.Request:output_type -> oppb.v1.RequestResponse
+	72, // 91: oppb.v1.ProviderService.RegistrationCreate:output_type -> oppb.v1.RegistrationCreateResponse
+	73, // 92: oppb.v1.ProviderService.RegistrationDelete:output_type -> oppb.v1.RegistrationDeleteResponse
+	74, // 93: oppb.v1.ProviderService.RegistrationGet:output_type -> oppb.v1.RegistrationGetResponse
+	33, // 94: oppb.v1.ProviderService.Revocation:output_type -> oppb.v1.RevocationResponse
+	35, // 95: oppb.v1.ProviderService.Introspection:output_type -> oppb.v1.IntrospectionResponse
+	37, // 96: oppb.v1.ProviderService.EndSession:output_type -> oppb.v1.EndSessionResponse
+	39, // 97: oppb.v1.ProviderService.EndSessionConfirm:output_type -> oppb.v1.EndSessionConfirmResponse
+	5,  // 98: oppb.v1.ProviderService.CheckSessionIframe:output_type -> oppb.v1.CheckSessionIframeResponse
+	7,  // 99: oppb.v1.ProviderService.DeviceAuthorization:output_type -> oppb.v1.DeviceAuthorizationResponse
+	9,  // 100: oppb.v1.ProviderService.DeviceVerification:output_type -> oppb.v1.DeviceVerificationResponse
+	11, // 101: oppb.v1.ProviderService.BackchannelAuthentication:output_type -> oppb.v1.BackchannelAuthenticationResponse
+	13, // 102: oppb.v1.ProviderService.BackchannelAuthenticationIssue:output_type -> oppb.v1.BackchannelAuthenticationIssueResponse
+	15, // 103: oppb.v1.ProviderService.BackchannelAuthenticationCancel:output_type -> oppb.v1.BackchannelAuthenticationCancelResponse
+	81, // [81:104] is the sub-list for method output_type
+	58, // [58:81] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_oppb_v1_provider_service_proto_init() }
@@ -2385,36 +4859,62 @@ func file_oppb_v1_provider_service_proto_init() {
 	file_oppb_v1_client_meta_proto_init()
 	file_oppb_v1_jwks_proto_init()
 	file_oppb_v1_registration_proto_init()
-	file_oppb_v1_provider_service_proto_msgTypes[5].OneofWrappers = []any{
+	file_oppb_v1_provider_service_proto_msgTypes[7].OneofWrappers = []any{
+		(*DeviceAuthorizationResponse_Success)(nil),
+		(*DeviceAuthorizationResponse_Fail)(nil),
+	}
+	file_oppb_v1_provider_service_proto_msgTypes[9].OneofWrappers = []any{
+		(*DeviceVerificationResponse_Html)(nil),
+		(*DeviceVerificationResponse_Login)(nil),
+	}
+	file_oppb_v1_provider_service_proto_msgTypes[11].OneofWrappers = []any{
+		(*BackchannelAuthenticationResponse_Success)(nil),
+		(*BackchannelAuthenticationResponse_Fail)(nil),
+	}
+	file_oppb_v1_provider_service_proto_msgTypes[17].OneofWrappers = []any{
 		(*AuthorizationResponse_Fail)(nil),
 		(*AuthorizationResponse_Login)(nil),
 		(*AuthorizationResponse_Issue)(nil),
 		(*AuthorizationResponse_Redirect)(nil),
 		(*AuthorizationResponse_Html)(nil),
+		(*AuthorizationResponse_Consent)(nil),
 	}
-	file_oppb_v1_provider_service_proto_msgTypes[7].OneofWrappers = []any{
+	file_oppb_v1_provider_service_proto_msgTypes[19].OneofWrappers = []any{
 		(*AuthorizationIssueResponse_Redirect)(nil),
 		(*AuthorizationIssueResponse_Html)(nil),
+		(*AuthorizationIssueResponse_Consent)(nil),
 	}
-	file_oppb_v1_provider_service_proto_msgTypes[9].OneofWrappers = []any{
+	file_oppb_v1_provider_service_proto_msgTypes[21].OneofWrappers = []any{
 		(*AuthorizationCancelResponse_Redirect)(nil),
 		(*AuthorizationCancelResponse_Html)(nil),
 	}
-	file_oppb_v1_provider_service_proto_msgTypes[13].OneofWrappers = []any{
+	file_oppb_v1_provider_service_proto_msgTypes[25].OneofWrappers = []any{
 		(*TokenResponse_Success)(nil),
 		(*TokenResponse_Fail)(nil),
 	}
-	file_oppb_v1_provider_service_proto_msgTypes[17].OneofWrappers = []any{
+	file_oppb_v1_provider_service_proto_msgTypes[29].OneofWrappers = []any{
 		(*PushedAuthorizationResponse_Success)(nil),
 		(*PushedAuthorizationResponse_Fail)(nil),
 	}
+	file_oppb_v1_provider_service_proto_msgTypes[33].OneofWrappers = []any{
+		(*RevocationResponse_Success)(nil),
+		(*RevocationResponse_Fail)(nil),
+	}
+	file_oppb_v1_provider_service_proto_msgTypes[37].OneofWrappers = []any{
+		(*EndSessionResponse_Fail)(nil),
+		(*EndSessionResponse_Confirm)(nil),
+	}
+	file_oppb_v1_provider_service_proto_msgTypes[39].OneofWrappers = []any{
+		(*EndSessionConfirmResponse_Redirect)(nil),
+		(*EndSessionConfirmResponse_Html)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oppb_v1_provider_service_proto_rawDesc), len(file_oppb_v1_provider_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -87,8 +87,33 @@ type RegistrationCreateRequest struct {
 	AuthorizationEncryptedResponseEnc string `protobuf:"bytes,135,opt,name=authorization_encrypted_response_enc,proto3" json:"authorization_encrypted_response_enc,omitempty"`
 	// https://datatracker.ietf.org/doc/html/rfc8705#section-3.4
 	TlsClientCertificateBoundAccessTokens bool `protobuf:"varint,136,opt,name=tls_client_certificate_bound_access_tokens,proto3" json:"tls_client_certificate_bound_access_tokens,omitempty"`
-	unknownFields                         protoimpl.UnknownFields
-	sizeCache                             protoimpl.SizeCache
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-6
+	IntrospectionSignedResponseAlg string `protobuf:"bytes,137,opt,name=introspection_signed_response_alg,proto3" json:"introspection_signed_response_alg,omitempty"`
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRegistration
+	BackchannelLogoutUri             string `protobuf:"bytes,138,opt,name=backchannel_logout_uri,proto3" json:"backchannel_logout_uri,omitempty"`
+	BackchannelLogoutSessionRequired bool   `protobuf:"varint,139,opt,name=backchannel_logout_session_required,proto3" json:"backchannel_logout_session_required,omitempty"`
+	// https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPLogout
+	FrontchannelLogoutUri             string `protobuf:"bytes,140,opt,name=frontchannel_logout_uri,proto3" json:"frontchannel_logout_uri,omitempty"`
+	FrontchannelLogoutSessionRequired bool   `protobuf:"varint,141,opt,name=frontchannel_logout_session_required,proto3" json:"frontchannel_logout_session_required,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc7591.html#section-2
+	Scope string `protobuf:"bytes,142,opt,name=scope,proto3" json:"scope,omitempty"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+	BackchannelTokenDeliveryMode               string `protobuf:"bytes,143,opt,name=backchannel_token_delivery_mode,proto3" json:"backchannel_token_delivery_mode,omitempty"`
+	BackchannelClientNotificationEndpoint      string `protobuf:"bytes,144,opt,name=backchannel_client_notification_endpoint,proto3" json:"backchannel_client_notification_endpoint,omitempty"`
+	BackchannelAuthenticationRequestSigningAlg string `protobuf:"bytes,145,opt,name=backchannel_authentication_request_signing_alg,proto3" json:"backchannel_authentication_request_signing_alg,omitempty"`
+	BackchannelUserCodeParameter               bool   `protobuf:"varint,146,opt,name=backchannel_user_code_parameter,proto3" json:"backchannel_user_code_parameter,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1.2
+	TlsClientAuthSubjectDn string `protobuf:"bytes,147,opt,name=tls_client_auth_subject_dn,proto3" json:"tls_client_auth_subject_dn,omitempty"`
+	TlsClientAuthSanDns    string `protobuf:"bytes,148,opt,name=tls_client_auth_san_dns,proto3" json:"tls_client_auth_san_dns,omitempty"`
+	TlsClientAuthSanUri    string `protobuf:"bytes,149,opt,name=tls_client_auth_san_uri,proto3" json:"tls_client_auth_san_uri,omitempty"`
+	TlsClientAuthSanIp     string `protobuf:"bytes,150,opt,name=tls_client_auth_san_ip,proto3" json:"tls_client_auth_san_ip,omitempty"`
+	TlsClientAuthSanEmail  string `protobuf:"bytes,151,opt,name=tls_client_auth_san_email,proto3" json:"tls_client_auth_san_email,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+	DpopBoundAccessTokens bool `protobuf:"varint,152,opt,name=dpop_bound_access_tokens,proto3" json:"dpop_bound_access_tokens,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+	AuthorizationDetailsTypes []string `protobuf:"bytes,153,rep,name=authorization_details_types,proto3" json:"authorization_details_types,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *RegistrationCreateRequest) Reset() {
@@ -373,6 +398,125 @@ func (x *RegistrationCreateRequest) GetTlsClientCertificateBoundAccessTokens() b
 	return false
 }
 
+func (x *RegistrationCreateRequest) GetIntrospectionSignedResponseAlg() string {
+	if x != nil {
+		return x.IntrospectionSignedResponseAlg
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetBackchannelLogoutUri() string {
+	if x != nil {
+		return x.BackchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetBackchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.BackchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *RegistrationCreateRequest) GetFrontchannelLogoutUri() string {
+	if x != nil {
+		return x.FrontchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetFrontchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.FrontchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *RegistrationCreateRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetBackchannelTokenDeliveryMode() string {
+	if x != nil {
+		return x.BackchannelTokenDeliveryMode
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetBackchannelClientNotificationEndpoint() string {
+	if x != nil {
+		return x.BackchannelClientNotificationEndpoint
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetBackchannelAuthenticationRequestSigningAlg() string {
+	if x != nil {
+		return x.BackchannelAuthenticationRequestSigningAlg
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetBackchannelUserCodeParameter() bool {
+	if x != nil {
+		return x.BackchannelUserCodeParameter
+	}
+	return false
+}
+
+func (x *RegistrationCreateRequest) GetTlsClientAuthSubjectDn() string {
+	if x != nil {
+		return x.TlsClientAuthSubjectDn
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetTlsClientAuthSanDns() string {
+	if x != nil {
+		return x.TlsClientAuthSanDns
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetTlsClientAuthSanUri() string {
+	if x != nil {
+		return x.TlsClientAuthSanUri
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetTlsClientAuthSanIp() string {
+	if x != nil {
+		return x.TlsClientAuthSanIp
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetTlsClientAuthSanEmail() string {
+	if x != nil {
+		return x.TlsClientAuthSanEmail
+	}
+	return ""
+}
+
+func (x *RegistrationCreateRequest) GetDpopBoundAccessTokens() bool {
+	if x != nil {
+		return x.DpopBoundAccessTokens
+	}
+	return false
+}
+
+func (x *RegistrationCreateRequest) GetAuthorizationDetailsTypes() []string {
+	if x != nil {
+		return x.AuthorizationDetailsTypes
+	}
+	return nil
+}
+
 type RegistrationCreateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to RegistrationCreateResponseOneof:
@@ -779,8 +923,33 @@ type RegistrationCreateSuccessResponse struct {
 	AuthorizationEncryptedResponseEnc string `protobuf:"bytes,135,opt,name=authorization_encrypted_response_enc,proto3" json:"authorization_encrypted_response_enc,omitempty"`
 	// https://datatracker.ietf.org/doc/html/rfc8705#section-3.4
 	TlsClientCertificateBoundAccessTokens bool `protobuf:"varint,136,opt,name=tls_client_certificate_bound_access_tokens,proto3" json:"tls_client_certificate_bound_access_tokens,omitempty"`
-	unknownFields                         protoimpl.UnknownFields
-	sizeCache                             protoimpl.SizeCache
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-6
+	IntrospectionSignedResponseAlg string `protobuf:"bytes,137,opt,name=introspection_signed_response_alg,proto3" json:"introspection_signed_response_alg,omitempty"`
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRegistration
+	BackchannelLogoutUri             string `protobuf:"bytes,138,opt,name=backchannel_logout_uri,proto3" json:"backchannel_logout_uri,omitempty"`
+	BackchannelLogoutSessionRequired bool   `protobuf:"varint,139,opt,name=backchannel_logout_session_required,proto3" json:"backchannel_logout_session_required,omitempty"`
+	// https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPLogout
+	FrontchannelLogoutUri             string `protobuf:"bytes,140,opt,name=frontchannel_logout_uri,proto3" json:"frontchannel_logout_uri,omitempty"`
+	FrontchannelLogoutSessionRequired bool   `protobuf:"varint,141,opt,name=frontchannel_logout_session_required,proto3" json:"frontchannel_logout_session_required,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc7591.html#section-2
+	Scope string `protobuf:"bytes,142,opt,name=scope,proto3" json:"scope,omitempty"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+	BackchannelTokenDeliveryMode               string `protobuf:"bytes,143,opt,name=backchannel_token_delivery_mode,proto3" json:"backchannel_token_delivery_mode,omitempty"`
+	BackchannelClientNotificationEndpoint      string `protobuf:"bytes,144,opt,name=backchannel_client_notification_endpoint,proto3" json:"backchannel_client_notification_endpoint,omitempty"`
+	BackchannelAuthenticationRequestSigningAlg string `protobuf:"bytes,145,opt,name=backchannel_authentication_request_signing_alg,proto3" json:"backchannel_authentication_request_signing_alg,omitempty"`
+	BackchannelUserCodeParameter               bool   `protobuf:"varint,146,opt,name=backchannel_user_code_parameter,proto3" json:"backchannel_user_code_parameter,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1.2
+	TlsClientAuthSubjectDn string `protobuf:"bytes,147,opt,name=tls_client_auth_subject_dn,proto3" json:"tls_client_auth_subject_dn,omitempty"`
+	TlsClientAuthSanDns    string `protobuf:"bytes,148,opt,name=tls_client_auth_san_dns,proto3" json:"tls_client_auth_san_dns,omitempty"`
+	TlsClientAuthSanUri    string `protobuf:"bytes,149,opt,name=tls_client_auth_san_uri,proto3" json:"tls_client_auth_san_uri,omitempty"`
+	TlsClientAuthSanIp     string `protobuf:"bytes,150,opt,name=tls_client_auth_san_ip,proto3" json:"tls_client_auth_san_ip,omitempty"`
+	TlsClientAuthSanEmail  string `protobuf:"bytes,151,opt,name=tls_client_auth_san_email,proto3" json:"tls_client_auth_san_email,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+	DpopBoundAccessTokens bool `protobuf:"varint,152,opt,name=dpop_bound_access_tokens,proto3" json:"dpop_bound_access_tokens,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+	AuthorizationDetailsTypes []string `protobuf:"bytes,153,rep,name=authorization_details_types,proto3" json:"authorization_details_types,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *RegistrationCreateSuccessResponse) Reset() {
@@ -1107,6 +1276,125 @@ func (x *RegistrationCreateSuccessResponse) GetTlsClientCertificateBoundAccessTo
 	return false
 }
 
+func (x *RegistrationCreateSuccessResponse) GetIntrospectionSignedResponseAlg() string {
+	if x != nil {
+		return x.IntrospectionSignedResponseAlg
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetBackchannelLogoutUri() string {
+	if x != nil {
+		return x.BackchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetBackchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.BackchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *RegistrationCreateSuccessResponse) GetFrontchannelLogoutUri() string {
+	if x != nil {
+		return x.FrontchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetFrontchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.FrontchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *RegistrationCreateSuccessResponse) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetBackchannelTokenDeliveryMode() string {
+	if x != nil {
+		return x.BackchannelTokenDeliveryMode
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetBackchannelClientNotificationEndpoint() string {
+	if x != nil {
+		return x.BackchannelClientNotificationEndpoint
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetBackchannelAuthenticationRequestSigningAlg() string {
+	if x != nil {
+		return x.BackchannelAuthenticationRequestSigningAlg
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetBackchannelUserCodeParameter() bool {
+	if x != nil {
+		return x.BackchannelUserCodeParameter
+	}
+	return false
+}
+
+func (x *RegistrationCreateSuccessResponse) GetTlsClientAuthSubjectDn() string {
+	if x != nil {
+		return x.TlsClientAuthSubjectDn
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetTlsClientAuthSanDns() string {
+	if x != nil {
+		return x.TlsClientAuthSanDns
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetTlsClientAuthSanUri() string {
+	if x != nil {
+		return x.TlsClientAuthSanUri
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetTlsClientAuthSanIp() string {
+	if x != nil {
+		return x.TlsClientAuthSanIp
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetTlsClientAuthSanEmail() string {
+	if x != nil {
+		return x.TlsClientAuthSanEmail
+	}
+	return ""
+}
+
+func (x *RegistrationCreateSuccessResponse) GetDpopBoundAccessTokens() bool {
+	if x != nil {
+		return x.DpopBoundAccessTokens
+	}
+	return false
+}
+
+func (x *RegistrationCreateSuccessResponse) GetAuthorizationDetailsTypes() []string {
+	if x != nil {
+		return x.AuthorizationDetailsTypes
+	}
+	return nil
+}
+
 type RegistrationGetSuccessResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// ClientIdentity
@@ -1159,8 +1447,33 @@ type RegistrationGetSuccessResponse struct {
 	AuthorizationEncryptedResponseEnc string `protobuf:"bytes,135,opt,name=authorization_encrypted_response_enc,proto3" json:"authorization_encrypted_response_enc,omitempty"`
 	// https://datatracker.ietf.org/doc/html/rfc8705#section-3.4
 	TlsClientCertificateBoundAccessTokens bool `protobuf:"varint,136,opt,name=tls_client_certificate_bound_access_tokens,proto3" json:"tls_client_certificate_bound_access_tokens,omitempty"`
-	unknownFields                         protoimpl.UnknownFields
-	sizeCache                             protoimpl.SizeCache
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-6
+	IntrospectionSignedResponseAlg string `protobuf:"bytes,137,opt,name=introspection_signed_response_alg,proto3" json:"introspection_signed_response_alg,omitempty"`
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRegistration
+	BackchannelLogoutUri             string `protobuf:"bytes,138,opt,name=backchannel_logout_uri,proto3" json:"backchannel_logout_uri,omitempty"`
+	BackchannelLogoutSessionRequired bool   `protobuf:"varint,139,opt,name=backchannel_logout_session_required,proto3" json:"backchannel_logout_session_required,omitempty"`
+	// https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPLogout
+	FrontchannelLogoutUri             string `protobuf:"bytes,140,opt,name=frontchannel_logout_uri,proto3" json:"frontchannel_logout_uri,omitempty"`
+	FrontchannelLogoutSessionRequired bool   `protobuf:"varint,141,opt,name=frontchannel_logout_session_required,proto3" json:"frontchannel_logout_session_required,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc7591.html#section-2
+	Scope string `protobuf:"bytes,142,opt,name=scope,proto3" json:"scope,omitempty"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+	BackchannelTokenDeliveryMode               string `protobuf:"bytes,143,opt,name=backchannel_token_delivery_mode,proto3" json:"backchannel_token_delivery_mode,omitempty"`
+	BackchannelClientNotificationEndpoint      string `protobuf:"bytes,144,opt,name=backchannel_client_notification_endpoint,proto3" json:"backchannel_client_notification_endpoint,omitempty"`
+	BackchannelAuthenticationRequestSigningAlg string `protobuf:"bytes,145,opt,name=backchannel_authentication_request_signing_alg,proto3" json:"backchannel_authentication_request_signing_alg,omitempty"`
+	BackchannelUserCodeParameter               bool   `protobuf:"varint,146,opt,name=backchannel_user_code_parameter,proto3" json:"backchannel_user_code_parameter,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1.2
+	TlsClientAuthSubjectDn string `protobuf:"bytes,147,opt,name=tls_client_auth_subject_dn,proto3" json:"tls_client_auth_subject_dn,omitempty"`
+	TlsClientAuthSanDns    string `protobuf:"bytes,148,opt,name=tls_client_auth_san_dns,proto3" json:"tls_client_auth_san_dns,omitempty"`
+	TlsClientAuthSanUri    string `protobuf:"bytes,149,opt,name=tls_client_auth_san_uri,proto3" json:"tls_client_auth_san_uri,omitempty"`
+	TlsClientAuthSanIp     string `protobuf:"bytes,150,opt,name=tls_client_auth_san_ip,proto3" json:"tls_client_auth_san_ip,omitempty"`
+	TlsClientAuthSanEmail  string `protobuf:"bytes,151,opt,name=tls_client_auth_san_email,proto3" json:"tls_client_auth_san_email,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+	DpopBoundAccessTokens bool `protobuf:"varint,152,opt,name=dpop_bound_access_tokens,proto3" json:"dpop_bound_access_tokens,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+	AuthorizationDetailsTypes []string `protobuf:"bytes,153,rep,name=authorization_details_types,proto3" json:"authorization_details_types,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *RegistrationGetSuccessResponse) Reset() {
@@ -1473,6 +1786,125 @@ func (x *RegistrationGetSuccessResponse) GetTlsClientCertificateBoundAccessToken
 	return false
 }
 
+func (x *RegistrationGetSuccessResponse) GetIntrospectionSignedResponseAlg() string {
+	if x != nil {
+		return x.IntrospectionSignedResponseAlg
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetBackchannelLogoutUri() string {
+	if x != nil {
+		return x.BackchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetBackchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.BackchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *RegistrationGetSuccessResponse) GetFrontchannelLogoutUri() string {
+	if x != nil {
+		return x.FrontchannelLogoutUri
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetFrontchannelLogoutSessionRequired() bool {
+	if x != nil {
+		return x.FrontchannelLogoutSessionRequired
+	}
+	return false
+}
+
+func (x *RegistrationGetSuccessResponse) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetBackchannelTokenDeliveryMode() string {
+	if x != nil {
+		return x.BackchannelTokenDeliveryMode
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetBackchannelClientNotificationEndpoint() string {
+	if x != nil {
+		return x.BackchannelClientNotificationEndpoint
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetBackchannelAuthenticationRequestSigningAlg() string {
+	if x != nil {
+		return x.BackchannelAuthenticationRequestSigningAlg
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetBackchannelUserCodeParameter() bool {
+	if x != nil {
+		return x.BackchannelUserCodeParameter
+	}
+	return false
+}
+
+func (x *RegistrationGetSuccessResponse) GetTlsClientAuthSubjectDn() string {
+	if x != nil {
+		return x.TlsClientAuthSubjectDn
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetTlsClientAuthSanDns() string {
+	if x != nil {
+		return x.TlsClientAuthSanDns
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetTlsClientAuthSanUri() string {
+	if x != nil {
+		return x.TlsClientAuthSanUri
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetTlsClientAuthSanIp() string {
+	if x != nil {
+		return x.TlsClientAuthSanIp
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetTlsClientAuthSanEmail() string {
+	if x != nil {
+		return x.TlsClientAuthSanEmail
+	}
+	return ""
+}
+
+func (x *RegistrationGetSuccessResponse) GetDpopBoundAccessTokens() bool {
+	if x != nil {
+		return x.DpopBoundAccessTokens
+	}
+	return false
+}
+
+func (x *RegistrationGetSuccessResponse) GetAuthorizationDetailsTypes() []string {
+	if x != nil {
+		return x.AuthorizationDetailsTypes
+	}
+	return nil
+}
+
 type RegistrationDeleteSuccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1618,7 +2050,7 @@ var File_oppb_v1_registration_proto protoreflect.FileDescriptor
 
 const file_oppb_v1_registration_proto_rawDesc = "" +
 	"\n" +
-	"\x1aoppb/v1/registration.proto\x12\aoppb.v1\x1a\x12oppb/v1/jwks.proto\"\xd9\x0f\n" +
+	"\x1aoppb/v1/registration.proto\x12\aoppb.v1\x1a\x12oppb/v1/jwks.proto\"\xe6\x18\n" +
 	"\x19RegistrationCreateRequest\x12$\n" +
 	"\rredirect_uris\x18e \x03(\tR\rredirect_uris\x12&\n" +
 	"\x0eresponse_types\x18f \x03(\tR\x0eresponse_types\x12 \n" +
@@ -1659,7 +2091,24 @@ const file_oppb_v1_registration_proto_rawDesc = "" +
 	"!authorization_signed_response_alg\x18\x85\x01 \x01(\tR!authorization_signed_response_alg\x12S\n" +
 	"$authorization_encrypted_response_alg\x18\x86\x01 \x01(\tR$authorization_encrypted_response_alg\x12S\n" +
 	"$authorization_encrypted_response_enc\x18\x87\x01 \x01(\tR$authorization_encrypted_response_enc\x12_\n" +
-	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\"\xc3\x01\n" +
+	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\x12M\n" +
+	"!introspection_signed_response_alg\x18\x89\x01 \x01(\tR!introspection_signed_response_alg\x127\n" +
+	"\x16backchannel_logout_uri\x18\x8a\x01 \x01(\tR\x16backchannel_logout_uri\x12Q\n" +
+	"#backchannel_logout_session_required\x18\x8b\x01 \x01(\bR#backchannel_logout_session_required\x129\n" +
+	"\x17frontchannel_logout_uri\x18\x8c\x01 \x01(\tR\x17frontchannel_logout_uri\x12S\n" +
+	"$frontchannel_logout_session_required\x18\x8d\x01 \x01(\bR$frontchannel_logout_session_required\x12\x15\n" +
+	"\x05scope\x18\x8e\x01 \x01(\tR\x05scope\x12I\n" +
+	"\x1fbackchannel_token_delivery_mode\x18\x8f\x01 \x01(\tR\x1fbackchannel_token_delivery_mode\x12[\n" +
+	"(backchannel_client_notification_endpoint\x18\x90\x01 \x01(\tR(backchannel_client_notification_endpoint\x12g\n" +
+	".backchannel_authentication_request_signing_alg\x18\x91\x01 \x01(\tR.backchannel_authentication_request_signing_alg\x12I\n" +
+	"\x1fbackchannel_user_code_parameter\x18\x92\x01 \x01(\bR\x1fbackchannel_user_code_parameter\x12?\n" +
+	"\x1atls_client_auth_subject_dn\x18\x93\x01 \x01(\tR\x1atls_client_auth_subject_dn\x129\n" +
+	"\x17tls_client_auth_san_dns\x18\x94\x01 \x01(\tR\x17tls_client_auth_san_dns\x129\n" +
+	"\x17tls_client_auth_san_uri\x18\x95\x01 \x01(\tR\x17tls_client_auth_san_uri\x127\n" +
+	"\x16tls_client_auth_san_ip\x18\x96\x01 \x01(\tR\x16tls_client_auth_san_ip\x12=\n" +
+	"\x19tls_client_auth_san_email\x18\x97\x01 \x01(\tR\x19tls_client_auth_san_email\x12;\n" +
+	"\x18dpop_bound_access_tokens\x18\x98\x01 \x01(\bR\x18dpop_bound_access_tokens\x12A\n" +
+	"\x1bauthorization_details_types\x18\x99\x01 \x03(\tR\x1bauthorization_details_types\"\xc3\x01\n" +
 	"\x1aRegistrationCreateResponse\x12F\n" +
 	"\asuccess\x18\x01 \x01(\v2*.oppb.v1.RegistrationCreateSuccessResponseH\x00R\asuccess\x127\n" +
 	"\x04fail\x18\x02 \x01(\v2!.oppb.v1.RegistrationFailResponseH\x00R\x04failB$\n" +
@@ -1677,7 +2126,7 @@ const file_oppb_v1_registration_proto_rawDesc = "" +
 	"\x1aRegistrationDeleteResponse\x12F\n" +
 	"\asuccess\x18\x01 \x01(\v2*.oppb.v1.RegistrationDeleteSuccessResponseH\x00R\asuccess\x127\n" +
 	"\x04fail\x18\x02 \x01(\v2!.oppb.v1.RegistrationFailResponseH\x00R\x04failB$\n" +
-	"\"registration_delete_response_oneof\"\x8b\x12\n" +
+	"\"registration_delete_response_oneof\"\x98\x1b\n" +
 	"!RegistrationCreateSuccessResponse\x12\x1c\n" +
 	"\tclient_id\x18\x01 \x01(\tR\tclient_id\x12$\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\rclient_secret\x12<\n" +
@@ -1724,7 +2173,24 @@ const file_oppb_v1_registration_proto_rawDesc = "" +
 	"!authorization_signed_response_alg\x18\x85\x01 \x01(\tR!authorization_signed_response_alg\x12S\n" +
 	"$authorization_encrypted_response_alg\x18\x86\x01 \x01(\tR$authorization_encrypted_response_alg\x12S\n" +
 	"$authorization_encrypted_response_enc\x18\x87\x01 \x01(\tR$authorization_encrypted_response_enc\x12_\n" +
-	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\"\x90\x11\n" +
+	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\x12M\n" +
+	"!introspection_signed_response_alg\x18\x89\x01 \x01(\tR!introspection_signed_response_alg\x127\n" +
+	"\x16backchannel_logout_uri\x18\x8a\x01 \x01(\tR\x16backchannel_logout_uri\x12Q\n" +
+	"#backchannel_logout_session_required\x18\x8b\x01 \x01(\bR#backchannel_logout_session_required\x129\n" +
+	"\x17frontchannel_logout_uri\x18\x8c\x01 \x01(\tR\x17frontchannel_logout_uri\x12S\n" +
+	"$frontchannel_logout_session_required\x18\x8d\x01 \x01(\bR$frontchannel_logout_session_required\x12\x15\n" +
+	"\x05scope\x18\x8e\x01 \x01(\tR\x05scope\x12I\n" +
+	"\x1fbackchannel_token_delivery_mode\x18\x8f\x01 \x01(\tR\x1fbackchannel_token_delivery_mode\x12[\n" +
+	"(backchannel_client_notification_endpoint\x18\x90\x01 \x01(\tR(backchannel_client_notification_endpoint\x12g\n" +
+	".backchannel_authentication_request_signing_alg\x18\x91\x01 \x01(\tR.backchannel_authentication_request_signing_alg\x12I\n" +
+	"\x1fbackchannel_user_code_parameter\x18\x92\x01 \x01(\bR\x1fbackchannel_user_code_parameter\x12?\n" +
+	"\x1atls_client_auth_subject_dn\x18\x93\x01 \x01(\tR\x1atls_client_auth_subject_dn\x129\n" +
+	"\x17tls_client_auth_san_dns\x18\x94\x01 \x01(\tR\x17tls_client_auth_san_dns\x129\n" +
+	"\x17tls_client_auth_san_uri\x18\x95\x01 \x01(\tR\x17tls_client_auth_san_uri\x127\n" +
+	"\x16tls_client_auth_san_ip\x18\x96\x01 \x01(\tR\x16tls_client_auth_san_ip\x12=\n" +
+	"\x19tls_client_auth_san_email\x18\x97\x01 \x01(\tR\x19tls_client_auth_san_email\x12;\n" +
+	"\x18dpop_bound_access_tokens\x18\x98\x01 \x01(\bR\x18dpop_bound_access_tokens\x12A\n" +
+	"\x1bauthorization_details_types\x18\x99\x01 \x03(\tR\x1bauthorization_details_types\"\x9d\x1a\n" +
 	"\x1eRegistrationGetSuccessResponse\x12\x1c\n" +
 	"\tclient_id\x18\x01 \x01(\tR\tclient_id\x12$\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\rclient_secret\x120\n" +
@@ -1769,7 +2235,24 @@ const file_oppb_v1_registration_proto_rawDesc = "" +
 	"!authorization_signed_response_alg\x18\x85\x01 \x01(\tR!authorization_signed_response_alg\x12S\n" +
 	"$authorization_encrypted_response_alg\x18\x86\x01 \x01(\tR$authorization_encrypted_response_alg\x12S\n" +
 	"$authorization_encrypted_response_enc\x18\x87\x01 \x01(\tR$authorization_encrypted_response_enc\x12_\n" +
-	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\"#\n" +
+	"*tls_client_certificate_bound_access_tokens\x18\x88\x01 \x01(\bR*tls_client_certificate_bound_access_tokens\x12M\n" +
+	"!introspection_signed_response_alg\x18\x89\x01 \x01(\tR!introspection_signed_response_alg\x127\n" +
+	"\x16backchannel_logout_uri\x18\x8a\x01 \x01(\tR\x16backchannel_logout_uri\x12Q\n" +
+	"#backchannel_logout_session_required\x18\x8b\x01 \x01(\bR#backchannel_logout_session_required\x129\n" +
+	"\x17frontchannel_logout_uri\x18\x8c\x01 \x01(\tR\x17frontchannel_logout_uri\x12S\n" +
+	"$frontchannel_logout_session_required\x18\x8d\x01 \x01(\bR$frontchannel_logout_session_required\x12\x15\n" +
+	"\x05scope\x18\x8e\x01 \x01(\tR\x05scope\x12I\n" +
+	"\x1fbackchannel_token_delivery_mode\x18\x8f\x01 \x01(\tR\x1fbackchannel_token_delivery_mode\x12[\n" +
+	"(backchannel_client_notification_endpoint\x18\x90\x01 \x01(\tR(backchannel_client_notification_endpoint\x12g\n" +
+	".backchannel_authentication_request_signing_alg\x18\x91\x01 \x01(\tR.backchannel_authentication_request_signing_alg\x12I\n" +
+	"\x1fbackchannel_user_code_parameter\x18\x92\x01 \x01(\bR\x1fbackchannel_user_code_parameter\x12?\n" +
+	"\x1atls_client_auth_subject_dn\x18\x93\x01 \x01(\tR\x1atls_client_auth_subject_dn\x129\n" +
+	"\x17tls_client_auth_san_dns\x18\x94\x01 \x01(\tR\x17tls_client_auth_san_dns\x129\n" +
+	"\x17tls_client_auth_san_uri\x18\x95\x01 \x01(\tR\x17tls_client_auth_san_uri\x127\n" +
+	"\x16tls_client_auth_san_ip\x18\x96\x01 \x01(\tR\x16tls_client_auth_san_ip\x12=\n" +
+	"\x19tls_client_auth_san_email\x18\x97\x01 \x01(\tR\x19tls_client_auth_san_email\x12;\n" +
+	"\x18dpop_bound_access_tokens\x18\x98\x01 \x01(\bR\x18dpop_bound_access_tokens\x12A\n" +
+	"\x1bauthorization_details_types\x18\x99\x01 \x03(\tR\x1bauthorization_details_types\"#\n" +
 	"!RegistrationDeleteSuccessResponse\"m\n" +
 	"\x18RegistrationFailResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
@@ -63,6 +63,10 @@ func (i *inner) DeleteTokensWithRequetId(ctx context.Context, issuerId, requestI
 }
 
 func (i *inner) DeleteTokensWithSessionId(ctx context.Context, issuerId, sessionId string) error {
+	// client_credentials などエンドユーザーのセッションがないトークンは対象外
+	if sessionId == "" {
+		return nil
+	}
 	q := i.CloudFirestore.Collection(model.GetTokenIdentiferCollectionName(issuerId)).Where("SessionId", "==", sessionId)
 	_, err := i.CloudFirestore.DeleteWithQuery(ctx, q, 10)
 	return err
@@ -81,6 +85,13 @@ func construction(ctx context.Context, projectID, databaseID string) {
 	setupTTL(ctx, admin, projectID, databaseID, "sessions", "ExpireAt")
 	setupTTL(ctx, admin, projectID, databaseID, "tokens", "ExpireAt")
 	setupTTL(ctx, admin, projectID, databaseID, "pars", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "logoutRequests", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "deviceAuthorizations", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "deviceUserCodes", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "backchannelAuthentications", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "assertions", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "dpopProofs", "ExpireAt")
+	setupTTL(ctx, admin, projectID, databaseID, "dpopNonces", "ExpireAt")
 }
 
 func setupTTL(ctx context.Context, admin *apiv1.FirestoreAdminClient, projectID, databaseID, collectionId, fieldName string) {
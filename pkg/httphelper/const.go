@@ -23,11 +23,14 @@
 package httphelper
 
 const (
+	HeaderAccept                   = "Accept"
 	HeaderAccessControlAllowOrigin = "Access-Control-Allow-Origin"
 	HeaderAuthorization            = "Authorization"
 	HeaderCacheControl             = "Cache-Control"
 	HeaderContentType              = "Content-Type"
 	HeaderContentTypeOptions       = "X-Content-Type-Options"
+	HeaderDpop                     = "DPoP"
+	HeaderDpopNonce                = "DPoP-Nonce"
 	HeaderPragma                   = "Pragma"
 )
 
@@ -38,6 +41,8 @@ const (
 	MimeTypeTextHtml          = "text/html"
 	MimeTypeTextPlain         = "text/plain"
 	MimeTypeWwwFormUnlencoded = "application/x-www-form-urlencoded"
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-12.3
+	MimeTypeTokenIntrospectionJwt = "application/token-introspection+jwt"
 	//
 	DefaultCharSet = "; charset=UTF-8"
 )
@@ -64,7 +64,11 @@ func (inner) DeleteTokensWithRequetId(ctx context.Context, issuerId, requestId s
 }
 
 func (inner) DeleteTokensWithSessionId(ctx context.Context, issuerId, sessionId string) error {
-	// request_id base link
+	// client_credentials などエンドユーザーのセッションがないトークンはリンクしていない
+	if sessionId == "" {
+		return nil
+	}
+	// session_id base link
 	link := &tokenIdentifierLink{
 		IssuerId: issuerId,
 		Key:      sessionId,
@@ -91,6 +95,10 @@ func TokenWriteInterceptor(ctx context.Context, data any) {
 		requestLink.ExpireAt = time.Now().Add(24 * time.Hour)
 		_ = dataprovider.Set(ctx, requestLink)
 
+		// client_credentials などエンドユーザーのセッションがないトークンはリンクしない
+		if p.Details.Authorized.SessionId == "" {
+			return
+		}
 		// session_id base link
 		sessionLink := &tokenIdentifierLink{
 			IssuerId: p.Details.Authorized.Request.Client.Issuer.Id,
@@ -0,0 +1,173 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package inmemstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	dataprovider.Initialize(New(1 * time.Minute))
+	dataprovider.AddWriteOpInterceptor(&model.TokenIdentifier{}, TokenWriteInterceptor)
+	_ = m.Run()
+}
+
+func TestTokenWriteInterceptor(t *testing.T) {
+	ctx := context.Background()
+	issuer := &oppb.CommonKey{Id: "issuer-1"}
+
+	type testCase struct {
+		name        string
+		requestId   string
+		sessionId   string
+		wantSession bool
+	}
+
+	tests := []testCase{
+		{
+			name:        "Token of an end-user session",
+			requestId:   "request-1",
+			sessionId:   "session-1",
+			wantSession: true,
+		},
+		{
+			// client_credentials, jwt-bearer
+			name:        "Token without a session",
+			requestId:   "request-2",
+			sessionId:   "",
+			wantSession: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			token := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: "token-" + tc.requestId,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Key:    &oppb.CommonKey{Id: tc.requestId},
+							Client: &model.Client{Issuer: issuer},
+						},
+						SessionId: tc.sessionId,
+					},
+				},
+				ExpireAt:  time.Now().Add(time.Hour),
+				RequestId: tc.requestId,
+				SessionId: tc.sessionId,
+			}
+			if err := dataprovider.Create(ctx, token); err != nil {
+				t.Fatal(err)
+			}
+
+			requestLink := &tokenIdentifierLink{IssuerId: issuer.Id, Key: tc.requestId, Kind: "request"}
+			if assert.NoError(t, dataprovider.Get(ctx, requestLink)) {
+				assert.Equal(t, []string{token.Details.Identifier}, requestLink.List)
+			}
+			sessionLink := &tokenIdentifierLink{IssuerId: issuer.Id, Key: tc.sessionId, Kind: "session"}
+			err := dataprovider.Get(ctx, sessionLink)
+			if tc.wantSession {
+				if assert.NoError(t, err) {
+					assert.Equal(t, []string{token.Details.Identifier}, sessionLink.List)
+				}
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteTokensWithSessionId(t *testing.T) {
+	ctx := context.Background()
+	store := New(1 * time.Minute)
+	issuer := &oppb.CommonKey{Id: "issuer-2"}
+
+	newToken := func(identifier, sessionId string) *model.TokenIdentifier {
+		return &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Identifier: identifier,
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Key:    &oppb.CommonKey{Id: "request-" + identifier},
+						Client: &model.Client{Issuer: issuer},
+					},
+					SessionId: sessionId,
+				},
+			},
+			ExpireAt:  time.Now().Add(time.Hour),
+			RequestId: "request-" + identifier,
+			SessionId: sessionId,
+		}
+	}
+	exists := func(identifier string) bool {
+		return dataprovider.Get(ctx, newToken(identifier, "")) == nil
+	}
+
+	type testCase struct {
+		name      string
+		sessionId string
+		deleted   []string
+		remaining []string
+	}
+
+	tests := []testCase{
+		{
+			name:      "Empty session id deletes nothing",
+			sessionId: "",
+			remaining: []string{"user-token", "client-token"},
+		},
+		{
+			name:      "Tokens of the session are deleted",
+			sessionId: "session-2",
+			deleted:   []string{"user-token"},
+			remaining: []string{"client-token"},
+		},
+	}
+
+	for _, token := range []*model.TokenIdentifier{
+		newToken("user-token", "session-2"),
+		newToken("client-token", ""),
+	} {
+		if err := dataprovider.Create(ctx, token); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NoError(t, store.DeleteTokensWithSessionId(ctx, issuer.Id, tc.sessionId))
+			for _, identifier := range tc.deleted {
+				assert.False(t, exists(identifier), identifier)
+			}
+			for _, identifier := range tc.remaining {
+				assert.True(t, exists(identifier), identifier)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+// https://www.rfc-editor.org/rfc/rfc7523.html#section-3
+// 再利用防止のために使用済みの jti を有効期限まで記録する
+type AssertionDetails struct {
+	Issuer   *oppb.CommonKey
+	ClientId string
+	Jti      string
+}
+
+type Assertion struct {
+	CreateAt time.Time
+	Details  AssertionDetails
+	ExpireAt time.Time
+}
+
+func GetAssertionCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/assertions", version, issuerId)
+}
+
+func (a Assertion) Path(_ context.Context) string {
+	// jti は任意の文字列のためハッシュ化してキーにする
+	// 区切り文字を含む client_id や jti で衝突しないように JSON 配列としてエンコードする
+	b, _ := json.Marshal([]string{a.Details.ClientId, a.Details.Jti})
+	hash := sha256.Sum256(b)
+	return GetAssertionCollectionName(a.Details.Issuer.Id) + "/" + base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func (a Assertion) ExpireAtUnix(_ context.Context) int64 {
+	return a.ExpireAt.Unix()
+}
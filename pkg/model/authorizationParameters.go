@@ -47,6 +47,9 @@ type AuthorizationParametersInterface interface {
 	GetCodeChallengeMethod() string
 	GetRequest() string
 	GetRequestUri() string
+	GetDpopJkt() string
+	GetAuthorizationDetails() string
+	GetResources() []string
 	GetIsPar() bool
 	GetParKey() string
 }
@@ -76,6 +79,9 @@ func ClearAuthorizationParameters(meta *oppb.ClientMeta, dst *oppb.Authorization
 	dst.CodeChallengeMethod = ""
 	dst.Request = ""
 	dst.RequestUri = ""
+	dst.DpopJkt = ""
+	dst.AuthorizationDetails = ""
+	dst.Resources = []string{}
 }
 
 func OverrideAuthorizationParameters(
@@ -148,6 +154,15 @@ func OverrideAuthorizationParameters(
 	if len(src.GetRequestUri()) > 0 {
 		dst.RequestUri = src.GetRequestUri()
 	}
+	if len(src.GetDpopJkt()) > 0 {
+		dst.DpopJkt = src.GetDpopJkt()
+	}
+	if len(src.GetAuthorizationDetails()) > 0 {
+		dst.AuthorizationDetails = src.GetAuthorizationDetails()
+	}
+	if len(src.GetResources()) > 0 {
+		dst.Resources = src.GetResources()
+	}
 	dst.IsPar = src.GetIsPar()
 	dst.ParKey = src.GetParKey()
 }
@@ -30,4 +30,8 @@ type Authorized struct {
 	Request   RequestDetails
 	SessionId string
 	Subject   string // 認証時に設定するパラメータ
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+	AuthorizationDetails string // 付与した authorization_details (json array)
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+	Resources []string // 付与したリソース (resource)
 }
@@ -0,0 +1,77 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+type BackchannelAuthenticationStatus string
+
+const (
+	BackchannelAuthenticationStatusPending  BackchannelAuthenticationStatus = "pending"
+	BackchannelAuthenticationStatusApproved BackchannelAuthenticationStatus = "approved"
+	BackchannelAuthenticationStatusDenied   BackchannelAuthenticationStatus = "denied"
+)
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+type BackchannelAuthenticationDetails struct {
+	Issuer                  *oppb.CommonKey
+	AuthReqId               string
+	Client                  *Client
+	Scopes                  []string
+	AcrValues               []string
+	ClientNotificationToken string
+	LoginHint               string
+	LoginHintToken          string
+	Subject                 string // subject of id_token_hint
+	BindingMessage          string
+	UserCode                string
+	Status                  BackchannelAuthenticationStatus
+	Authorized              *Authorized // set when the end-user approved
+	Interval                int32       // minimum polling interval (seconds)
+	LastPolledAt            time.Time
+}
+
+type BackchannelAuthentication struct {
+	CreateAt time.Time
+	Details  BackchannelAuthenticationDetails
+	ExpireAt time.Time
+}
+
+func GetBackchannelAuthenticationCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/backchannelAuthentications", version, issuerId)
+}
+
+func (b BackchannelAuthentication) Path(_ context.Context) string {
+	return GetBackchannelAuthenticationCollectionName(b.Details.Issuer.Id) + "/" + b.Details.AuthReqId
+}
+
+// 期限切れ後のポーリングに expired_token を返せるように、ExpireAt の後も保持してから削除する
+func (b BackchannelAuthentication) ExpireAtUnix(_ context.Context) int64 {
+	return b.ExpireAt.Add(expiredGrantRetention).Unix()
+}
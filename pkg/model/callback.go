@@ -22,9 +22,71 @@
 
 package model
 
-import "context"
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+)
 
 type ProviderCallbacks interface {
 	DeleteTokensWithRequetId(ctx context.Context, issuerId, requestId string) error
 	DeleteTokensWithSessionId(ctx context.Context, issuerId, sessionId string) error
 }
+
+// ErrInvalidTarget is returned by TokenExchangePolicyCallback
+// when the requested audience or resource is not acceptable.
+var ErrInvalidTarget = errors.New("invalid target")
+
+// https://www.rfc-editor.org/rfc/rfc8693.html#section-2.1
+type TokenExchangeRequest struct {
+	Client       *Client          // client requesting the exchange
+	SubjectToken *TokenIdentifier // validated subject_token
+	ActorToken   *TokenIdentifier // validated actor_token (nil if absent)
+	Audience     []string
+	Resource     []string
+	Scopes       []string // requested scopes (scopes of the subject_token if omitted)
+}
+
+type TokenExchangeDecision struct {
+	Scopes   []string // must be a subset of the requested scopes
+	Audience []string
+}
+
+// https://www.rfc-editor.org/rfc/rfc7523.html#section-2.1
+type JwtBearerAssertion struct {
+	Client  *Client        // client presenting the assertion
+	Subject string         // sub of the assertion
+	Claims  map[string]any // all claims of the verified assertion
+}
+
+// JwtBearerCallbacks can optionally be implemented by ProviderCallbacks
+// to enable the JWT bearer authorization grant.
+type JwtBearerCallbacks interface {
+	// JwtBearerSubjectCallback maps the assertion to the local subject and its claims(json string).
+	JwtBearerSubjectCallback(ctx context.Context, assertion *JwtBearerAssertion) (subject, claims string, err error)
+}
+
+// TokenExchangeCallbacks can optionally be implemented by ProviderCallbacks
+// to apply the token exchange policy.
+type TokenExchangeCallbacks interface {
+	TokenExchangePolicyCallback(ctx context.Context, req *TokenExchangeRequest) (*TokenExchangeDecision, error)
+}
+
+// SubjectMapper can optionally be implemented by ProviderCallbacks
+// to replace the default mapping of the subject identifiers.
+// https://openid.net/specs/openid-connect-core-1_0.html#SubjectIDTypes
+type SubjectMapper interface {
+	// ClientSubject returns the sub value presented to the client.
+	ClientSubject(ctx context.Context, iss *Issuer, client *Client, subject string) (string, error)
+	// InternalSubject returns the local subject of the sub value presented to the client.
+	InternalSubject(ctx context.Context, iss *Issuer, client *Client, clientSubject string) (string, error)
+}
+
+// TlsClientAuthCallbacks can optionally be implemented by ProviderCallbacks
+// to enable the tls_client_auth client authentication.
+// tls_client_auth is refused if it is not implemented.
+// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1
+type TlsClientAuthCallbacks interface {
+	// TlsClientAuthTrustAnchors returns the CA certificates trusted to issue the client certificates of the issuer.
+	TlsClientAuthTrustAnchors(ctx context.Context, iss *Issuer) (*x509.CertPool, error)
+}
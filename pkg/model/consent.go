@@ -0,0 +1,62 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+// https://openid.net/specs/openid-connect-core-1_0.html#Consent
+// 同意済みのスコープ・クレームを (subject, client) ごとに記録する
+type ConsentDetails struct {
+	Issuer   *oppb.CommonKey
+	Subject  string   // internal subject
+	ClientId string   // client_id
+	Scopes   []string // 同意済みのスコープ
+	Claims   []string // claims パラメータで同意済みのクレーム名
+}
+
+type Consent struct {
+	CreateAt time.Time
+	UpdateAt time.Time
+	Details  ConsentDetails
+}
+
+func GetConsentCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/consents", version, issuerId)
+}
+
+func (c *Consent) Path(_ context.Context) string {
+	// subject は任意の文字列のため、client_id との組み合わせのハッシュ値をキーにする
+	// 区切り文字を含む subject で衝突しないように JSON 配列としてエンコードする
+	b, _ := json.Marshal([]string{c.Details.Subject, c.Details.ClientId})
+	h := sha256.Sum256(b)
+	return GetConsentCollectionName(c.Details.Issuer.Id) + "/" + hex.EncodeToString(h[:])
+}
@@ -0,0 +1,100 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+// expiredGrantRetention is how long an expired device_code or auth_req_id is
+// kept so that polling with it is answered with expired_token.
+const expiredGrantRetention = 10 * time.Minute
+
+type DeviceAuthorizationStatus string
+
+const (
+	DeviceAuthorizationStatusPending  DeviceAuthorizationStatus = "pending"
+	DeviceAuthorizationStatusApproved DeviceAuthorizationStatus = "approved"
+	DeviceAuthorizationStatusDenied   DeviceAuthorizationStatus = "denied"
+)
+
+// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.2
+type DeviceAuthorizationDetails struct {
+	Issuer       *oppb.CommonKey
+	DeviceCode   string
+	UserCode     string
+	RequestId    string // request used by the user-verification (login) flow
+	ClientId     string
+	Status       DeviceAuthorizationStatus
+	Authorized   *Authorized // set when the end-user approved
+	Interval     int32       // minimum polling interval (seconds)
+	LastPolledAt time.Time
+}
+
+type DeviceAuthorization struct {
+	CreateAt time.Time
+	Details  DeviceAuthorizationDetails
+	ExpireAt time.Time
+}
+
+func GetDeviceAuthorizationCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/deviceAuthorizations", version, issuerId)
+}
+
+func (d DeviceAuthorization) Path(_ context.Context) string {
+	return GetDeviceAuthorizationCollectionName(d.Details.Issuer.Id) + "/" + d.Details.DeviceCode
+}
+
+// 期限切れ後のポーリングに expired_token を返せるように、ExpireAt の後も保持してから削除する
+func (d DeviceAuthorization) ExpireAtUnix(_ context.Context) int64 {
+	return d.ExpireAt.Add(expiredGrantRetention).Unix()
+}
+
+// DeviceUserCode maps a user_code entered on the verification page to its device_code.
+type DeviceUserCodeDetails struct {
+	Issuer     *oppb.CommonKey
+	UserCode   string
+	DeviceCode string
+}
+
+type DeviceUserCode struct {
+	CreateAt time.Time
+	Details  DeviceUserCodeDetails
+	ExpireAt time.Time
+}
+
+func GetDeviceUserCodeCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/deviceUserCodes", version, issuerId)
+}
+
+func (d DeviceUserCode) Path(_ context.Context) string {
+	return GetDeviceUserCodeCollectionName(d.Details.Issuer.Id) + "/" + d.Details.UserCode
+}
+
+func (d DeviceUserCode) ExpireAtUnix(_ context.Context) int64 {
+	return d.ExpireAt.Unix()
+}
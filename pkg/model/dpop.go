@@ -0,0 +1,86 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-11.1
+// 再利用防止のために使用済みの DPoP proof の jti を許容期間まで記録する
+type DpopProofDetails struct {
+	Issuer *oppb.CommonKey
+	Jkt    string // JWK SHA-256 thumbprint of the proof key
+	Jti    string
+}
+
+type DpopProof struct {
+	CreateAt time.Time
+	Details  DpopProofDetails
+	ExpireAt time.Time
+}
+
+func GetDpopProofCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/dpopProofs", version, issuerId)
+}
+
+func (d DpopProof) Path(_ context.Context) string {
+	// jti は任意の文字列のためハッシュ化してキーにする
+	hash := sha256.Sum256([]byte(d.Details.Jkt + " " + d.Details.Jti))
+	return GetDpopProofCollectionName(d.Details.Issuer.Id) + "/" + base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func (d DpopProof) ExpireAtUnix(_ context.Context) int64 {
+	return d.ExpireAt.Unix()
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+// サーバーが払い出した DPoP nonce を有効期限まで記録する
+type DpopNonceDetails struct {
+	Issuer *oppb.CommonKey
+	Nonce  string
+}
+
+type DpopNonce struct {
+	CreateAt time.Time
+	Details  DpopNonceDetails
+	ExpireAt time.Time
+}
+
+func GetDpopNonceCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/dpopNonces", version, issuerId)
+}
+
+func (d DpopNonce) Path(_ context.Context) string {
+	return GetDpopNonceCollectionName(d.Details.Issuer.Id) + "/" + d.Details.Nonce
+}
+
+func (d DpopNonce) ExpireAtUnix(_ context.Context) int64 {
+	return d.ExpireAt.Unix()
+}
@@ -0,0 +1,61 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+type LogoutRequestDetails struct {
+	Key                   *oppb.CommonKey
+	Issuer                *oppb.CommonKey
+	Client                *Client // nil if the RP could not be identified
+	PostLogoutRedirectUri string
+	State                 string
+	LogoutHint            string
+	UiLocales             []string
+	Subject               string   // subject of id_token_hint
+	SessionIds            []string // sessions to be terminated
+}
+
+type LogoutRequest struct {
+	CreateAt time.Time
+	Details  LogoutRequestDetails
+	ExpireAt time.Time
+}
+
+func GetLogoutRequestCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/logoutRequests", version, issuerId)
+}
+
+func (l LogoutRequest) Path(_ context.Context) string {
+	return GetLogoutRequestCollectionName(l.Details.Issuer.Id) + "/" + l.Details.Key.Id
+}
+
+func (l LogoutRequest) ExpireAtUnix(_ context.Context) int64 {
+	return l.ExpireAt.Unix()
+}
@@ -0,0 +1,84 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+// ペアワイズ識別子から内部の subject を逆引きするために記録する
+type PairwiseSubjectDetails struct {
+	Issuer   *oppb.CommonKey
+	Sector   string // sector identifier (host)
+	Pairwise string // sub value presented to the clients of the sector
+	Subject  string // internal subject
+}
+
+type PairwiseSubject struct {
+	CreateAt time.Time
+	Details  PairwiseSubjectDetails
+}
+
+func GetPairwiseSubjectCollectionName(issuerId string) string {
+	return fmt.Sprintf("opgo/%s/issuers/%s/pairwiseSubjects", version, issuerId)
+}
+
+func (p *PairwiseSubject) Path(_ context.Context) string {
+	// ペアワイズ識別子はセクターごとに異なるハッシュ値のためキーにする
+	return GetPairwiseSubjectCollectionName(p.Details.Issuer.Id) + "/" + p.Details.Pairwise
+}
+
+// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+// The Sector Identifier is the host component of the sector_identifier_uri,
+// or of the registered redirect_uri when no sector_identifier_uri is registered.
+func SectorIdentifier(meta *oppb.ClientMeta) (string, error) {
+	if meta.SectorIdentifierUri != "" {
+		u, err := url.Parse(meta.SectorIdentifierUri)
+		if err != nil || u.Host == "" {
+			return "", fmt.Errorf("invalid sector_identifier_uri:%s", meta.SectorIdentifierUri)
+		}
+		return u.Host, nil
+	}
+	host := ""
+	for _, uri := range meta.RedirectUris {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return "", fmt.Errorf("invalid redirect_uri:%s", uri)
+		}
+		if host != "" && host != u.Host {
+			// 複数のホストの redirect_uri を登録する場合は sector_identifier_uri が必要
+			return "", fmt.Errorf("sector_identifier_uri is required for redirect_uris with multiple hosts")
+		}
+		host = u.Host
+	}
+	if host == "" {
+		return "", fmt.Errorf("sector identifier could not be determined")
+	}
+	return host, nil
+}
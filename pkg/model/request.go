@@ -35,6 +35,10 @@ type RequestDetails struct {
 	Client     *Client
 	AuthParams *oppb.AuthorizationParameters
 	Issuer     string
+	DeviceCode string // set when the request verifies a device authorization
+	// https://openid.net/specs/openid-connect-core-1_0.html#Consent
+	ConsentSubject   string // set while the end-user consent is requested
+	ConsentSessionId string
 }
 
 type Request struct {
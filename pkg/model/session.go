@@ -35,6 +35,7 @@ type SessionDetails struct {
 	Issuer       *oppb.CommonKey
 	SessionGroup SessionGroup
 	Meta         *oppb.SessonMeta
+	ClientIds    []string // clients participating in the session (for logout)
 }
 
 type Session struct {
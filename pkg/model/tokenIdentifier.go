@@ -36,11 +36,26 @@ const (
 	TokenTypeRefreshToken TokenType = "refresh"
 )
 
+// https://www.rfc-editor.org/rfc/rfc8693.html#section-4.1
+type TokenActor struct {
+	Subject  string
+	ClientId string
+	Actor    *TokenActor // prior actor in the delegation chain
+}
+
 type TokenIdentifierDetails struct {
 	Authorized           Authorized
 	Identifier           string
 	Type                 TokenType
-	TlsClientCertificate string
+	TlsClientCertificate string // x5t#S256 of the certificate bound to the token
+	DpopJkt              string // jkt of the DPoP key bound to the token (RFC 9449)
+	// refresh token rotation
+	FamilyCreateAt time.Time // first issue of the refresh token family
+	IsUsed         bool      // rotated refresh token
+	// token exchange (RFC 8693)
+	Audience []string
+	Actor    *TokenActor
+	Lineage  []Authorized // authorizations of the exchanged tokens (oldest first)
 }
 
 type TokenIdentifier struct {
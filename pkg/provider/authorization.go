@@ -116,7 +116,7 @@ func (p *Provider) Authorization(ctx context.Context,
 		// parse query
 		params := parseParams(client, parseTarget)
 
-		res, err := authorization(ctx, iss, params, client, req.Msg.Sessions)
+		res, err := authorization(ctx, p.subjectMapper(), iss, params, client, req.Msg.Sessions)
 		if err != nil {
 			return nil, err
 		}
@@ -242,10 +242,14 @@ func parseParams(client *model.Client, parseTarget string) *oppb.AuthorizationPa
 	params.CodeChallengeMethod = vals.Get("code_challenge_method")
 	params.Request = vals.Get("request")
 	params.RequestUri = vals.Get("request_uri")
+	params.DpopJkt = vals.Get("dpop_jkt")
+	params.AuthorizationDetails = vals.Get("authorization_details")
+	params.Resources = vals.GetAll("resource")
 	return params
 }
 
 func authorization(ctx context.Context,
+	mapper model.SubjectMapper,
 	iss *model.Issuer,
 	params *oppb.AuthorizationParameters,
 	client *model.Client,
@@ -442,6 +446,22 @@ func authorization(ctx context.Context,
 			},
 		}), nil
 	}
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-5
+	if desc := checkAuthorizationDetails(iss, client, params.AuthorizationDetails); desc != "" {
+		return connect.NewResponse(&oppb.AuthorizationResponse{
+			AuthorizationResponseOneof: &oppb.AuthorizationResponse_Fail{
+				Fail: failAuthorizationInvalidAuthorizationDetails(desc),
+			},
+		}), nil
+	}
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+	if desc := checkResources(client, params.Resources); desc != "" {
+		return connect.NewResponse(&oppb.AuthorizationResponse{
+			AuthorizationResponseOneof: &oppb.AuthorizationResponse_Fail{
+				Fail: failAuthorizationInvalidTarget(desc),
+			},
+		}), nil
+	}
 
 	switch client.Extensions.Profile {
 	case oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_1_0:
@@ -604,13 +624,33 @@ func authorization(ctx context.Context,
 					},
 				}), nil
 			}
+			// https://openid.net/specs/openid-connect-core-1_0.html#SubjectIDTypes
+			// pairwise の sub を内部の subject に戻す
+			subject, err := mapper.InternalSubject(ctx, iss, client, hintClaims.Subject)
+			if err != nil {
+				log.Printf("InternalSubject error:%v", err)
+				return connect.NewResponse(&oppb.AuthorizationResponse{
+					AuthorizationResponseOneof: &oppb.AuthorizationResponse_Fail{
+						Fail: failAuthorizationLoginRequired(),
+					},
+				}), nil
+			}
+			// https://openid.net/specs/openid-connect-core-1_0.html#AuthError
+			// 同意画面を表示できないためエラー応答する
+			if consentRequired(ctx, iss, client, subject, params) {
+				return connect.NewResponse(&oppb.AuthorizationResponse{
+					AuthorizationResponseOneof: &oppb.AuthorizationResponse_Fail{
+						Fail: failAuthorizationConsentRequired(),
+					},
+				}), nil
+			}
 
 			// id_token_hintが存在する場合は後段処理で、認可コード発行まで行う
 			return connect.NewResponse(&oppb.AuthorizationResponse{
 				AuthorizationResponseOneof: &oppb.AuthorizationResponse_Issue{
 					Issue: &oppb.AuthorizationNextActionIssue{
 						RequestId:  r.Details.Key.Id,
-						Subject:    hintClaims.Subject,
+						Subject:    subject,
 						Client:     client.Meta,
 						AuthParams: params,
 					},
@@ -623,6 +663,13 @@ func authorization(ctx context.Context,
 					Fail: failAuthorizationLoginRequired(),
 				},
 			}), nil
+		} else if consentRequired(ctx, iss, client, ses.Details.Meta.Subject, params) {
+			// 同意画面を表示できないためエラー応答する
+			return connect.NewResponse(&oppb.AuthorizationResponse{
+				AuthorizationResponseOneof: &oppb.AuthorizationResponse_Fail{
+					Fail: failAuthorizationConsentRequired(),
+				},
+			}), nil
 		} else {
 			// セッションが存在する場合は後段処理で、認可コード発行まで行う
 			return connect.NewResponse(&oppb.AuthorizationResponse{
@@ -638,7 +685,8 @@ func authorization(ctx context.Context,
 			}), nil
 		}
 
-	} else if len(params.Prompts) == 0 {
+	} else if !slices.ContainsFunc(params.Prompts, func(prompt string) bool { return prompt != "consent" }) {
+		// prompt 指定なし、もしくは consent のみ
 		if ses.Details.Key.Id == "" {
 			// セッションが存在しない場合は後段処理で、ログイン画面表示を行う
 			return connect.NewResponse(&oppb.AuthorizationResponse{
@@ -650,6 +698,18 @@ func authorization(ctx context.Context,
 					},
 				},
 			}), nil
+		} else if consentRequired(ctx, iss, client, ses.Details.Meta.Subject, params) {
+			// セッションが存在するが同意が必要な場合は後段処理で、同意画面表示を行う
+			consent, err := requestConsent(ctx, r, ses.Details.Key.Id, ses.Details.Meta.Subject)
+			if err != nil {
+				log.Printf("[BACKEND_ERROR] request Set:%v", err)
+				return nil, err
+			}
+			return connect.NewResponse(&oppb.AuthorizationResponse{
+				AuthorizationResponseOneof: &oppb.AuthorizationResponse_Consent{
+					Consent: consent,
+				},
+			}), nil
 		} else {
 			// セッションが存在する場合は後段処理で、認可コード発行まで行う
 			return connect.NewResponse(&oppb.AuthorizationResponse{
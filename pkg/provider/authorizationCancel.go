@@ -60,6 +60,20 @@ func (p *Provider) AuthorizationCancel(ctx context.Context,
 			return nil, err
 		}
 
+		if r.Details.DeviceCode != "" {
+			// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.5
+			// access_denied はトークンエンドポイントへのポーリングで通知する
+			html, err := completeDeviceAuthorization(ctx, iss, r, nil)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&oppb.AuthorizationCancelResponse{
+				AuthorizationCancelResponseOneof: &oppb.AuthorizationCancelResponse_Html{
+					Html: html,
+				},
+			}), nil
+		}
+
 		res, err := makeFailResponse(ctx, iss, r.Details.Client, r.Details.AuthParams, failAuthorizationAccessDenied())
 		if err != nil {
 			return nil, err
@@ -0,0 +1,73 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// checkAuthorizationDetails validates the authorization_details (json array) and returns the error description.
+// https://www.rfc-editor.org/rfc/rfc9396.html#section-5
+// The AS MUST refuse to process any unknown authorization details type or authorization details not conforming to the respective type definition.
+func checkAuthorizationDetails(iss *model.Issuer, client *model.Client, value string) string {
+	if value == "" {
+		return ""
+	}
+	var details []map[string]any
+	if err := json.Unmarshal([]byte(value), &details); err != nil {
+		return "authorization_details must be a JSON array of objects"
+	}
+	if len(details) == 0 {
+		return "authorization_details is empty"
+	}
+	for _, detail := range details {
+		// https://www.rfc-editor.org/rfc/rfc9396.html#section-2
+		// type: REQUIRED
+		typ, ok := detail["type"].(string)
+		if !ok || typ == "" {
+			return "authorization_details type is required"
+		}
+		if !slices.Contains(iss.Meta.AuthorizationDetailsTypesSupported, typ) {
+			return "authorization_details type not supported:" + typ
+		}
+		// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+		// authorization_details_types: Indicates what authorization details types the client uses.
+		if len(client.Meta.AuthorizationDetailsTypes) > 0 && !slices.Contains(client.Meta.AuthorizationDetailsTypes, typ) {
+			return "authorization_details type not allowed:" + typ
+		}
+	}
+	return ""
+}
+
+// authorizationDetailsClaim returns the authorization_details as a JSON value of the token response, introspection and JWT access token.
+// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+// https://www.rfc-editor.org/rfc/rfc9396.html#section-9
+func authorizationDetailsClaim(value string) (json.RawMessage, bool) {
+	if value == "" || !json.Valid([]byte(value)) {
+		return nil, false
+	}
+	return json.RawMessage(value), true
+}
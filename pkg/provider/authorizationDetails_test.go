@@ -0,0 +1,245 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPaymentDetails = `[{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"123.50"}}]`
+
+// pushTestAuthorizationRequest sends the authorization request of the client to the PAR endpoint
+// and returns the error code (empty on success) and the stored parameters.
+func pushTestAuthorizationRequest(t *testing.T, iss *model.Issuer, client *model.Client, form url.Values) (string, *oppb.AuthorizationParameters) {
+	t.Helper()
+	ctx := context.Background()
+	form.Set("client_id", client.Identity.ClientId)
+	form.Set("client_secret", client.Identity.ClientSecret)
+	form.Set("response_type", oauth.ResponseTypeCode)
+	form.Set("redirect_uri", client.Meta.RedirectUris[0])
+	form.Set("scope", "openid")
+	req := connect.NewRequest(&oppb.PushedAuthorizationRequest{
+		ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+		Method:      http.MethodPost,
+		Form:        form.Encode(),
+	})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	res, err := testProvider.PushedAuthorization(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fail := res.Msg.GetFail(); fail != nil {
+		return fail.Error.Error, nil
+	}
+	pa := &model.PushedAuthorization{
+		Client: client,
+		Params: &oppb.AuthorizationParameters{
+			ParKey: strings.TrimPrefix(res.Msg.GetSuccess().RequestUri, oauth.SchemeRequestURI),
+		},
+	}
+	if err := dataprovider.Get(ctx, pa); err != nil {
+		t.Fatal(err)
+	}
+	return "", pa.Params
+}
+
+func TestCheckAuthorizationDetails(t *testing.T) {
+	iss := &model.Issuer{
+		Meta: &oppb.IssuerMeta{
+			AuthorizationDetailsTypesSupported: []string{"payment_initiation", "account_information"},
+		},
+	}
+	client := &model.Client{Meta: &oppb.ClientMeta{}}
+	restricted := &model.Client{
+		Meta: &oppb.ClientMeta{
+			AuthorizationDetailsTypes: []string{"account_information"},
+		},
+	}
+
+	type testCase struct {
+		name      string
+		client    *model.Client
+		value     string
+		wantError bool
+	}
+
+	tests := []testCase{
+		{name: "Omitted", client: client, value: ""},
+		{name: "Supported type", client: client, value: testPaymentDetails},
+		{name: "Multiple types", client: client, value: `[{"type":"payment_initiation"},{"type":"account_information","actions":["read"]}]`},
+		{name: "Type registered for the client", client: restricted, value: `[{"type":"account_information"}]`},
+		{name: "Type not registered for the client", client: restricted, value: testPaymentDetails, wantError: true},
+		{name: "Unsupported type", client: client, value: `[{"type":"unknown"}]`, wantError: true},
+		{name: "Missing type", client: client, value: `[{"actions":["read"]}]`, wantError: true},
+		{name: "Type is not a string", client: client, value: `[{"type":1}]`, wantError: true},
+		{name: "Empty array", client: client, value: `[]`, wantError: true},
+		{name: "Object instead of array", client: client, value: `{"type":"payment_initiation"}`, wantError: true},
+		{name: "Not JSON", client: client, value: `payment_initiation`, wantError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			desc := checkAuthorizationDetails(iss, tc.client, tc.value)
+			if tc.wantError {
+				assert.NotEmpty(t, desc)
+			} else {
+				assert.Empty(t, desc)
+			}
+		})
+	}
+}
+
+func TestPushedAuthorizationDetails(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.Meta.AuthorizationDetailsTypesSupported = []string{"payment_initiation"}
+	if err := dataprovider.Set(ctx, iss); err != nil {
+		t.Fatal(err)
+	}
+	client := newTestClient(t, iss)
+
+	type testCase struct {
+		name      string
+		details   string
+		wantError string
+	}
+
+	tests := []testCase{
+		{
+			name:    "Supported authorization_details",
+			details: testPaymentDetails,
+		},
+		{
+			name:      "Unsupported type",
+			details:   `[{"type":"account_information"}]`,
+			wantError: oauth.AuthorizationErrorInvalidAuthorizationDetails,
+		},
+		{
+			name:      "Malformed authorization_details",
+			details:   `{"type":"payment_initiation"`,
+			wantError: oauth.AuthorizationErrorInvalidAuthorizationDetails,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			code, params := pushTestAuthorizationRequest(t, iss, client, url.Values{
+				"authorization_details": {tc.details},
+			})
+			assert.Equal(t, tc.wantError, code)
+			if tc.wantError == "" && assert.NotNil(t, params) {
+				assert.JSONEq(t, tc.details, params.AuthorizationDetails)
+			}
+		})
+	}
+}
+
+func TestAuthorizationDetailsInTokenResponses(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss)
+
+	type testCase struct {
+		name    string
+		details string
+	}
+
+	tests := []testCase{
+		{name: "Granted authorization_details", details: testPaymentDetails},
+		{name: "Without authorization_details", details: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := newTestAuthorized(t, iss, client, "openid", "offline_access")
+			authorized.AuthorizationDetails = tc.details
+			refresh, err := makeRefreshTokenIdentifier(authorized, time.Now(), time.Now(), "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dataprovider.Create(ctx, refresh); err != nil {
+				t.Fatal(err)
+			}
+
+			// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {refresh.Details.Identifier},
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+			if tc.details == "" {
+				assert.Empty(t, success.AuthorizationDetails)
+			} else {
+				assert.JSONEq(t, tc.details, success.AuthorizationDetails)
+			}
+
+			// https://www.rfc-editor.org/rfc/rfc9396.html#section-9.2
+			req := connect.NewRequest(&oppb.IntrospectionRequest{
+				ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+				Method:      http.MethodPost,
+				Form: url.Values{
+					"token":         {success.AccessToken},
+					"client_id":     {client.Identity.ClientId},
+					"client_secret": {client.Identity.ClientSecret},
+				}.Encode(),
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			introspection, err := testProvider.Introspection(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body := map[string]json.RawMessage{}
+			if err := json.Unmarshal([]byte(introspection.Msg.Body), &body); err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, "true", string(body["active"]))
+			if tc.details == "" {
+				assert.NotContains(t, body, "authorization_details")
+			} else {
+				// JSON の文字列ではなく配列として返される
+				assert.JSONEq(t, tc.details, string(body["authorization_details"]))
+			}
+		})
+	}
+}
@@ -69,6 +69,26 @@ func failAuthorizationInvalidRequestUri(errorDescription string) *oppb.Authoriza
 	}
 }
 
+func failAuthorizationInvalidAuthorizationDetails(errorDescription string) *oppb.AuthorizationFailResponse {
+	return &oppb.AuthorizationFailResponse{
+		StatusCode: http.StatusBadRequest,
+		Error: &oppb.AuthorizationErrorResponse{
+			Error:            oauth.AuthorizationErrorInvalidAuthorizationDetails,
+			ErrorDescription: errorDescription,
+		},
+	}
+}
+
+func failAuthorizationInvalidTarget(errorDescription string) *oppb.AuthorizationFailResponse {
+	return &oppb.AuthorizationFailResponse{
+		StatusCode: http.StatusBadRequest,
+		Error: &oppb.AuthorizationErrorResponse{
+			Error:            oauth.AuthorizationErrorInvalidTarget,
+			ErrorDescription: errorDescription,
+		},
+	}
+}
+
 func failAuthorizationRequestUriNotSupported() *oppb.AuthorizationFailResponse {
 	return &oppb.AuthorizationFailResponse{
 		StatusCode: http.StatusBadRequest,
@@ -109,6 +129,16 @@ func failAuthorizationLoginRequired() *oppb.AuthorizationFailResponse {
 	}
 }
 
+func failAuthorizationConsentRequired() *oppb.AuthorizationFailResponse {
+	return &oppb.AuthorizationFailResponse{
+		StatusCode: http.StatusBadRequest,
+		Error: &oppb.AuthorizationErrorResponse{
+			Error:            oauth.AuthorizationErrorConsentRequired,
+			ErrorDescription: "consent required",
+		},
+	}
+}
+
 func failAuthorizationAccessDenied() *oppb.AuthorizationFailResponse {
 	return &oppb.AuthorizationFailResponse{
 		StatusCode: http.StatusBadRequest,
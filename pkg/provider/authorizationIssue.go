@@ -89,15 +89,76 @@ func (p *Provider) AuthorizationIssue(ctx context.Context,
 					return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("subject mismatch"))
 				}
 				authTime = ses.CreateAt
+
+				// ログアウト通知のためにセッションに参加しているクライアントを記録する
+				clientId := r.Details.Client.Identity.ClientId
+				if !slices.Contains(ses.Details.ClientIds, clientId) {
+					ses.Details.ClientIds = append(ses.Details.ClientIds, clientId)
+					if err := dataprovider.Set(ctx, ses); err != nil {
+						log.Printf("[BACKEND_ERROR] session Set:%v", err)
+						return nil, err
+					}
+				}
+			}
+		}
+
+		// https://openid.net/specs/openid-connect-core-1_0.html#Consent
+		if req.Msg.ConsentGranted {
+			if req.Msg.Subject != r.Details.ConsentSubject {
+				log.Printf("[BACKEND_ERROR] consent subject mismatch: %s != %s", req.Msg.Subject, r.Details.ConsentSubject)
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("consent subject mismatch"))
+			}
+			if err := saveConsent(ctx, iss, r.Details.Client, req.Msg.Subject, r.Details.AuthParams); err != nil {
+				log.Printf("[BACKEND_ERROR] consent Set:%v", err)
+				return nil, err
+			}
+		} else if consentRequired(ctx, iss, r.Details.Client, req.Msg.Subject, r.Details.AuthParams) {
+			// 同意が必要な場合は後段処理で、同意画面表示を行う
+			consent, err := requestConsent(ctx, r, req.Msg.SessionId, req.Msg.Subject)
+			if err != nil {
+				log.Printf("[BACKEND_ERROR] request Set:%v", err)
+				return nil, err
+			}
+			return connect.NewResponse(&oppb.AuthorizationIssueResponse{
+				AuthorizationIssueResponseOneof: &oppb.AuthorizationIssueResponse_Consent{
+					Consent: consent,
+				},
+			}), nil
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+		// The AS MAY enrich the authorization details with the End-User's consent.
+		authorizationDetails := r.Details.AuthParams.AuthorizationDetails
+		if req.Msg.AuthorizationDetails != "" {
+			if desc := checkAuthorizationDetails(iss, r.Details.Client, req.Msg.AuthorizationDetails); desc != "" {
+				log.Printf("[BACKEND_ERROR] authorization_details error:%s", desc)
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s", desc))
 			}
+			authorizationDetails = req.Msg.AuthorizationDetails
 		}
 
 		authorized := model.Authorized{
-			AuthTime:  authTime,
-			Claims:    req.Msg.Claims,
-			Request:   r.Details,
-			SessionId: req.Msg.SessionId,
-			Subject:   req.Msg.Subject,
+			AuthTime:             authTime,
+			Claims:               req.Msg.Claims,
+			Request:              r.Details,
+			SessionId:            req.Msg.SessionId,
+			Subject:              req.Msg.Subject,
+			AuthorizationDetails: authorizationDetails,
+			Resources:            r.Details.AuthParams.Resources,
+		}
+
+		if r.Details.DeviceCode != "" {
+			// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.3
+			// デバイス認可の場合はトークンエンドポイントへのポーリングで発行する
+			html, err := completeDeviceAuthorization(ctx, iss, r, &authorized)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&oppb.AuthorizationIssueResponse{
+				AuthorizationIssueResponseOneof: &oppb.AuthorizationIssueResponse_Html{
+					Html: html,
+				},
+			}), nil
 		}
 
 		success := &responseSuccess{
@@ -151,7 +212,7 @@ func (p *Provider) AuthorizationIssue(ctx context.Context,
 
 		if slices.Contains(strings.Split(r.Details.AuthParams.ResponseType, " "), oauth.ResponseTypeToken) {
 			if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
-				access, err := makeAccessTokenIdentifier(authorized, time.Now(), "")
+				access, err := makeAccessTokenIdentifier(authorized, time.Now(), "", "")
 				if err != nil {
 					log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
 					return err
@@ -159,7 +220,11 @@ func (p *Provider) AuthorizationIssue(ctx context.Context,
 				if err := dataprovider.Create(ctx, access); err != nil {
 					return err
 				}
-				success.AccessToken = access.Details.Identifier
+				success.AccessToken, err = makeAccessToken(ctx, p.subjectMapper(), iss, access)
+				if err != nil {
+					log.Printf("makeAccessToken error:%s", err.Error())
+					return err
+				}
 				success.ExpiresIn = int(r.Details.Client.Attribute.AccessTokenLifetimeSeconds)
 				success.TokenType = "Bearer"
 				return nil
@@ -180,11 +245,11 @@ func (p *Provider) AuthorizationIssue(ctx context.Context,
 					return err
 				}
 
-				claims, err := makeIdTokenClaims(iss, id, time.Now(), success.Code, success.AccessToken, success.State)
+				claims, err := makeIdTokenClaims(ctx, p.subjectMapper(), iss, id, time.Now(), success.Code, success.AccessToken, success.State)
 				if err != nil {
 					return err
 				}
-				success.IdToken, err = makeJwt(ctx, iss, claims, id.Details.Authorized.Request.Client.Meta.IdTokenSignedResponseAlg)
+				success.IdToken, err = makeIdToken(ctx, iss, id.Details.Authorized.Request.Client, claims)
 				return err
 			}); err != nil {
 				log.Printf("[BACKEND_ERROR] DB write error(TokenIdentifier)")
@@ -200,7 +265,14 @@ func (p *Provider) AuthorizationIssue(ctx context.Context,
 		}
 		vals["code"] = success.Code
 		vals["id_token"] = success.IdToken
-		vals["session_state"] = getSessionState(r.Details.Client.Issuer.Id, r.Details.Client.Identity.ClientId, req.Msg.SessionId)
+		if req.Msg.SessionId != "" {
+			// https://openid.net/specs/openid-connect-session-1_0.html#CreatingUpdatingSessions
+			vals["session_state"], err = getSessionState(r.Details.Client.Identity.ClientId, r.Details.AuthParams.RedirectUri, req.Msg.SessionId)
+			if err != nil {
+				log.Printf("[BACKEND_ERROR] session_state error:%v", err)
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+		}
 		vals["state"] = success.State
 
 		builder, err := newRedirectBuilder(iss, r.Details.Client, r.Details.AuthParams, vals)
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Eigen438/opgo/internal/claims"
@@ -67,6 +68,11 @@ type authorizationRequestParamFromJwt struct {
 	CodeChallengeMethod string            `json:"code_challenge_method"`
 	Request             string            `json:"request"`
 	RequestUri          string            `json:"request_uri"`
+	DpopJkt             string            `json:"dpop_jkt"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-3
+	AuthorizationDetails json.RawMessage `json:"authorization_details"`
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+	Resource jwt.ClaimStrings `json:"resource"`
 	jwt.RegisteredClaims
 }
 
@@ -176,6 +182,18 @@ func (a authorizationRequestParamFromJwt) GetRequestUri() string {
 	return a.RequestUri
 }
 
+func (a authorizationRequestParamFromJwt) GetDpopJkt() string {
+	return a.DpopJkt
+}
+
+func (a authorizationRequestParamFromJwt) GetAuthorizationDetails() string {
+	return string(a.AuthorizationDetails)
+}
+
+func (a authorizationRequestParamFromJwt) GetResources() []string {
+	return a.Resource
+}
+
 func (a authorizationRequestParamFromJwt) GetIsPar() bool {
 	return false
 }
@@ -191,6 +209,17 @@ func analyzeAuthorizationRequestJwt(
 	jwtString string,
 	authParam *oppb.AuthorizationParameters) *oppb.AuthorizationFailResponse {
 
+	if isJwe(jwtString) {
+		// https://openid.net/specs/openid-connect-core-1_0.html#EncryptedRequestObject
+		// the Authorization Server MUST decrypt the JWT in accordance with the JSON Web Encryption [JWE] specification.
+		// If the result is a signed request object, signature validation MUST be performed
+		decrypted, err := decryptRequestObject(ctx, iss, client, jwtString)
+		if err != nil {
+			return failAuthorizationInvalidRequestObject("request object decrypt error")
+		}
+		jwtString = decrypted
+	}
+
 	arp := &authorizationRequestParamFromJwt{MaxAge: -1 /* dummy */}
 	token, err := parseJwt(ctx, client.Meta, jwtString, arp)
 	if err != nil {
@@ -243,30 +272,43 @@ func parseJwt(ctx context.Context, client *oppb.ClientMeta, jwtString string, ou
 	return jwt.NewParser(jwt.WithLeeway(24*time.Hour)).ParseWithClaims(jwtString, out, func(t *jwt.Token) (any, error) {
 		if t.Header["alg"] == "none" {
 			return jwt.UnsafeAllowNoneSignatureType, nil
-		} else if len(client.JwksUri) > 0 {
-			kf, err := keyfunc.NewDefault([]string{client.JwksUri})
-			if err != nil {
-				return nil, err
-			}
-			return kf.Keyfunc(t)
-		} else if client.Jwks != nil {
-			var jwks jwkset.JWKSMarshal
-			jwks.Keys = convert.JWKMarchalsFromKeys(client.Jwks.Keys)
-			storage, err := jwks.ToStorage()
-			if err != nil {
-				return nil, err
-			}
-			j, err := storage.JSON(ctx)
-			if err != nil {
-				return nil, err
-			}
-			kf, err := keyfunc.NewJWKSetJSON(j)
-			if err != nil {
-				return nil, err
-			}
-			return kf.Keyfunc(t)
-		} else {
-			return nil, fmt.Errorf("no jwks/jwks_uri found for client %s", client.ClientName)
 		}
+		kf, err := clientKeyfunc(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return kf.Keyfunc(t)
 	})
 }
+
+// remoteClientKeyfuncs caches the Keyfunc of each jwks_uri, which refreshes the JWK Set in the background.
+var remoteClientKeyfuncs sync.Map
+
+// clientKeyfunc resolves the keys registered for the client with jwks_uri or jwks.
+func clientKeyfunc(ctx context.Context, client *oppb.ClientMeta) (keyfunc.Keyfunc, error) {
+	if len(client.JwksUri) > 0 {
+		if kf, ok := remoteClientKeyfuncs.Load(client.JwksUri); ok {
+			return kf.(keyfunc.Keyfunc), nil
+		}
+		kf, err := keyfunc.NewDefault([]string{client.JwksUri})
+		if err != nil {
+			return nil, err
+		}
+		actual, _ := remoteClientKeyfuncs.LoadOrStore(client.JwksUri, kf)
+		return actual.(keyfunc.Keyfunc), nil
+	} else if client.Jwks != nil {
+		var jwks jwkset.JWKSMarshal
+		jwks.Keys = convert.JWKMarchalsFromKeys(client.Jwks.Keys)
+		storage, err := jwks.ToStorage()
+		if err != nil {
+			return nil, err
+		}
+		j, err := storage.JSON(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return keyfunc.NewJWKSetJSON(j)
+	} else {
+		return nil, fmt.Errorf("no jwks/jwks_uri found for client %s", client.ClientName)
+	}
+}
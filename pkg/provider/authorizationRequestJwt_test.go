@@ -0,0 +1,142 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/opgo/internal/convert"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeEncryptedRequestObject(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	opKey, kid := issuerEncryptionKey(t, iss)
+
+	clientKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := jwkset.NewJWKFromKey(clientKey.Public(), jwkset.JWKOptions{
+		Metadata: jwkset.JWKMetadataOptions{
+			KID: "client-sig",
+			USE: jwkset.UseSig,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newTestClient(t, iss, func(c *model.Client) {
+		c.Meta.Jwks = &oppb.Jwks{Keys: convert.JwksFromJWKMarshals([]jwkset.JWKMarshal{jwk.Marshal()})}
+	})
+
+	sign := func(key *rsa.PrivateKey) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":           client.Identity.ClientId,
+			"aud":           iss.Meta.Issuer,
+			"client_id":     client.Identity.ClientId,
+			"response_type": oauth.ResponseTypeCode,
+			"scope":         "openid profile",
+			"state":         "state-from-request-object",
+			"nonce":         "nonce-from-request-object",
+			"exp":           time.Now().Add(time.Minute).Unix(),
+		})
+		token.Header["kid"] = "client-sig"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	type testCase struct {
+		name      string
+		request   func() string
+		wantError string
+	}
+
+	tests := []testCase{
+		{
+			name: "Signed request object",
+			request: func() string {
+				return sign(clientKey)
+			},
+		},
+		{
+			name: "Signed then encrypted request object",
+			request: func() string {
+				return encryptTestRequestObject(t, sign(clientKey), "RSA-OAEP", "A128GCM", &opKey.PublicKey, kid)
+			},
+		},
+		{
+			name: "Encrypted request object that cannot be decrypted",
+			request: func() string {
+				return encryptTestRequestObject(t, sign(clientKey), "RSA-OAEP", "A128GCM", &otherKey.PublicKey, kid)
+			},
+			wantError: "request object decrypt error",
+		},
+		{
+			name: "Nested request object signed by another key",
+			request: func() string {
+				return encryptTestRequestObject(t, sign(otherKey), "RSA-OAEP", "A128GCM", &opKey.PublicKey, kid)
+			},
+			wantError: "request object parse error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authParam := &oppb.AuthorizationParameters{
+				ClientId: client.Identity.ClientId,
+				State:    "state-from-query",
+			}
+			fail := analyzeAuthorizationRequestJwt(ctx, iss, client, tc.request(), authParam)
+			if tc.wantError != "" {
+				if assert.NotNil(t, fail) {
+					assert.Equal(t, oauth.AuthorizationErrorInvalidRequestObject, fail.Error.Error)
+					assert.Equal(t, tc.wantError, fail.Error.ErrorDescription)
+				}
+				assert.Equal(t, "state-from-query", authParam.State)
+				return
+			}
+			assert.Nil(t, fail)
+			assert.Equal(t, "state-from-request-object", authParam.State)
+			assert.Equal(t, "nonce-from-request-object", authParam.Nonce)
+			assert.Equal(t, []string{"openid", "profile"}, authParam.Scopes)
+		})
+	}
+}
@@ -0,0 +1,369 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	backchannelAuthenticationLifetime = 10 * time.Minute
+	backchannelAuthenticationInterval = 5 // seconds
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.3
+	// auth_req_id: It is RECOMMENDED to have at least 160 bits of entropy.
+	authReqIdLength = 40
+)
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+var backchannelAuthenticationParams = []string{
+	"scope",
+	"client_notification_token",
+	"acr_values",
+	"login_hint_token",
+	"id_token_hint",
+	"login_hint",
+	"binding_message",
+	"user_code",
+	"requested_expiry",
+}
+
+func (p *Provider) BackchannelAuthentication(ctx context.Context,
+	req *connect.Request[oppb.BackchannelAuthenticationRequest]) (*connect.Response[oppb.BackchannelAuthenticationResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		if req.Msg.Method != http.MethodPost {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "method must be POST"), nil
+		}
+		if ct := req.Msg.ContentType; !strings.HasPrefix(ct, httphelper.MimeTypeWwwFormUnlencoded) {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest,
+				"content-type does not has "+httphelper.MimeTypeWwwFormUnlencoded+":"+ct), nil
+		}
+		vals := query.Parse(req.Msg.Form)
+
+		client, fail, err := identifyClient(ctx, iss, req.Msg.BasicAuth, vals)
+		if err != nil {
+			return nil, err
+		} else if fail != nil {
+			return connect.NewResponse(&oppb.BackchannelAuthenticationResponse{
+				BackchannelAuthenticationResponseOneof: &oppb.BackchannelAuthenticationResponse_Fail{
+					Fail: fail,
+				},
+			}), nil
+		}
+
+		// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+		// The Client MUST authenticate to the Backchannel Authentication Endpoint
+		if client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodNone {
+			return backchannelAuthenticationFail(http.StatusUnauthorized, oauth.TokenErrorInvalidClient, "client authentication is required"), nil
+		}
+		params := &clientAuthentication{
+			AllowAudience: []string{
+				iss.Meta.BackchannelAuthenticationEndpoint,
+				iss.Meta.TokenEndpoint,
+				iss.Meta.Issuer,
+			},
+			BasicAuth:                         req.Msg.BasicAuth,
+			Client:                            client,
+			Issuer:                            iss,
+			Values:                            vals,
+			TlsClientCertificate:              req.Msg.TlsClientCertificate,
+			TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
+		}
+		if terr := p.checkClientAuthentication(ctx, params); terr != nil {
+			return connect.NewResponse(&oppb.BackchannelAuthenticationResponse{
+				BackchannelAuthenticationResponseOneof: &oppb.BackchannelAuthenticationResponse_Fail{
+					Fail: terr,
+				},
+			}), nil
+		}
+
+		if !slices.Contains(client.Meta.GrantTypes, oauth.GrantTypeCiba) {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "ciba is not allowed for this client"), nil
+		}
+
+		isFapi := client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_1_0 ||
+			client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0
+
+		// トークン配信モードチェック
+		mode := client.Meta.BackchannelTokenDeliveryMode
+		if !slices.Contains([]string{
+			oauth.BackchannelTokenDeliveryModePoll,
+			oauth.BackchannelTokenDeliveryModePing,
+			oauth.BackchannelTokenDeliveryModePush,
+		}, mode) ||
+			(len(iss.Meta.BackchannelTokenDeliveryModesSupported) > 0 && !slices.Contains(iss.Meta.BackchannelTokenDeliveryModesSupported, mode)) {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "unsupported backchannel_token_delivery_mode:"+mode), nil
+		}
+		if isFapi {
+			// https://openid.net/specs/openid-financial-api-ciba-ID1.html#authorization-server
+			// shall not support CIBA push mode
+			if mode == oauth.BackchannelTokenDeliveryModePush {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "push mode is not allowed for FAPI clients"), nil
+			}
+			// shall only support confidential clients authenticated with private_key_jwt or mTLS
+			if !slices.Contains([]string{
+				oauth.TokenEndpointAuthMethodPrivateKeyJwt,
+				oauth.TokenEndpointAuthMethodTlsClientAuth,
+				oauth.TokenEndpointAuthMethodSelfSignedTlsClientAuth,
+			}, client.Meta.TokenEndpointAuthMethod) {
+				return backchannelAuthenticationFail(http.StatusUnauthorized, oauth.TokenErrorInvalidClient, "client authentication method not allowed for FAPI clients"), nil
+			}
+		}
+
+		// パラメータ取得（署名付き認証リクエストの場合は request から取得する）
+		ap := map[string]string{}
+		if request := vals.Get("request"); request != "" {
+			claims, desc := analyzeBackchannelAuthenticationRequestJwt(ctx, iss, client, request, isFapi)
+			if desc != "" {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequestObject, desc), nil
+			}
+			ap = claims
+		} else if isFapi {
+			// https://openid.net/specs/openid-financial-api-ciba-ID1.html#authorization-server
+			// shall only accept Signed Authentication Requests
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "signed authentication request is required"), nil
+		} else {
+			for _, name := range backchannelAuthenticationParams {
+				ap[name] = vals.Get(name)
+			}
+		}
+
+		// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+		// scope: REQUIRED. CIBA authentication requests MUST therefore contain the openid scope value.
+		scopes, ok := grantedScopes(iss, client, ap["scope"])
+		if !ok || !slices.Contains(scopes, "openid") {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidScope, "scope must contain openid:"+ap["scope"]), nil
+		}
+
+		if mode != oauth.BackchannelTokenDeliveryModePoll {
+			if ap["client_notification_token"] == "" {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "client_notification_token is required"), nil
+			}
+			if client.Meta.BackchannelClientNotificationEndpoint == "" {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "backchannel_client_notification_endpoint is not registered"), nil
+			}
+		}
+
+		// ヒントはいずれか１つのみ
+		hints := 0
+		for _, name := range []string{"login_hint_token", "id_token_hint", "login_hint"} {
+			if ap[name] != "" {
+				hints++
+			}
+		}
+		if hints != 1 {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest,
+				"exactly one of login_hint_token, id_token_hint or login_hint is required"), nil
+		}
+		subject := ""
+		if ap["id_token_hint"] != "" {
+			rc, err := verifyIdToken(ctx, iss, ap["id_token_hint"])
+			if err != nil {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "invalid id_token_hint"), nil
+			}
+			// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+			// An ID Token previously issued to the Client by the OpenID Provider
+			if !slices.Contains(rc.Audience, client.Identity.ClientId) {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "id_token_hint was not issued to the client"), nil
+			}
+			// pairwise の sub を内部の subject に戻す
+			subject, err = p.subjectMapper().InternalSubject(ctx, iss, client, rc.Subject)
+			if err != nil {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorUnknownUserId, "unknown subject of id_token_hint"), nil
+			}
+		}
+
+		if client.Meta.BackchannelUserCodeParameter && iss.Meta.BackchannelUserCodeParameterSupported && ap["user_code"] == "" {
+			return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorMissingUserCode, "user_code is required"), nil
+		}
+
+		lifetime := backchannelAuthenticationLifetime
+		if v := ap["requested_expiry"]; v != "" {
+			sec, err := strconv.Atoi(v)
+			if err != nil || sec <= 0 {
+				return backchannelAuthenticationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "invalid requested_expiry:"+v), nil
+			}
+			if d := time.Duration(sec) * time.Second; d < lifetime {
+				lifetime = d
+			}
+		}
+
+		authReqId, err := randutil.String(authReqIdLength, randutil.RunesNumAndAlpha)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		ba := &model.BackchannelAuthentication{
+			CreateAt: now,
+			Details: model.BackchannelAuthenticationDetails{
+				Issuer:                  iss.Key,
+				AuthReqId:               authReqId,
+				Client:                  client,
+				Scopes:                  scopes,
+				AcrValues:               strings.Fields(ap["acr_values"]),
+				ClientNotificationToken: ap["client_notification_token"],
+				LoginHint:               ap["login_hint"],
+				LoginHintToken:          ap["login_hint_token"],
+				Subject:                 subject,
+				BindingMessage:          ap["binding_message"],
+				UserCode:                ap["user_code"],
+				Status:                  model.BackchannelAuthenticationStatusPending,
+				Interval:                backchannelAuthenticationInterval,
+			},
+			ExpireAt: now.Add(lifetime),
+		}
+		if err := dataprovider.Create(ctx, ba); err != nil {
+			log.Printf("[BACKEND_ERROR] BackchannelAuthentication Create error:%v", err)
+			return nil, err
+		}
+
+		success := &oppb.BackchannelAuthenticationSuccessResponse{
+			AuthReqId: authReqId,
+			ExpiresIn: int32(lifetime.Seconds()),
+		}
+		if mode != oauth.BackchannelTokenDeliveryModePush {
+			success.Interval = backchannelAuthenticationInterval
+		}
+		return connect.NewResponse(&oppb.BackchannelAuthenticationResponse{
+			BackchannelAuthenticationResponseOneof: &oppb.BackchannelAuthenticationResponse_Success{
+				Success: success,
+			},
+			Notify: &oppb.BackchannelAuthenticationNotify{
+				AuthReqId:      authReqId,
+				ClientId:       client.Identity.ClientId,
+				Client:         client.Meta,
+				Scopes:         ba.Details.Scopes,
+				AcrValues:      ba.Details.AcrValues,
+				LoginHint:      ba.Details.LoginHint,
+				LoginHintToken: ba.Details.LoginHintToken,
+				Subject:        ba.Details.Subject,
+				BindingMessage: ba.Details.BindingMessage,
+				UserCode:       ba.Details.UserCode,
+			},
+		}), nil
+	}
+}
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1.1
+// 署名付き認証リクエストを検証し、パラメータを取り出す。エラー時は error_description を返す。
+func analyzeBackchannelAuthenticationRequestJwt(
+	ctx context.Context,
+	iss *model.Issuer,
+	client *model.Client,
+	jwtString string,
+	isFapi bool) (map[string]string, string) {
+	claims := jwt.MapClaims{}
+	token, err := parseJwt(ctx, client.Meta, jwtString, claims)
+	if err != nil {
+		return nil, "request object parse error"
+	}
+	alg := fmt.Sprintf("%v", token.Header["alg"])
+	if alg == "none" {
+		return nil, "request object must be signed"
+	}
+	if v := client.Meta.BackchannelAuthenticationRequestSigningAlg; v != "" && v != alg {
+		return nil, "signing alg not match:" + alg
+	}
+	if isFapi && slices.Contains(fapiRejectionAlg, alg) {
+		return nil, "signing alg not allow"
+	}
+
+	// aud, iss, exp, iat, nbf, jti は必須
+	if aud, err := claims.GetAudience(); err != nil || !slices.Contains(aud, iss.Meta.Issuer) {
+		return nil, "jwt aud not match"
+	}
+	if issuer, err := claims.GetIssuer(); err != nil || issuer != client.Identity.ClientId {
+		return nil, "jwt iss not match"
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return nil, "jwt not contains exp"
+	}
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return nil, "jwt not contains iat"
+	}
+	nbf, err := claims.GetNotBefore()
+	if err != nil || nbf == nil {
+		return nil, "jwt not contains nbf"
+	}
+	if jti, ok := claims["jti"].(string); !ok || jti == "" {
+		return nil, "jwt not contains jti"
+	}
+	now := time.Now()
+	if now.After(exp.Time) {
+		return nil, "jwt was expired"
+	}
+	if now.Before(nbf.Time) {
+		return nil, "jwt is not yet valid"
+	}
+	// https://openid.net/specs/openid-financial-api-ciba-ID1.html#authorization-server
+	// exp - nbf は 60 分以内
+	if exp.Sub(nbf.Time) > 60*time.Minute {
+		return nil, "exp too long"
+	}
+
+	out := map[string]string{}
+	for _, name := range backchannelAuthenticationParams {
+		switch v := claims[name].(type) {
+		case string:
+			out[name] = v
+		case float64:
+			out[name] = strconv.FormatInt(int64(v), 10)
+		}
+	}
+	return out, ""
+}
+
+func backchannelAuthenticationFail(statusCode int32, code, description string) *connect.Response[oppb.BackchannelAuthenticationResponse] {
+	return connect.NewResponse(&oppb.BackchannelAuthenticationResponse{
+		BackchannelAuthenticationResponseOneof: &oppb.BackchannelAuthenticationResponse_Fail{
+			Fail: &oppb.TokenFailResponse{
+				StatusCode: statusCode,
+				Error: &oppb.OauthError{
+					Error:            code,
+					ErrorDescription: description,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	clientNotificationAttempts      = 3
+	clientNotificationRetryInterval = 500 * time.Millisecond
+	clientNotificationTimeout       = 5 * time.Second
+)
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.3
+type backchannelPushResponse struct {
+	AuthReqId string `json:"auth_req_id"`
+	*oppb.TokenSuccessResponse
+}
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.12
+type backchannelPushError struct {
+	AuthReqId        string `json:"auth_req_id"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func (p *Provider) BackchannelAuthenticationIssue(ctx context.Context,
+	req *connect.Request[oppb.BackchannelAuthenticationIssueRequest]) (*connect.Response[oppb.BackchannelAuthenticationIssueResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		ba, err := getPendingBackchannelAuthentication(ctx, iss, req.Msg.AuthReqId)
+		if err != nil {
+			return nil, err
+		}
+		if ba.Details.Subject != "" && ba.Details.Subject != req.Msg.Subject {
+			log.Printf("[BACKEND_ERROR] subject mismatch: %s != %s", req.Msg.Subject, ba.Details.Subject)
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("subject mismatch"))
+		}
+
+		now := time.Now()
+		ba.Details.Status = model.BackchannelAuthenticationStatusApproved
+		ba.Details.Authorized = &model.Authorized{
+			AuthTime: now,
+			Claims:   req.Msg.Claims,
+			Request: model.RequestDetails{
+				Key: &oppb.CommonKey{
+					Id: ba.Details.AuthReqId,
+				},
+				Client: ba.Details.Client,
+				AuthParams: &oppb.AuthorizationParameters{
+					ClientId:  ba.Details.Client.Identity.ClientId,
+					Scopes:    ba.Details.Scopes,
+					AcrValues: ba.Details.AcrValues,
+					MaxAge:    -1,
+				},
+				Issuer: iss.Meta.Issuer,
+			},
+			Subject: req.Msg.Subject,
+		}
+
+		switch ba.Details.Client.Meta.BackchannelTokenDeliveryMode {
+		case oauth.BackchannelTokenDeliveryModePush:
+			// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.3
+			if err := dataprovider.Delete(ctx, ba); err != nil {
+				return nil, err
+			}
+			var success *oppb.TokenSuccessResponse
+			if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+				var err error
+				success, err = issueTokens(ctx, p.subjectMapper(), iss, *ba.Details.Authorized, tokenIssueOptions{
+					PushAuthReqId: ba.Details.AuthReqId,
+				}, now)
+				return err
+			}); err != nil {
+				log.Printf("[BACKEND_ERROR] DB write error(TokenIdentifier):%v", err)
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			if err := notifyClient(ctx, ba, &backchannelPushResponse{
+				AuthReqId:            ba.Details.AuthReqId,
+				TokenSuccessResponse: success,
+			}); err != nil {
+				return nil, connect.NewError(connect.CodeUnavailable, err)
+			}
+		default:
+			if err := dataprovider.Set(ctx, ba); err != nil {
+				log.Printf("[BACKEND_ERROR] BackchannelAuthentication Set error:%v", err)
+				return nil, err
+			}
+			if err := pingClient(ctx, ba); err != nil {
+				return nil, connect.NewError(connect.CodeUnavailable, err)
+			}
+		}
+		return connect.NewResponse(&oppb.BackchannelAuthenticationIssueResponse{}), nil
+	}
+}
+
+func (p *Provider) BackchannelAuthenticationCancel(ctx context.Context,
+	req *connect.Request[oppb.BackchannelAuthenticationCancelRequest]) (*connect.Response[oppb.BackchannelAuthenticationCancelResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		ba, err := getPendingBackchannelAuthentication(ctx, iss, req.Msg.AuthReqId)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Msg.Discard {
+			// クライアントへは通知せずに破棄する
+			if err := dataprovider.Delete(ctx, ba); err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&oppb.BackchannelAuthenticationCancelResponse{}), nil
+		}
+
+		ba.Details.Status = model.BackchannelAuthenticationStatusDenied
+		switch ba.Details.Client.Meta.BackchannelTokenDeliveryMode {
+		case oauth.BackchannelTokenDeliveryModePush:
+			if err := dataprovider.Delete(ctx, ba); err != nil {
+				return nil, err
+			}
+			if err := notifyClient(ctx, ba, &backchannelPushError{
+				AuthReqId:        ba.Details.AuthReqId,
+				Error:            oauth.TokenErrorAccessDenied,
+				ErrorDescription: "the end-user denied the authorization request",
+			}); err != nil {
+				return nil, connect.NewError(connect.CodeUnavailable, err)
+			}
+		default:
+			if err := dataprovider.Set(ctx, ba); err != nil {
+				log.Printf("[BACKEND_ERROR] BackchannelAuthentication Set error:%v", err)
+				return nil, err
+			}
+			if err := pingClient(ctx, ba); err != nil {
+				return nil, connect.NewError(connect.CodeUnavailable, err)
+			}
+		}
+		return connect.NewResponse(&oppb.BackchannelAuthenticationCancelResponse{}), nil
+	}
+}
+
+func getPendingBackchannelAuthentication(ctx context.Context, iss *model.Issuer, authReqId string) (*model.BackchannelAuthentication, error) {
+	ba := &model.BackchannelAuthentication{
+		Details: model.BackchannelAuthenticationDetails{
+			Issuer:    iss.Key,
+			AuthReqId: authReqId,
+		},
+	}
+	if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+		return dataprovider.Get(ctx, ba)
+	}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("auth_req_id not found"))
+		}
+		return nil, err
+	}
+	if ba.Details.Status != model.BackchannelAuthenticationStatusPending || time.Now().After(ba.ExpireAt) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("auth_req_id is expired or already completed"))
+	}
+	return ba, nil
+}
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.2
+func pingClient(ctx context.Context, ba *model.BackchannelAuthentication) error {
+	if ba.Details.Client.Meta.BackchannelTokenDeliveryMode != oauth.BackchannelTokenDeliveryModePing {
+		return nil
+	}
+	return notifyClient(ctx, ba, map[string]string{
+		"auth_req_id": ba.Details.AuthReqId,
+	})
+}
+
+// notifyClient posts the body to the client notification endpoint with the client_notification_token.
+func notifyClient(ctx context.Context, ba *model.BackchannelAuthentication, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	c := &http.Client{
+		Timeout: clientNotificationTimeout,
+	}
+	uri := ba.Details.Client.Meta.BackchannelClientNotificationEndpoint
+	for count := range clientNotificationAttempts {
+		if count > 0 {
+			time.Sleep(time.Duration(count) * clientNotificationRetryInterval)
+		}
+		var r *http.Request
+		r, err = http.NewRequestWithContext(ctx, http.MethodPost, uri, bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		r.Header.Set(httphelper.HeaderContentType, httphelper.MimeTypeJson)
+		r.Header.Set("Authorization", "Bearer "+ba.Details.ClientNotificationToken)
+		var resp *http.Response
+		resp, err = c.Do(r)
+		if err != nil {
+			continue
+		}
+		resp.Body.Close()
+		// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.2
+		// The Client MUST respond with an HTTP 204 No Content. The OP SHOULD also accept HTTP 200 OK
+		if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusOK {
+			return nil
+		}
+		err = fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+	log.Printf("client notification failed: client_id=%s uri=%s error=%v", ba.Details.Client.Identity.ClientId, uri, err)
+	return err
+}
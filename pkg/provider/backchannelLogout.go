@@ -0,0 +1,200 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+	backchannelLogoutEvent         = "http://schemas.openid.net/event/backchannel-logout"
+	backchannelLogoutTokenType     = "logout+jwt"
+	backchannelLogoutTokenLifetime = 2 * time.Minute
+	backchannelLogoutAttempts      = 3
+	backchannelLogoutRetryInterval = 500 * time.Millisecond
+	backchannelLogoutTimeout       = 2 * time.Second
+	// 全クライアントへの送信の上限（ログアウト応答を遅延させないため）
+	backchannelLogoutDeadline = 5 * time.Second
+)
+
+// backchannelLogout sends logout tokens to every client participating in the session in parallel.
+// Delivery (including retries) is abandoned after backchannelLogoutDeadline.
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest
+func backchannelLogout(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, ses *model.Session, participants []*model.Client) []*oppb.BackchannelLogoutResult {
+	if !iss.Meta.BackchannelLogoutSupported {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, backchannelLogoutDeadline)
+	defer cancel()
+
+	clients := []*model.Client{}
+	for _, client := range participants {
+		if client.Meta.BackchannelLogoutUri != "" {
+			clients = append(clients, client)
+		}
+	}
+
+	results := make([]*oppb.BackchannelLogoutResult, len(clients))
+	wg := sync.WaitGroup{}
+	for i, client := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = sendBackchannelLogout(ctx, mapper, iss, ses, client)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func sendBackchannelLogout(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, ses *model.Session, client *model.Client) *oppb.BackchannelLogoutResult {
+	result := &oppb.BackchannelLogoutResult{
+		ClientId:             client.Identity.ClientId,
+		BackchannelLogoutUri: client.Meta.BackchannelLogoutUri,
+	}
+
+	token, err := makeLogoutToken(ctx, mapper, iss, ses, client, time.Now())
+	if err != nil {
+		log.Printf("[BACKEND_ERROR] makeLogoutToken(%s):%v", client.Identity.ClientId, err)
+		result.Error = err.Error()
+		return result
+	}
+
+	c := &http.Client{
+		Timeout: backchannelLogoutTimeout,
+	}
+	for count := range backchannelLogoutAttempts {
+		if count > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Duration(count) * backchannelLogoutRetryInterval):
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		result.Attempts = int32(count + 1)
+		result.StatusCode, err = postLogoutToken(ctx, c, client.Meta.BackchannelLogoutUri, token)
+		if err == nil {
+			result.Error = ""
+			break
+		}
+		result.Error = err.Error()
+		// 4xx はクライアントが拒否したものとして再送しない
+		if result.StatusCode >= http.StatusBadRequest && result.StatusCode < http.StatusInternalServerError {
+			break
+		}
+	}
+	if result.Error != "" {
+		log.Printf("backchannel logout failed: client_id=%s uri=%s attempts=%d error=%s",
+			result.ClientId, result.BackchannelLogoutUri, result.Attempts, result.Error)
+	}
+	return result
+}
+
+func postLogoutToken(ctx context.Context, c *http.Client, uri, token string) (int32, error) {
+	vals := url.Values{}
+	vals.Set("logout_token", token)
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(vals.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.Do(r)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCResponse
+	// If the logout succeeded, the RP MUST respond with HTTP 200 OK.
+	// However, note that some Web frameworks will substitute an HTTP 204 No Content response ...
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return int32(resp.StatusCode), fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+	return int32(resp.StatusCode), nil
+}
+
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+func makeLogoutToken(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, ses *model.Session, client *model.Client, now time.Time) (string, error) {
+	jti, err := randutil.UuidV4()
+	if err != nil {
+		return "", err
+	}
+	// ID トークンと同じ sub を通知する
+	sub, err := mapper.ClientSubject(ctx, iss, client, ses.Details.Meta.Subject)
+	if err != nil {
+		return "", err
+	}
+	c := jwt.MapClaims{
+		"iss": iss.Meta.Issuer,
+		"sub": sub,
+		"aud": client.Identity.ClientId,
+		"iat": now.Unix(),
+		"exp": now.Add(backchannelLogoutTokenLifetime).Unix(),
+		"jti": jti,
+		"events": map[string]any{
+			backchannelLogoutEvent: map[string]any{},
+		},
+	}
+	if iss.Meta.BackchannelLogoutSessionSupported || client.Meta.BackchannelLogoutSessionRequired {
+		c["sid"] = ses.Details.Key.Id
+	}
+
+	alg, err := logoutTokenSigningAlg(iss, client)
+	if err != nil {
+		return "", err
+	}
+	return makeTypedJwt(ctx, iss, c, alg, backchannelLogoutTokenType)
+}
+
+// logoutTokenSigningAlg uses id_token_signed_response_alg like makeIdToken.
+// Logout Tokens MUST be signed, so an unsigned or omitted alg falls back to
+// the first id_token_signing_alg_values_supported the issuer has a key for.
+func logoutTokenSigningAlg(iss *model.Issuer, client *model.Client) (string, error) {
+	if alg := client.Meta.IdTokenSignedResponseAlg; alg != "" && alg != jwt.SigningMethodNone.Alg() {
+		return alg, nil
+	}
+	for _, alg := range iss.Meta.IdTokenSigningAlgValuesSupported {
+		if keyType, ok := keyutil.KeyType(alg); ok {
+			if _, ok := iss.Resources.KeyMap[keyType]; ok {
+				return alg, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no signing key for the logout token")
+}
@@ -0,0 +1,221 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+func TestMakeLogoutToken(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		name            string
+		issuer          func(iss *model.Issuer)
+		client          func(c *model.Client)
+		expectedAlg     string
+		expectedSid     bool
+		expectedFailure bool
+	}
+
+	tests := []testCase{
+		{
+			name:        "id_token_signed_response_alg is used",
+			issuer:      func(iss *model.Issuer) {},
+			client:      func(c *model.Client) { c.Meta.IdTokenSignedResponseAlg = "ES256" },
+			expectedAlg: "ES256",
+		},
+		{
+			name: "Unsigned id_token_signed_response_alg falls back to a supported alg with a key",
+			issuer: func(iss *model.Issuer) {
+				iss.Meta.IdTokenSigningAlgValuesSupported = []string{"none", "RS384", "ES256"}
+			},
+			client:      func(c *model.Client) { c.Meta.IdTokenSignedResponseAlg = "none" },
+			expectedAlg: "ES256",
+		},
+		{
+			name:        "Omitted id_token_signed_response_alg falls back to a supported alg",
+			issuer:      func(iss *model.Issuer) {},
+			client:      func(c *model.Client) { c.Meta.IdTokenSignedResponseAlg = "" },
+			expectedAlg: "RS256",
+		},
+		{
+			name: "No key for the supported algs",
+			issuer: func(iss *model.Issuer) {
+				iss.Meta.IdTokenSigningAlgValuesSupported = []string{"none", "RS384"}
+			},
+			client:          func(c *model.Client) { c.Meta.IdTokenSignedResponseAlg = "none" },
+			expectedFailure: true,
+		},
+		{
+			name:   "sid is included when the client requires it",
+			issuer: func(iss *model.Issuer) {},
+			client: func(c *model.Client) {
+				c.Meta.BackchannelLogoutSessionRequired = true
+			},
+			expectedAlg: "RS256",
+			expectedSid: true,
+		},
+		{
+			name: "sid is included when the OP supports it",
+			issuer: func(iss *model.Issuer) {
+				iss.Meta.BackchannelLogoutSessionSupported = true
+			},
+			client:      func(c *model.Client) {},
+			expectedAlg: "RS256",
+			expectedSid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			iss := newTestIssuer(t)
+			tc.issuer(iss)
+			client := newTestClient(t, iss, tc.client)
+			ses := newTestSession(t, iss, "user-1", client)
+
+			now := time.Now()
+			token, err := makeLogoutToken(ctx, testProvider.subjectMapper(), iss, ses, client, now)
+			if tc.expectedFailure {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			claims := jwt.MapClaims{}
+			parsed, err := jwt.NewParser(jwt.WithValidMethods([]string{tc.expectedAlg})).
+				ParseWithClaims(token, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "logout+jwt", parsed.Header["typ"])
+			assert.Equal(t, iss.Meta.Issuer, claims["iss"])
+			assert.Equal(t, "user-1", claims["sub"])
+			assert.Equal(t, client.Identity.ClientId, claims["aud"])
+			assert.Equal(t, float64(now.Unix()), claims["iat"])
+			assert.NotEmpty(t, claims["jti"])
+			// A Logout Token MUST NOT contain a nonce Claim.
+			assert.NotContains(t, claims, "nonce")
+			assert.Equal(t, map[string]any{
+				"http://schemas.openid.net/event/backchannel-logout": map[string]any{},
+			}, claims["events"])
+			if tc.expectedSid {
+				assert.Equal(t, ses.Details.Key.Id, claims["sid"])
+			} else {
+				assert.NotContains(t, claims, "sid")
+			}
+		})
+	}
+}
+
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest
+func TestBackchannelLogout(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.Meta.BackchannelLogoutSupported = true
+
+	type testCase struct {
+		name             string
+		statusCode       int
+		expectedAttempts int32
+		expectedError    bool
+	}
+
+	tests := []testCase{
+		{
+			name:             "Delivered to the backchannel_logout_uri",
+			statusCode:       http.StatusOK,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "204 No Content is also accepted",
+			statusCode:       http.StatusNoContent,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "Rejected by the RP is not retried",
+			statusCode:       http.StatusBadRequest,
+			expectedAttempts: 1,
+			expectedError:    true,
+		},
+		{
+			name:             "Server error is retried",
+			statusCode:       http.StatusInternalServerError,
+			expectedAttempts: backchannelLogoutAttempts,
+			expectedError:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var received atomic.Int32
+			var logoutToken atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received.Add(1)
+				logoutToken.Store(r.PostFormValue("logout_token"))
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.BackchannelLogoutUri = server.URL
+			})
+			// backchannel_logout_uri を登録していないクライアントには送らない
+			frontOnly := newTestClient(t, iss)
+			ses := newTestSession(t, iss, "user-1", client, frontOnly)
+
+			results := backchannelLogout(ctx, testProvider.subjectMapper(), iss, ses, []*model.Client{client, frontOnly})
+			if !assert.Len(t, results, 1) {
+				return
+			}
+			assert.Equal(t, client.Identity.ClientId, results[0].ClientId)
+			assert.Equal(t, int32(tc.statusCode), results[0].StatusCode)
+			assert.Equal(t, tc.expectedAttempts, results[0].Attempts)
+			assert.Equal(t, tc.expectedAttempts, received.Load())
+			assert.Equal(t, tc.expectedError, results[0].Error != "")
+			assert.NotEmpty(t, logoutToken.Load())
+		})
+	}
+
+	t.Run("Not sent when the OP does not support backchannel logout", func(t *testing.T) {
+		unsupported := newTestIssuer(t)
+		client := newTestClient(t, unsupported, func(c *model.Client) {
+			c.Meta.BackchannelLogoutUri = "https://rp.example.com/logout"
+		})
+		ses := newTestSession(t, unsupported, "user-1", client)
+		assert.Empty(t, backchannelLogout(ctx, testProvider.subjectMapper(), unsupported, ses, []*model.Client{client}))
+	})
+}
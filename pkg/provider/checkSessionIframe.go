@@ -0,0 +1,108 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+)
+
+// https://openid.net/specs/openid-connect-session-1_0.html#OPiframe
+// RP iframe から "client_id + ' ' + session_state" を受け取り、
+// ブラウザ状態（opbs_ cookie）から session_state を再計算して "changed" / "unchanged" / "error" を返す
+const checkSessionIframeHtml = `
+<!DOCTYPE html>
+<html>
+  <head><title>Check Session</title></head>
+  <body>
+    <script>
+      const prefix = {{ .Prefix }};
+      function browserStates() {
+        return document.cookie.split(";")
+          .map((c) => c.trim())
+          .filter((c) => c.startsWith(prefix))
+          .map((c) => decodeURIComponent(c.substring(c.indexOf("=") + 1)));
+      }
+      async function sha256(s) {
+        const digest = await crypto.subtle.digest("SHA-256", new TextEncoder().encode(s));
+        return btoa(String.fromCharCode(...new Uint8Array(digest)))
+          .replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+      }
+      async function check(data, origin) {
+        if (typeof data !== "string") {
+          return "error";
+        }
+        const parts = data.split(" ");
+        if (parts.length !== 2) {
+          return "error";
+        }
+        const clientId = parts[0];
+        const sessionState = parts[1];
+        const i = sessionState.lastIndexOf(".");
+        if (i < 0) {
+          return "error";
+        }
+        const salt = sessionState.substring(i + 1);
+        for (const bs of browserStates()) {
+          const ss = (await sha256(clientId + " " + origin + " " + bs + " " + salt)) + "." + salt;
+          if (ss === sessionState) {
+            return "unchanged";
+          }
+        }
+        return "changed";
+      }
+      window.addEventListener("message", async (e) => {
+        if (!e.source) {
+          return;
+        }
+        const result = await check(e.data, e.origin);
+        e.source.postMessage(result, e.origin);
+      }, false);
+    </script>
+  </body>
+</html>
+`
+
+func (p *Provider) CheckSessionIframe(ctx context.Context,
+	req *connect.Request[oppb.CheckSessionIframeRequest]) (*connect.Response[oppb.CheckSessionIframeResponse], error) {
+	if _, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		t, err := template.New("CheckSession").Parse(checkSessionIframeHtml)
+		if err != nil {
+			return nil, err
+		}
+		buf := &bytes.Buffer{}
+		if err := t.Execute(buf, map[string]string{"Prefix": browserStateCookiePrefix}); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&oppb.CheckSessionIframeResponse{
+			Content: buf.String(),
+		}), nil
+	}
+}
@@ -0,0 +1,132 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://openid.net/specs/openid-connect-session-1_0.html#CreatingUpdatingSessions
+func TestGetSessionState(t *testing.T) {
+	// recompute は OP iframe と同じ手順で session_state を再計算する
+	recompute := func(clientId, origin, browserState, sessionState string) string {
+		salt := sessionState[strings.LastIndex(sessionState, ".")+1:]
+		hash := sha256.Sum256([]byte(clientId + " " + origin + " " + browserState + " " + salt))
+		return base64.RawURLEncoding.EncodeToString(hash[:]) + "." + salt
+	}
+
+	type testCase struct {
+		name      string
+		clientId  string
+		origin    string
+		sessionId string
+		unchanged bool
+	}
+
+	tests := []testCase{
+		{
+			name:      "Same client, origin and session",
+			clientId:  "client-1",
+			origin:    "https://rp.example.com",
+			sessionId: "session-1",
+			unchanged: true,
+		},
+		{
+			name:      "Another session",
+			clientId:  "client-1",
+			origin:    "https://rp.example.com",
+			sessionId: "session-2",
+			unchanged: false,
+		},
+		{
+			name:      "Another origin",
+			clientId:  "client-1",
+			origin:    "https://attacker.example.com",
+			sessionId: "session-1",
+			unchanged: false,
+		},
+		{
+			name:      "Another client",
+			clientId:  "client-2",
+			origin:    "https://rp.example.com",
+			sessionId: "session-1",
+			unchanged: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sessionState, err := getSessionState("client-1", "https://rp.example.com/callback?x=1", "session-1")
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Len(t, strings.Split(sessionState, "."), 2)
+			assert.Equal(t, tc.unchanged, recompute(tc.clientId, tc.origin, getBrowserState(tc.sessionId), sessionState) == sessionState)
+		})
+	}
+
+	t.Run("Salt differs for every session_state", func(t *testing.T) {
+		first, err := getSessionState("client-1", "https://rp.example.com/callback", "session-1")
+		assert.NoError(t, err)
+		second, err := getSessionState("client-1", "https://rp.example.com/callback", "session-1")
+		assert.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Browser state does not expose the session id", func(t *testing.T) {
+		assert.NotContains(t, getBrowserState("session-1"), "session-1")
+		assert.Equal(t, "opbs_group-1", getBrowserStateName("group-1"))
+	})
+}
+
+// https://openid.net/specs/openid-connect-session-1_0.html#OPiframe
+func TestCheckSessionIframe(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	req := connect.NewRequest(&oppb.CheckSessionIframeRequest{})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	res, err := testProvider.CheckSessionIframe(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ブラウザ状態の cookie 名の接頭辞を埋め込む
+	assert.Contains(t, res.Msg.Content, `const prefix = "opbs_";`)
+	assert.Contains(t, res.Msg.Content, `addEventListener("message"`)
+
+	t.Run("Unknown issuer", func(t *testing.T) {
+		req := connect.NewRequest(&oppb.CheckSessionIframeRequest{})
+		auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, "wrong-password"))
+		_, err := testProvider.CheckSessionIframe(ctx, req)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,237 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+func TestClientSecretJwt(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	assertion := func(client *model.Client, method jwt.SigningMethod, secret string, modify func(claims jwt.MapClaims)) string {
+		claims := jwt.MapClaims{
+			"iss": client.Identity.ClientId,
+			"sub": client.Identity.ClientId,
+			"aud": iss.Meta.TokenEndpoint,
+			"jti": client.Identity.ClientId + "-" + time.Now().String(),
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}
+		if modify != nil {
+			modify(claims)
+		}
+		signed, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	type testCase struct {
+		name      string
+		client    func(c *model.Client)
+		assertion func(client *model.Client) string
+		noType    bool
+		wantError string
+	}
+
+	tests := []testCase{
+		{
+			name:   "HS256 signed with client_secret",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, nil)
+			},
+		},
+		{
+			name:   "HS512 with the issuer as audience",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS512, client.Identity.ClientSecret, func(claims jwt.MapClaims) {
+					claims["aud"] = iss.Meta.Issuer
+				})
+			},
+		},
+		{
+			name:   "Signed with another secret",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, "other-secret", nil)
+			},
+			wantError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Signing alg differs from token_endpoint_auth_signing_alg",
+			client: func(c *model.Client) {
+				c.Meta.TokenEndpointAuthSigningAlg = jwt.SigningMethodHS512.Alg()
+			},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, nil)
+			},
+			wantError: oauth.TokenErrorInvalidClient,
+		},
+		{
+			name: "Not allowed for FAPI clients",
+			client: func(c *model.Client) {
+				c.Extensions.Profile = oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0
+			},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, nil)
+			},
+			wantError: oauth.TokenErrorInvalidClient,
+		},
+		{
+			name:   "Expired assertion",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, func(claims jwt.MapClaims) {
+					claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				})
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "iss and sub are not the client",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, func(claims jwt.MapClaims) {
+					claims["sub"] = "other-client"
+				})
+			},
+			wantError: oauth.TokenErrorInvalidClient,
+		},
+		{
+			name:   "aud is not the authorization server",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, func(claims jwt.MapClaims) {
+					claims["aud"] = "https://other.example.com"
+				})
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Missing client_assertion_type",
+			client: func(c *model.Client) {},
+			assertion: func(client *model.Client) string {
+				return assertion(client, jwt.SigningMethodHS256, client.Identity.ClientSecret, nil)
+			},
+			noType:    true,
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodClientSecretJwt
+				c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeClientCredentials)
+				tc.client(c)
+			})
+			form := url.Values{
+				"grant_type":       {oauth.GrantTypeClientCredentials},
+				"client_assertion": {tc.assertion(client)},
+			}
+			if !tc.noType {
+				form.Set("client_assertion_type", testClientAssertionType)
+			}
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantError != "" {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, tc.wantError, res.Msg.GetFail().Error.Error)
+				}
+				return
+			}
+			if assert.NotNil(t, res.Msg.GetSuccess()) {
+				assert.NotEmpty(t, res.Msg.GetSuccess().AccessToken)
+			}
+		})
+	}
+}
+
+func TestParseClientSecretJwt(t *testing.T) {
+	client := &model.Client{
+		Identity: &oppb.ClientIdentity{
+			ClientId:     "client",
+			ClientSecret: "secret",
+		},
+	}
+
+	type testCase struct {
+		name      string
+		client    *model.Client
+		method    jwt.SigningMethod
+		key       any
+		wantError bool
+	}
+
+	tests := []testCase{
+		{name: "HS256", client: client, method: jwt.SigningMethodHS256, key: []byte("secret")},
+		{name: "HS384", client: client, method: jwt.SigningMethodHS384, key: []byte("secret")},
+		{name: "HS512", client: client, method: jwt.SigningMethodHS512, key: []byte("secret")},
+		{name: "Wrong secret", client: client, method: jwt.SigningMethodHS256, key: []byte("wrong"), wantError: true},
+		{name: "Unsigned", client: client, method: jwt.SigningMethodNone, key: jwt.UnsafeAllowNoneSignatureType, wantError: true},
+		{
+			name: "Client without client_secret",
+			client: &model.Client{
+				Identity: &oppb.ClientIdentity{ClientId: "client"},
+			},
+			method:    jwt.SigningMethodHS256,
+			key:       []byte(""),
+			wantError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			signed, err := jwt.NewWithClaims(tc.method, jwt.RegisteredClaims{Issuer: "client"}).SignedString(tc.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rc := &jwt.RegisteredClaims{}
+			_, err = parseClientSecretJwt(tc.client, signed, rc)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "client", rc.Issuer)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// requestedConsent returns the scopes and the claim names of the claims parameter to be consented by the end-user.
+func requestedConsent(params *oppb.AuthorizationParameters) ([]string, []string) {
+	names := []string{}
+	if params.Claims != "" {
+		// https://openid.net/specs/openid-connect-core-1_0.html#ClaimsParameter
+		rules := map[string]map[string]json.RawMessage{}
+		if err := json.Unmarshal([]byte(params.Claims), &rules); err == nil {
+			for _, member := range []string{"userinfo", "id_token"} {
+				for name := range rules[member] {
+					if !slices.Contains(names, name) {
+						names = append(names, name)
+					}
+				}
+			}
+		}
+	}
+	slices.Sort(names)
+	return params.Scopes, names
+}
+
+func newConsent(iss *model.Issuer, client *model.Client, subject string) *model.Consent {
+	return &model.Consent{
+		Details: model.ConsentDetails{
+			Issuer:   iss.Key,
+			Subject:  subject,
+			ClientId: client.Identity.ClientId,
+		},
+	}
+}
+
+// consentRequired reports whether the end-user consent is required before issuing.
+// https://openid.net/specs/openid-connect-core-1_0.html#Consent
+func consentRequired(ctx context.Context, iss *model.Issuer, client *model.Client, subject string, params *oppb.AuthorizationParameters) bool {
+	if client.Attribute.FirstParty {
+		// ファーストパーティのクライアントは同意を省略する
+		return false
+	}
+	// https://openid.net/specs/openid-connect-core-1_0.html#AuthRequest
+	// consent: The Authorization Server SHOULD prompt the End-User for consent before returning information to the Client.
+	if slices.Contains(params.Prompts, "consent") {
+		return true
+	}
+	consent := newConsent(iss, client, subject)
+	if err := dataprovider.Get(ctx, consent); err != nil {
+		// 同意情報が存在しない
+		return true
+	}
+	scopes, claims := requestedConsent(params)
+	for _, scope := range scopes {
+		if !slices.Contains(consent.Details.Scopes, scope) {
+			return true
+		}
+	}
+	for _, claim := range claims {
+		if !slices.Contains(consent.Details.Claims, claim) {
+			return true
+		}
+	}
+	return false
+}
+
+// saveConsent records the scopes and claims consented by the end-user, so that repeat logins skip the consent.
+func saveConsent(ctx context.Context, iss *model.Issuer, client *model.Client, subject string, params *oppb.AuthorizationParameters) error {
+	now := time.Now()
+	consent := newConsent(iss, client, subject)
+	if err := dataprovider.Get(ctx, consent); err != nil {
+		consent = newConsent(iss, client, subject)
+		consent.CreateAt = now
+	}
+	scopes, claims := requestedConsent(params)
+	for _, scope := range scopes {
+		if !slices.Contains(consent.Details.Scopes, scope) {
+			consent.Details.Scopes = append(consent.Details.Scopes, scope)
+		}
+	}
+	for _, claim := range claims {
+		if !slices.Contains(consent.Details.Claims, claim) {
+			consent.Details.Claims = append(consent.Details.Claims, claim)
+		}
+	}
+	consent.UpdateAt = now
+	return dataprovider.Set(ctx, consent)
+}
+
+// requestConsent records the end-user awaiting consent on the request and returns the consent next-action.
+func requestConsent(ctx context.Context, r *model.Request, sessionId, subject string) (*oppb.AuthorizationNextActionConsent, error) {
+	r.Details.ConsentSubject = subject
+	r.Details.ConsentSessionId = sessionId
+	if err := dataprovider.Set(ctx, r); err != nil {
+		return nil, err
+	}
+	return &oppb.AuthorizationNextActionConsent{
+		RequestId:  r.Details.Key.Id,
+		SessionId:  sessionId,
+		Subject:    subject,
+		Client:     r.Details.Client.Meta,
+		AuthParams: r.Details.AuthParams,
+	}, nil
+}
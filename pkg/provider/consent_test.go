@@ -0,0 +1,264 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsentRequired(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	consentClient := func(clientId string, firstParty bool) *model.Client {
+		return &model.Client{
+			Identity:  &oppb.ClientIdentity{ClientId: clientId},
+			Attribute: &oppb.ClientAttribute{FirstParty: firstParty},
+		}
+	}
+	granted := &oppb.AuthorizationParameters{
+		Scopes: []string{"openid", "profile"},
+		Claims: `{"userinfo":{"email":null}}`,
+	}
+
+	type testCase struct {
+		name     string
+		grant    func(t *testing.T)
+		client   *model.Client
+		subject  string
+		params   *oppb.AuthorizationParameters
+		expected bool
+	}
+
+	save := func(client *model.Client, subject string, params *oppb.AuthorizationParameters) func(t *testing.T) {
+		return func(t *testing.T) {
+			if err := saveConsent(ctx, iss, client, subject, params); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	tests := []testCase{
+		{
+			name:     "No consent granted",
+			client:   consentClient("client-1", false),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}},
+			expected: true,
+		},
+		{
+			name:     "Consent granted for the scopes and claims",
+			grant:    save(consentClient("client-2", false), "user-1", granted),
+			client:   consentClient("client-2", false),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}, Claims: `{"id_token":{"email":null}}`},
+			expected: false,
+		},
+		{
+			name:     "Additional scope",
+			grant:    save(consentClient("client-3", false), "user-1", granted),
+			client:   consentClient("client-3", false),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid", "email"}},
+			expected: true,
+		},
+		{
+			name:     "Additional claim",
+			grant:    save(consentClient("client-4", false), "user-1", granted),
+			client:   consentClient("client-4", false),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}, Claims: `{"userinfo":{"phone_number":null}}`},
+			expected: true,
+		},
+		{
+			name:     "prompt=consent",
+			grant:    save(consentClient("client-5", false), "user-1", granted),
+			client:   consentClient("client-5", false),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}, Prompts: []string{"consent"}},
+			expected: true,
+		},
+		{
+			name:     "First-party client",
+			client:   consentClient("client-6", true),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}},
+			expected: false,
+		},
+		{
+			name:     "Consent of another end-user",
+			grant:    save(consentClient("client-7", false), "user-2", granted),
+			client:   consentClient("client-7", false),
+			subject:  "user-1",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}},
+			expected: true,
+		},
+		{
+			// ("user a", "client-8") と ("user", "a client-8") は別の組み合わせ
+			name:     "Subject containing a space does not share the consent",
+			grant:    save(consentClient("client-8", false), "user a", granted),
+			client:   consentClient("a client-8", false),
+			subject:  "user",
+			params:   &oppb.AuthorizationParameters{Scopes: []string{"openid"}},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.grant != nil {
+				tc.grant(t)
+			}
+			assert.Equal(t, tc.expected, consentRequired(ctx, iss, tc.client, tc.subject, tc.params))
+		})
+	}
+}
+
+func TestAuthorizationConsent(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	type testCase struct {
+		name       string
+		firstParty bool
+		granted    []string
+		prompt     string
+		scope      string
+		consent    bool
+		error      string
+	}
+
+	tests := []testCase{
+		{
+			name:   "prompt=none without consent",
+			prompt: "none",
+			scope:  "openid",
+			error:  oauth.AuthorizationErrorConsentRequired,
+		},
+		{
+			name:    "prompt=none with consent",
+			granted: []string{"openid", "profile"},
+			prompt:  "none",
+			scope:   "openid profile",
+		},
+		{
+			name:    "prompt=none with additional scope",
+			granted: []string{"openid"},
+			prompt:  "none",
+			scope:   "openid profile",
+			error:   oauth.AuthorizationErrorConsentRequired,
+		},
+		{
+			name:       "prompt=none with first-party client",
+			firstParty: true,
+			prompt:     "none",
+			scope:      "openid",
+		},
+		{
+			name:    "Without consent",
+			scope:   "openid",
+			consent: true,
+		},
+		{
+			name:    "With consent",
+			granted: []string{"openid"},
+			scope:   "openid",
+		},
+		{
+			name:    "prompt=consent with consent",
+			granted: []string{"openid"},
+			prompt:  "consent",
+			scope:   "openid",
+			consent: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ses := newTestSession(t, iss, "user-1")
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Attribute.SessionGroupId = ses.Details.SessionGroup.Key.Id
+				c.Attribute.FirstParty = tc.firstParty
+			})
+			if tc.granted != nil {
+				if err := saveConsent(ctx, iss, client, "user-1", &oppb.AuthorizationParameters{Scopes: tc.granted}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			form := url.Values{}
+			form.Set("client_id", client.Identity.ClientId)
+			form.Set("response_type", oauth.ResponseTypeCode)
+			form.Set("redirect_uri", client.Meta.RedirectUris[0])
+			form.Set("scope", tc.scope)
+			form.Set("state", "xyz")
+			if tc.prompt != "" {
+				form.Set("prompt", tc.prompt)
+			}
+			req := connect.NewRequest(&oppb.AuthorizationRequest{
+				Method: http.MethodGet,
+				Url:    "https://op.example.com/authorize?" + form.Encode(),
+				Sessions: map[string]string{
+					ses.Details.SessionGroup.Key.Id: ses.Details.Key.Id,
+				},
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			res, err := testProvider.Authorization(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			switch {
+			case tc.error != "":
+				// エラーはリダイレクトで応答される
+				if assert.NotNil(t, res.Msg.GetRedirect()) {
+					u, err := url.Parse(res.Msg.GetRedirect().Url)
+					if err != nil {
+						t.Fatal(err)
+					}
+					assert.Equal(t, tc.error, u.Query().Get("error"))
+					assert.Equal(t, "xyz", u.Query().Get("state"))
+				}
+			case tc.consent:
+				if assert.NotNil(t, res.Msg.GetConsent()) {
+					assert.Equal(t, "user-1", res.Msg.GetConsent().Subject)
+				}
+			default:
+				// 同意済みの場合は同意画面を表示せずに認可コード発行へ進む
+				if assert.NotNil(t, res.Msg.GetIssue()) {
+					assert.Equal(t, ses.Details.Key.Id, res.Msg.GetIssue().SessionId)
+					assert.Equal(t, "user-1", res.Msg.GetIssue().Subject)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+const (
+	deviceCodeLifetime        = 10 * time.Minute
+	deviceCodeInterval        = 5 // seconds
+	defaultDeviceVerification = "/device"
+	// https://www.rfc-editor.org/rfc/rfc8628.html#section-6.1
+	// base-20 character set (no vowels) to avoid ambiguous or offensive codes
+	userCodeRunes  = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength = 8
+)
+
+func (p *Provider) DeviceAuthorization(ctx context.Context,
+	req *connect.Request[oppb.DeviceAuthorizationRequest]) (*connect.Response[oppb.DeviceAuthorizationResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.1
+		if req.Msg.Method != http.MethodPost {
+			return deviceAuthorizationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "method must be POST"), nil
+		}
+		if ct := req.Msg.ContentType; !strings.HasPrefix(ct, httphelper.MimeTypeWwwFormUnlencoded) {
+			return deviceAuthorizationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest,
+				"content-type does not has "+httphelper.MimeTypeWwwFormUnlencoded+":"+ct), nil
+		}
+		vals := query.Parse(req.Msg.Form)
+
+		client, fail, err := identifyClient(ctx, iss, req.Msg.BasicAuth, vals)
+		if err != nil {
+			return nil, err
+		} else if fail != nil {
+			return connect.NewResponse(&oppb.DeviceAuthorizationResponse{
+				DeviceAuthorizationResponseOneof: &oppb.DeviceAuthorizationResponse_Fail{
+					Fail: fail,
+				},
+			}), nil
+		}
+
+		// エンドポイント認証チェック
+		params := &clientAuthentication{
+			AllowAudience: []string{
+				iss.Meta.DeviceAuthorizationEndpoint,
+				iss.Meta.TokenEndpoint,
+				iss.Meta.Issuer,
+			},
+			BasicAuth:                         req.Msg.BasicAuth,
+			Client:                            client,
+			Issuer:                            iss,
+			Values:                            vals,
+			TlsClientCertificate:              req.Msg.TlsClientCertificate,
+			TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
+		}
+		if terr := p.checkClientAuthentication(ctx, params); terr != nil {
+			return connect.NewResponse(&oppb.DeviceAuthorizationResponse{
+				DeviceAuthorizationResponseOneof: &oppb.DeviceAuthorizationResponse_Fail{
+					Fail: terr,
+				},
+			}), nil
+		}
+
+		if !slices.Contains(client.Meta.GrantTypes, oauth.GrantTypeDeviceCode) {
+			return deviceAuthorizationFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "device_code is not allowed for this client"), nil
+		}
+
+		scopes, ok := grantedScopes(iss, client, vals.Get("scope"))
+		if !ok {
+			return deviceAuthorizationFail(http.StatusBadRequest, oauth.TokenErrorInvalidScope, "scope not allowed:"+vals.Get("scope")), nil
+		}
+
+		now := time.Now()
+		deviceCode, err := randutil.UuidV4()
+		if err != nil {
+			return nil, err
+		}
+
+		// ユーザーコードを生成する（重複時はリトライ）
+		var uc *model.DeviceUserCode
+		if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+			userCode, err := randutil.String(userCodeLength, userCodeRunes)
+			if err != nil {
+				return err
+			}
+			uc = &model.DeviceUserCode{
+				CreateAt: now,
+				Details: model.DeviceUserCodeDetails{
+					Issuer:     iss.Key,
+					UserCode:   userCode,
+					DeviceCode: deviceCode,
+				},
+				ExpireAt: now.Add(deviceCodeLifetime),
+			}
+			return dataprovider.Create(ctx, uc)
+		}); err != nil {
+			log.Printf("[BACKEND_ERROR] DeviceUserCode Create error:%v", err)
+			return nil, err
+		}
+
+		// ユーザー認証（ログイン画面）で使用するリクエスト情報を生成する
+		requestId, err := randutil.UuidV4()
+		if err != nil {
+			return nil, err
+		}
+		r := model.NewRequest(requestId, iss.Meta.Issuer, client, &oppb.AuthorizationParameters{
+			ClientId: client.Identity.ClientId,
+			Scopes:   scopes,
+			MaxAge:   -1,
+		}, now)
+		r.Details.DeviceCode = deviceCode
+		r.ExpireAt = now.Add(deviceCodeLifetime)
+		if err := dataprovider.Create(ctx, r); err != nil {
+			log.Printf("[BACKEND_ERROR] Request Create error:%v", err)
+			return nil, err
+		}
+
+		da := &model.DeviceAuthorization{
+			CreateAt: now,
+			Details: model.DeviceAuthorizationDetails{
+				Issuer:     iss.Key,
+				DeviceCode: deviceCode,
+				UserCode:   uc.Details.UserCode,
+				RequestId:  requestId,
+				ClientId:   client.Identity.ClientId,
+				Status:     model.DeviceAuthorizationStatusPending,
+				Interval:   deviceCodeInterval,
+			},
+			ExpireAt: now.Add(deviceCodeLifetime),
+		}
+		if err := dataprovider.Create(ctx, da); err != nil {
+			log.Printf("[BACKEND_ERROR] DeviceAuthorization Create error:%v", err)
+			return nil, err
+		}
+
+		verificationUri := iss.Attribute.GetDeviceVerificationUri()
+		if verificationUri == "" {
+			verificationUri = strings.TrimSuffix(iss.Meta.Issuer, "/") + defaultDeviceVerification
+		}
+		complete, err := url.Parse(verificationUri)
+		if err != nil {
+			return nil, err
+		}
+		q := complete.Query()
+		q.Set("user_code", formatUserCode(uc.Details.UserCode))
+		complete.RawQuery = q.Encode()
+
+		return connect.NewResponse(&oppb.DeviceAuthorizationResponse{
+			DeviceAuthorizationResponseOneof: &oppb.DeviceAuthorizationResponse_Success{
+				Success: &oppb.DeviceAuthorizationSuccessResponse{
+					DeviceCode:              deviceCode,
+					UserCode:                formatUserCode(uc.Details.UserCode),
+					VerificationUri:         verificationUri,
+					VerificationUriComplete: complete.String(),
+					ExpiresIn:               int32(deviceCodeLifetime.Seconds()),
+					Interval:                deviceCodeInterval,
+				},
+			},
+		}), nil
+	}
+}
+
+func deviceAuthorizationFail(statusCode int32, code, description string) *connect.Response[oppb.DeviceAuthorizationResponse] {
+	return connect.NewResponse(&oppb.DeviceAuthorizationResponse{
+		DeviceAuthorizationResponseOneof: &oppb.DeviceAuthorizationResponse_Fail{
+			Fail: &oppb.TokenFailResponse{
+				StatusCode: statusCode,
+				Error: &oppb.OauthError{
+					Error:            code,
+					ErrorDescription: description,
+				},
+			},
+		},
+	})
+}
+
+// https://www.rfc-editor.org/rfc/rfc8628.html#section-6.1
+// 表示用に "XXXX-XXXX" 形式にする
+func formatUserCode(userCode string) string {
+	if len(userCode) != userCodeLength {
+		return userCode
+	}
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
+
+// 入力されたユーザーコードを正規化する（大文字化、区切り文字の除去）
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(userCode))
+}
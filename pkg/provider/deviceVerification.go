@@ -0,0 +1,203 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const deviceVerificationHtml = `
+<!DOCTYPE html>
+<html>
+  <head><title>Device Verification</title></head>
+  <body>
+    {{ if .Message }}<p>{{ .Message }}</p>{{ end }}
+    <form method="post">
+      <label for="user_code">Enter the code displayed on your device</label>
+      <input type="text" id="user_code" name="user_code" value="{{ .UserCode }}" autocomplete="off" autofocus/>
+      <button type="submit">Continue</button>
+    </form>
+  </body>
+</html>
+`
+
+const deviceResultHtml = `
+<!DOCTYPE html>
+<html>
+  <head><title>Device Verification</title></head>
+  <body>
+    <p>{{ . }}</p>
+  </body>
+</html>
+`
+
+// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.3
+func (p *Provider) DeviceVerification(ctx context.Context,
+	req *connect.Request[oppb.DeviceVerificationRequest]) (*connect.Response[oppb.DeviceVerificationResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		userCode := normalizeUserCode(req.Msg.UserCode)
+		if userCode == "" {
+			return deviceVerificationForm("", "")
+		}
+
+		uc := &model.DeviceUserCode{
+			Details: model.DeviceUserCodeDetails{
+				Issuer:   iss.Key,
+				UserCode: userCode,
+			},
+		}
+		if err := dataprovider.Get(ctx, uc); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return deviceVerificationForm(req.Msg.UserCode, "The code is invalid.")
+			}
+			return nil, err
+		}
+
+		da := &model.DeviceAuthorization{
+			Details: model.DeviceAuthorizationDetails{
+				Issuer:     iss.Key,
+				DeviceCode: uc.Details.DeviceCode,
+			},
+		}
+		if err := dataprovider.Get(ctx, da); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return deviceVerificationForm(req.Msg.UserCode, "The code is invalid.")
+			}
+			return nil, err
+		}
+		if time.Now().After(da.ExpireAt) || da.Details.Status != model.DeviceAuthorizationStatusPending {
+			return deviceVerificationForm(req.Msg.UserCode, "The code has expired or has already been used.")
+		}
+
+		r := &model.Request{
+			Details: model.RequestDetails{
+				Key: &oppb.CommonKey{
+					Id: da.Details.RequestId,
+				},
+				Client: &model.Client{
+					Issuer: iss.Key,
+				},
+			},
+		}
+		if err := dataprovider.Get(ctx, r); err != nil {
+			log.Printf("[BACKEND_ERROR] device request Get error:%v", err)
+			return nil, err
+		}
+
+		// ログイン画面（WriteLoginHtmlCallback）へ
+		return connect.NewResponse(&oppb.DeviceVerificationResponse{
+			DeviceVerificationResponseOneof: &oppb.DeviceVerificationResponse_Login{
+				Login: &oppb.AuthorizationNextActionLogin{
+					RequestId:  r.Details.Key.Id,
+					Client:     r.Details.Client.Meta,
+					AuthParams: r.Details.AuthParams,
+				},
+			},
+		}), nil
+	}
+}
+
+func deviceVerificationForm(userCode, message string) (*connect.Response[oppb.DeviceVerificationResponse], error) {
+	t, err := template.New("DeviceVerification").Parse(deviceVerificationHtml)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, map[string]string{"UserCode": userCode, "Message": message}); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&oppb.DeviceVerificationResponse{
+		DeviceVerificationResponseOneof: &oppb.DeviceVerificationResponse_Html{
+			Html: &oppb.AuthorizationHtmlResponse{
+				Content: buf.String(),
+			},
+		},
+	}), nil
+}
+
+// completeDeviceAuthorization records the end-user's decision on the device authorization
+// and returns the page shown on the verification device.
+func completeDeviceAuthorization(ctx context.Context, iss *model.Issuer, r *model.Request, authorized *model.Authorized) (*oppb.AuthorizationHtmlResponse, error) {
+	da := &model.DeviceAuthorization{
+		Details: model.DeviceAuthorizationDetails{
+			Issuer:     iss.Key,
+			DeviceCode: r.Details.DeviceCode,
+		},
+	}
+	if err := dataprovider.Get(ctx, da); err != nil {
+		log.Printf("[BACKEND_ERROR] DeviceAuthorization Get error:%v", err)
+		return nil, err
+	}
+	message := "Access was denied. You may close this window."
+	if authorized != nil {
+		da.Details.Status = model.DeviceAuthorizationStatusApproved
+		da.Details.Authorized = authorized
+		message = "Your device has been authorized. You may close this window and return to your device."
+	} else {
+		da.Details.Status = model.DeviceAuthorizationStatusDenied
+	}
+	if err := dataprovider.Set(ctx, da); err != nil {
+		log.Printf("[BACKEND_ERROR] DeviceAuthorization Set error:%v", err)
+		return nil, err
+	}
+
+	// ユーザーコードとリクエスト情報は１回限り
+	uc := &model.DeviceUserCode{
+		Details: model.DeviceUserCodeDetails{
+			Issuer:   iss.Key,
+			UserCode: da.Details.UserCode,
+		},
+	}
+	if err := dataprovider.Delete(ctx, uc); err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	if err := dataprovider.Delete(ctx, r); err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	t, err := template.New("DeviceResult").Parse(deviceResultHtml)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, message); err != nil {
+		return nil, err
+	}
+	return &oppb.AuthorizationHtmlResponse{
+		Content: buf.String(),
+	}, nil
+}
@@ -91,6 +91,8 @@ type configration struct {
 	RequireRequestUriRegistration bool `json:"require_request_uri_registration,omitempty"`
 	// https://openid.net/specs/openid-connect-rpinitiated-1_0.html#OPMetadata
 	EndSessionEndpoint string `json:"end_session_endpoint,omitempty"`
+	// https://openid.net/specs/openid-connect-session-1_0.html#OPMetadata
+	CheckSessionIframe string `json:"check_session_iframe,omitempty"`
 	// https://www.rfc-editor.org/rfc/rfc9126.html#section-5
 	PushedAuthorizationRequestEndpoint string `json:"pushed_authorization_request_endpoint,omitempty"`
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
@@ -108,6 +110,19 @@ type configration struct {
 	FrontchannelLogoutSessionSupported bool `json:"frontchannel_logout_session_supported,omitempty"`
 	// https://datatracker.ietf.org/doc/html/rfc8705#section-3.3
 	TlsClientCertificateBoundAccessTokens bool `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9701.html#section-7
+	IntrospectionSigningAlgValuesSupported []string `json:"introspection_signing_alg_values_supported,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc8628.html#section-4
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.4
+	BackchannelAuthenticationEndpoint                         string   `json:"backchannel_authentication_endpoint,omitempty"`
+	BackchannelTokenDeliveryModesSupported                    []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
+	BackchannelAuthenticationRequestSigningAlgValuesSupported []string `json:"backchannel_authentication_request_signing_alg_values_supported,omitempty"`
+	BackchannelUserCodeParameterSupported                     bool     `json:"backchannel_user_code_parameter_supported,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.1
+	DpopSigningAlgValuesSupported []string `json:"dpop_signing_alg_values_supported,omitempty"`
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+	AuthorizationDetailsTypesSupported []string `json:"authorization_details_types_supported,omitempty"`
 }
 
 func (p *Provider) Discovery(ctx context.Context,
@@ -0,0 +1,329 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-11.1
+	// DPoP proof の iat の許容範囲（jti はこの期間だけ記録する）
+	dpopProofLifetime = 5 * time.Minute
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+	dpopNonceLifetime = 5 * time.Minute
+)
+
+// dpopProof is a DPoP proof and the HTTP request it must be bound to.
+type dpopProof struct {
+	Proof       string
+	Method      string
+	Uri         string
+	AccessToken string // checked against the ath claim when set
+}
+
+// dpopError describes why a DPoP proof was rejected.
+type dpopError struct {
+	Error       string // invalid_dpop_proof or use_dpop_nonce
+	Description string
+	Nonce       string // nonce to be used in the next proof (use_dpop_nonce)
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.1
+// DPoP は dpop_signing_alg_values_supported が設定されている場合に有効になる
+func dpopSupported(iss *model.Issuer) bool {
+	return len(iss.Meta.DpopSigningAlgValuesSupported) > 0
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.3
+// checkDpopProof verifies the DPoP proof and returns the JWK SHA-256 thumbprint of its public key.
+func checkDpopProof(ctx context.Context, iss *model.Issuer, proof *dpopProof) (string, *dpopError) {
+	invalid := func(format string, a ...any) (string, *dpopError) {
+		return "", &dpopError{
+			Error:       oauth.TokenErrorInvalidDpopProof,
+			Description: fmt.Sprintf(format, a...),
+		}
+	}
+	if !dpopSupported(iss) {
+		return invalid("DPoP is not supported")
+	}
+	if proof.Proof == "" || strings.Contains(proof.Proof, ",") {
+		// 複数の DPoP ヘッダは許可されない
+		return invalid("exactly one DPoP proof is required")
+	}
+
+	jkt := ""
+	claims := jwt.MapClaims{}
+	token, err := jwt.NewParser(
+		jwt.WithValidMethods(iss.Meta.DpopSigningAlgValuesSupported),
+		// iat はプログラムでチェックする
+		jwt.WithoutClaimsValidation(),
+	).ParseWithClaims(proof.Proof, claims, func(t *jwt.Token) (any, error) {
+		if typ, _ := t.Header["typ"].(string); typ != "dpop+jwt" {
+			return nil, fmt.Errorf("invalid typ:%v", t.Header["typ"])
+		}
+		raw, err := json.Marshal(t.Header["jwk"])
+		if err != nil {
+			return nil, err
+		}
+		var key jwkset.JWKMarshal
+		if err := json.Unmarshal(raw, &key); err != nil {
+			return nil, fmt.Errorf("invalid jwk header")
+		}
+		// The jwk header MUST NOT contain a private key.
+		if key.D != "" || key.KTY == jwkset.KtyOct {
+			return nil, fmt.Errorf("jwk header must be a public key")
+		}
+		jwk, err := jwkset.NewJWKFromMarshal(key, jwkset.JWKMarshalOptions{}, jwkset.JWKValidateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if jkt, err = jwkThumbprint(key); err != nil {
+			return nil, err
+		}
+		return jwk.Key(), nil
+	})
+	if err != nil {
+		log.Printf("DPoP proof parse error: %v", err)
+		return invalid("DPoP proof parse error")
+	}
+	// 共通鍵と none は使用できない
+	if alg := token.Method.Alg(); alg == "none" || strings.HasPrefix(alg, "HS") {
+		return invalid("signing alg not allow:%s", alg)
+	}
+
+	if htm, _ := claims["htm"].(string); htm != proof.Method {
+		return invalid("htm not match:%s", htm)
+	}
+	if htu, _ := claims["htu"].(string); htu == "" || normalizeHtu(htu) != normalizeHtu(proof.Uri) {
+		return invalid("htu not match:%s", htu)
+	}
+	now := time.Now()
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return invalid("missing iat")
+	}
+	if iat.Before(now.Add(-dpopProofLifetime)) || iat.After(now.Add(dpopProofLifetime)) {
+		return invalid("iat is out of range")
+	}
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return invalid("missing jti")
+	}
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-7.1
+	if proof.AccessToken != "" {
+		hash := sha256.Sum256([]byte(proof.AccessToken))
+		if ath, _ := claims["ath"].(string); ath != base64.RawURLEncoding.EncodeToString(hash[:]) {
+			return invalid("ath not match")
+		}
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-8
+	// サーバー nonce を必須とする場合は、払い出した nonce であることを確認する
+	if iss.Attribute.DpopNonceRequired {
+		nonce, _ := claims["nonce"].(string)
+		if nonce == "" || !validDpopNonce(ctx, iss, nonce) {
+			newNonce, err := newDpopNonce(ctx, iss)
+			if err != nil {
+				log.Printf("[BACKEND_ERROR] DPoP nonce create error:%v", err)
+				return invalid("DPoP nonce could not be issued")
+			}
+			return "", &dpopError{
+				Error:       oauth.TokenErrorUseDpopNonce,
+				Description: "Authorization server requires nonce in DPoP proof",
+				Nonce:       newNonce,
+			}
+		}
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-11.1
+	// 同じ jti の proof の再利用を防ぐ
+	used := &model.DpopProof{
+		CreateAt: now,
+		Details: model.DpopProofDetails{
+			Issuer: iss.Key,
+			Jkt:    jkt,
+			Jti:    jti,
+		},
+		ExpireAt: iat.Add(dpopProofLifetime),
+	}
+	if err := dataprovider.Create(ctx, used); err != nil {
+		log.Printf("DPoP proof create error:%v", err)
+		return invalid("DPoP proof jti has already been used")
+	}
+	return jkt, nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-4.3
+// htu is compared without query and fragment parts.
+func normalizeHtu(value string) string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return value
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// https://www.rfc-editor.org/rfc/rfc7638.html#section-3
+// jwkThumbprint returns the base64url encoded JWK SHA-256 thumbprint (jkt).
+func jwkThumbprint(key jwkset.JWKMarshal) (string, error) {
+	var members string
+	switch key.KTY {
+	case jwkset.KtyEC:
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, key.CRV, key.KTY, key.X, key.Y)
+	case jwkset.KtyOKP:
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, key.CRV, key.KTY, key.X)
+	case jwkset.KtyRSA:
+		members = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, key.E, key.KTY, key.N)
+	default:
+		return "", fmt.Errorf("unsupported kty:%s", key.KTY)
+	}
+	hash := sha256.Sum256([]byte(members))
+	return base64.RawURLEncoding.EncodeToString(hash[:]), nil
+}
+
+func newDpopNonce(ctx context.Context, iss *model.Issuer) (string, error) {
+	nonce, err := randutil.UuidV4()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	if err := dataprovider.Create(ctx, &model.DpopNonce{
+		CreateAt: now,
+		Details: model.DpopNonceDetails{
+			Issuer: iss.Key,
+			Nonce:  nonce,
+		},
+		ExpireAt: now.Add(dpopNonceLifetime),
+	}); err != nil {
+		return "", err
+	}
+	return nonce, nil
+}
+
+func validDpopNonce(ctx context.Context, iss *model.Issuer, nonce string) bool {
+	n := &model.DpopNonce{
+		Details: model.DpopNonceDetails{
+			Issuer: iss.Key,
+			Nonce:  nonce,
+		},
+	}
+	if err := dataprovider.Get(ctx, n); err != nil {
+		return false
+	}
+	return time.Now().Before(n.ExpireAt)
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+// checkDpopRequest verifies the DPoP header sent to the token or PAR endpoint.
+// It returns an empty jkt when no proof is presented or DPoP is not supported.
+func checkDpopRequest(ctx context.Context, iss *model.Issuer, client *model.Client, value, method, uri string) (string, *dpopError) {
+	if !dpopSupported(iss) {
+		// DPoP をサポートしない場合は DPoP ヘッダを無視する
+		return "", nil
+	}
+	if value == "" {
+		// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+		// dpop_bound_access_tokens のクライアントは常に DPoP proof を送らなければならない
+		if client != nil && client.Meta.DpopBoundAccessTokens {
+			return "", &dpopError{
+				Error:       oauth.TokenErrorInvalidDpopProof,
+				Description: "DPoP proof is required",
+			}
+		}
+		return "", nil
+	}
+	return checkDpopProof(ctx, iss, &dpopProof{
+		Proof:  value,
+		Method: method,
+		Uri:    uri,
+	})
+}
+
+func dpopTokenFail(derr *dpopError) *connect.Response[oppb.TokenResponse] {
+	res := tokenFail(http.StatusBadRequest, derr.Error, derr.Description)
+	res.Msg.DpopNonce = derr.Nonce
+	return res
+}
+
+func dpopPushedAuthorizationFail(derr *dpopError) *connect.Response[oppb.PushedAuthorizationResponse] {
+	return connect.NewResponse(&oppb.PushedAuthorizationResponse{
+		PushedAuthorizationResponseOneof: &oppb.PushedAuthorizationResponse_Fail{
+			Fail: &oppb.PushedAuthorizationFailResponse{
+				StatusCode: http.StatusBadRequest,
+				Error: &oppb.OauthError{
+					Error:            derr.Error,
+					ErrorDescription: derr.Description,
+				},
+			},
+		},
+		DpopNonce: derr.Nonce,
+	})
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-7.1
+// DPoP バインドトークンのエラーは DPoP 認証スキームで応答する
+func errorDpop(iss *model.Issuer, code, description, nonce string) (*connect.Response[oppb.UserinfoResponse], error) {
+	headers := map[string]string{
+		"WWW-Authenticate": fmt.Sprintf("DPoP algs=\"%s\", error=\"%s\", error_description=\"%s\"",
+			strings.Join(iss.Meta.DpopSigningAlgValuesSupported, " "), code, description),
+	}
+	if nonce != "" {
+		headers[httphelper.HeaderDpopNonce] = nonce
+	}
+	return connect.NewResponse(&oppb.UserinfoResponse{
+		Headers:    headers,
+		StatusCode: http.StatusUnauthorized,
+	}), nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.1
+func accessTokenType(access *model.TokenIdentifier) string {
+	if access.Details.DpopJkt != "" {
+		return oauth.AccessTokenTypeDpop
+	}
+	return oauth.AccessTokenTypeBearer
+}
@@ -0,0 +1,409 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDpopKey is the key pair of a DPoP client.
+type testDpopKey struct {
+	private crypto.Signer
+	jwk     jwkset.JWKMarshal
+}
+
+func newTestDpopKey(t *testing.T) *testDpopKey {
+	t.Helper()
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := jwkset.NewJWKFromKey(private.Public(), jwkset.JWKOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testDpopKey{
+		private: private,
+		jwk:     jwk.Marshal(),
+	}
+}
+
+// thumbprint returns the jkt of the public key.
+func (k *testDpopKey) thumbprint(t *testing.T) string {
+	t.Helper()
+	jkt, err := jwkThumbprint(k.jwk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return jkt
+}
+
+// proof signs a DPoP proof with the claims after applying modify to the token.
+func (k *testDpopKey) proof(t *testing.T, claims jwt.MapClaims, modify func(token *jwt.Token)) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = "dpop+jwt"
+	token.Header["jwk"] = k.jwk
+	if modify != nil {
+		modify(token)
+	}
+	var key any = k.private
+	if token.Method == jwt.SigningMethodHS256 {
+		key = []byte("secret")
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func newTestDpopClaims(t *testing.T, htm, htu string) jwt.MapClaims {
+	t.Helper()
+	jti, err := randutil.UuidV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return jwt.MapClaims{
+		"jti": jti,
+		"htm": htm,
+		"htu": htu,
+		"iat": time.Now().Unix(),
+	}
+}
+
+func TestCheckDpopProof(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	key := newTestDpopKey(t)
+	other := newTestDpopKey(t)
+	accessHash := sha256.Sum256([]byte("access-token"))
+
+	type testCase struct {
+		name        string
+		proof       func(t *testing.T) string
+		accessToken string
+		wantError   bool
+	}
+
+	tests := []testCase{
+		{
+			name: "Valid proof",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil)
+			},
+		},
+		{
+			name: "htu is compared without query and fragment",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint+"?a=b#c"), nil)
+			},
+		},
+		{
+			name: "Valid proof with ath",
+			proof: func(t *testing.T) string {
+				claims := newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint)
+				claims["ath"] = base64.RawURLEncoding.EncodeToString(accessHash[:])
+				return key.proof(t, claims, nil)
+			},
+			accessToken: "access-token",
+		},
+		{
+			name: "Empty proof",
+			proof: func(t *testing.T) string {
+				return ""
+			},
+			wantError: true,
+		},
+		{
+			name: "Multiple proofs",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil) + "," +
+					key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil)
+			},
+			wantError: true,
+		},
+		{
+			name: "Invalid typ",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), func(token *jwt.Token) {
+					token.Header["typ"] = "JWT"
+				})
+			},
+			wantError: true,
+		},
+		{
+			name: "Symmetric signing alg",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), func(token *jwt.Token) {
+					token.Method = jwt.SigningMethodHS256
+					token.Header["alg"] = jwt.SigningMethodHS256.Alg()
+				})
+			},
+			wantError: true,
+		},
+		{
+			name: "jwk header contains a private key",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), func(token *jwt.Token) {
+					private, err := jwkset.NewJWKFromKey(key.private, jwkset.JWKOptions{
+						Marshal: jwkset.JWKMarshalOptions{Private: true},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					token.Header["jwk"] = private.Marshal()
+				})
+			},
+			wantError: true,
+		},
+		{
+			name: "Signed by a key other than the jwk header",
+			proof: func(t *testing.T) string {
+				return other.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), func(token *jwt.Token) {
+					token.Header["jwk"] = key.jwk
+				})
+			},
+			wantError: true,
+		},
+		{
+			name: "htm does not match",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "GET", iss.Meta.TokenEndpoint), nil)
+			},
+			wantError: true,
+		},
+		{
+			name: "htu does not match",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.IntrospectionEndpoint), nil)
+			},
+			wantError: true,
+		},
+		{
+			name: "iat is too old",
+			proof: func(t *testing.T) string {
+				claims := newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint)
+				claims["iat"] = time.Now().Add(-dpopProofLifetime - time.Minute).Unix()
+				return key.proof(t, claims, nil)
+			},
+			wantError: true,
+		},
+		{
+			name: "Missing jti",
+			proof: func(t *testing.T) string {
+				claims := newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint)
+				delete(claims, "jti")
+				return key.proof(t, claims, nil)
+			},
+			wantError: true,
+		},
+		{
+			name: "ath does not match the access token",
+			proof: func(t *testing.T) string {
+				claims := newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint)
+				claims["ath"] = base64.RawURLEncoding.EncodeToString(accessHash[:])
+				return key.proof(t, claims, nil)
+			},
+			accessToken: "other-access-token",
+			wantError:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jkt, derr := checkDpopProof(ctx, iss, &dpopProof{
+				Proof:       tc.proof(t),
+				Method:      "POST",
+				Uri:         iss.Meta.TokenEndpoint,
+				AccessToken: tc.accessToken,
+			})
+			if tc.wantError {
+				if assert.NotNil(t, derr) {
+					assert.Equal(t, oauth.TokenErrorInvalidDpopProof, derr.Error)
+				}
+				assert.Empty(t, jkt)
+			} else {
+				assert.Nil(t, derr)
+				assert.Equal(t, key.thumbprint(t), jkt)
+			}
+		})
+	}
+}
+
+func TestCheckDpopProofReplay(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	key := newTestDpopKey(t)
+
+	proof := &dpopProof{
+		Proof:  key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil),
+		Method: "POST",
+		Uri:    iss.Meta.TokenEndpoint,
+	}
+	_, derr := checkDpopProof(ctx, iss, proof)
+	assert.Nil(t, derr)
+
+	// 同じ jti の proof は拒否される
+	_, derr = checkDpopProof(ctx, iss, proof)
+	if assert.NotNil(t, derr) {
+		assert.Equal(t, oauth.TokenErrorInvalidDpopProof, derr.Error)
+	}
+
+	// 別の jti であれば同じ鍵で利用できる
+	proof.Proof = key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil)
+	_, derr = checkDpopProof(ctx, iss, proof)
+	assert.Nil(t, derr)
+}
+
+func TestCheckDpopProofNonce(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.Attribute.DpopNonceRequired = true
+	key := newTestDpopKey(t)
+
+	proof := &dpopProof{
+		Proof:  key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil),
+		Method: "POST",
+		Uri:    iss.Meta.TokenEndpoint,
+	}
+	_, derr := checkDpopProof(ctx, iss, proof)
+	if !assert.NotNil(t, derr) {
+		return
+	}
+	assert.Equal(t, oauth.TokenErrorUseDpopNonce, derr.Error)
+	assert.NotEmpty(t, derr.Nonce)
+
+	claims := newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint)
+	claims["nonce"] = derr.Nonce
+	proof.Proof = key.proof(t, claims, nil)
+	jkt, derr := checkDpopProof(ctx, iss, proof)
+	assert.Nil(t, derr)
+	assert.Equal(t, key.thumbprint(t), jkt)
+}
+
+func TestDpopTokenRequest(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	key := newTestDpopKey(t)
+
+	type testCase struct {
+		name          string
+		boundTokens   bool
+		proof         func(t *testing.T) string
+		wantError     string
+		wantTokenType string
+		wantJkt       string
+	}
+
+	tests := []testCase{
+		{
+			name: "DPoP bound access token",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "POST", iss.Meta.TokenEndpoint), nil)
+			},
+			wantTokenType: oauth.AccessTokenTypeDpop,
+			wantJkt:       key.thumbprint(t),
+		},
+		{
+			name: "Bearer access token without proof",
+			proof: func(t *testing.T) string {
+				return ""
+			},
+			wantTokenType: oauth.AccessTokenTypeBearer,
+		},
+		{
+			name:        "dpop_bound_access_tokens client without proof",
+			boundTokens: true,
+			proof: func(t *testing.T) string {
+				return ""
+			},
+			wantError: oauth.TokenErrorInvalidDpopProof,
+		},
+		{
+			name: "Invalid proof",
+			proof: func(t *testing.T) string {
+				return key.proof(t, newTestDpopClaims(t, "GET", iss.Meta.TokenEndpoint), nil)
+			},
+			wantError: oauth.TokenErrorInvalidDpopProof,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeClientCredentials)
+				c.Meta.DpopBoundAccessTokens = tc.boundTokens
+			})
+			req := newTestTokenRequest(iss, client, url.Values{
+				"grant_type": {oauth.GrantTypeClientCredentials},
+			})
+			req.Msg.Dpop = tc.proof(t)
+			res, err := testProvider.Token(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantError != "" {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, tc.wantError, res.Msg.GetFail().Error.Error)
+				}
+				return
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+			assert.Equal(t, tc.wantTokenType, success.TokenType)
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: success.AccessToken,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			if assert.Nil(t, dataprovider.Get(ctx, access)) {
+				assert.Equal(t, tc.wantJkt, access.Details.DpopJkt)
+			}
+		})
+	}
+}
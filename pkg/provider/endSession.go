@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const logoutRequestLifetime = 10 * time.Minute
+
+// https://openid.net/specs/openid-connect-rpinitiated-1_0.html
+func (p *Provider) EndSession(ctx context.Context,
+	req *connect.Request[oppb.EndSessionRequest]) (*connect.Response[oppb.EndSessionResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		// https://openid.net/specs/openid-connect-rpinitiated-1_0.html#RPLogout
+		// The OP MUST support the use of the HTTP GET and POST methods at the Logout Endpoint.
+		var parseTarget string
+		switch req.Msg.Method {
+		case http.MethodGet:
+			u, err := url.Parse(req.Msg.Url)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("url.Parse error"))
+			}
+			parseTarget = u.RawQuery
+		case http.MethodPost:
+			if ct := req.Msg.ContentType; !strings.HasPrefix(ct, httphelper.MimeTypeWwwFormUnlencoded) {
+				return endSessionFail(http.StatusBadRequest, "Unsupported content type:"+ct), nil
+			}
+			parseTarget = req.Msg.Form
+		default:
+			return endSessionFail(http.StatusMethodNotAllowed, "Method not allowed:"+req.Msg.Method), nil
+		}
+
+		vals := query.Parse(parseTarget)
+		idTokenHint := vals.Get("id_token_hint")
+		clientId := vals.Get("client_id")
+		postLogoutRedirectUri := vals.Get("post_logout_redirect_uri")
+
+		// The OP need not be the audience of the ID Token, but it is an ID Token
+		// previously issued by this OP.
+		subject := ""
+		if idTokenHint != "" {
+			hintClaims, err := verifyIdToken(ctx, iss, idTokenHint)
+			if err != nil {
+				log.Printf("verifyIdToken error:%v", err)
+				return endSessionFail(http.StatusBadRequest, "invalid id_token_hint"), nil
+			}
+			if len(hintClaims.Audience) == 0 {
+				return endSessionFail(http.StatusBadRequest, "id_token_hint has no audience"), nil
+			}
+			// When both client_id and id_token_hint are present, the OP MUST verify
+			// that the Client Identifier matches the one used when issuing the ID Token.
+			if clientId != "" && !slices.Contains(hintClaims.Audience, clientId) {
+				return endSessionFail(http.StatusBadRequest, "client_id does not match id_token_hint"), nil
+			}
+			if clientId == "" {
+				clientId = hintClaims.Audience[0]
+			}
+			subject = hintClaims.Subject
+		}
+
+		var client *model.Client
+		if clientId != "" {
+			client = &model.Client{
+				Identity: &oppb.ClientIdentity{
+					ClientId: clientId,
+				},
+				Issuer: iss.Key,
+			}
+			if err := dataprovider.Get(ctx, client); err != nil {
+				if status.Code(err) == codes.NotFound {
+					return endSessionFail(http.StatusBadRequest, "Unknown client_id:"+clientId), nil
+				}
+				return nil, err
+			}
+		}
+
+		// https://openid.net/specs/openid-connect-core-1_0.html#SubjectIDTypes
+		// pairwise の sub を内部の subject に戻す
+		logoutHint := vals.Get("logout_hint")
+		if client != nil {
+			mapper := p.subjectMapper()
+			if subject != "" {
+				internal, err := mapper.InternalSubject(ctx, iss, client, subject)
+				if err != nil {
+					log.Printf("InternalSubject error:%v", err)
+					return endSessionFail(http.StatusBadRequest, "unknown subject of id_token_hint"), nil
+				}
+				subject = internal
+			}
+			if logoutHint != "" {
+				// logout_hint は sub 以外の値の場合もあるため、戻せない場合はそのまま渡す
+				if internal, err := mapper.InternalSubject(ctx, iss, client, logoutHint); err == nil {
+					logoutHint = internal
+				}
+			}
+		}
+
+		// The OP MUST NOT perform post-logout redirection if the
+		// post_logout_redirect_uri value supplied does not exactly match one of
+		// the previously registered post_logout_redirect_uris values.
+		if postLogoutRedirectUri != "" {
+			if client == nil {
+				return endSessionFail(http.StatusBadRequest, "post_logout_redirect_uri requires client_id or id_token_hint"), nil
+			}
+			if !slices.Contains(client.Meta.PostLogoutRedirectUris, postLogoutRedirectUri) {
+				return endSessionFail(http.StatusBadRequest, "post_logout_redirect_uri not match"), nil
+			}
+		}
+
+		// Collect the sessions of the End-User from the session cookies.
+		// If the RP was identified, only the session group of the RP is terminated.
+		sessionIds := []string{}
+		for name, value := range req.Msg.Sessions {
+			if client != nil && name != client.Attribute.SessionGroupId {
+				continue
+			}
+			ses := &model.Session{
+				Details: model.SessionDetails{
+					Issuer: iss.Key,
+					Key: &oppb.CommonKey{
+						Id: value,
+					},
+				},
+			}
+			if err := dataprovider.Get(ctx, ses); err != nil {
+				continue
+			}
+			if ses.Details.SessionGroup.Key.Id != name {
+				continue
+			}
+			if subject != "" && ses.Details.Meta.Subject != subject {
+				// The session is of an End-User other than the one of id_token_hint.
+				continue
+			}
+			sessionIds = append(sessionIds, ses.Details.Key.Id)
+		}
+
+		var uiLocales []string
+		if len(vals.Get("ui_locales")) > 0 {
+			uiLocales = strings.Split(vals.Get("ui_locales"), " ")
+		}
+
+		var lr *model.LogoutRequest
+		if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+			logoutId, err := randutil.UniqueId()
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			lr = &model.LogoutRequest{
+				CreateAt: now,
+				Details: model.LogoutRequestDetails{
+					Key: &oppb.CommonKey{
+						Id: logoutId,
+					},
+					Issuer:                iss.Key,
+					Client:                client,
+					PostLogoutRedirectUri: postLogoutRedirectUri,
+					State:                 vals.Get("state"),
+					LogoutHint:            logoutHint,
+					UiLocales:             uiLocales,
+					Subject:               subject,
+					SessionIds:            sessionIds,
+				},
+				ExpireAt: now.Add(logoutRequestLifetime),
+			}
+			return dataprovider.Create(ctx, lr)
+		}); err != nil {
+			log.Printf("[BACKEND_ERROR] logoutRequest Create retry over:%v", err)
+			return nil, err
+		}
+
+		confirm := &oppb.EndSessionNextActionConfirm{
+			LogoutId:              lr.Details.Key.Id,
+			PostLogoutRedirectUri: lr.Details.PostLogoutRedirectUri,
+			LogoutHint:            lr.Details.LogoutHint,
+			UiLocales:             lr.Details.UiLocales,
+			Subject:               lr.Details.Subject,
+		}
+		if client != nil {
+			confirm.Client = client.Meta
+			confirm.ClientId = client.Identity.ClientId
+		}
+		return connect.NewResponse(&oppb.EndSessionResponse{
+			EndSessionResponseOneof: &oppb.EndSessionResponse_Confirm{
+				Confirm: confirm,
+			},
+		}), nil
+	}
+}
+
+func endSessionFail(statusCode int32, description string) *connect.Response[oppb.EndSessionResponse] {
+	return connect.NewResponse(&oppb.EndSessionResponse{
+		EndSessionResponseOneof: &oppb.EndSessionResponse_Fail{
+			Fail: &oppb.EndSessionFailResponse{
+				StatusCode: statusCode,
+				Error: &oppb.OauthError{
+					Error:            oauth.TokenErrorInvalidRequest,
+					ErrorDescription: description,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,189 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"log"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const loggedOutHtml = `
+<!DOCTYPE html>
+<html>
+  <head><title>Logged Out</title></head>
+  <body{{ if .RedirectUri }} onload="javascript:window.location.href={{ .RedirectUri }}"{{ end }}>
+    <p>You have been logged out.</p>
+    {{ range .FrontchannelLogoutUris }}
+    <iframe src="{{ . }}" style="display:none"></iframe>
+    {{ end }}
+    {{ if .RedirectUri }}
+    <p><a href="{{ .RedirectUri }}">Continue</a></p>
+    {{ end }}
+  </body>
+</html>
+`
+
+type loggedOut struct {
+	RedirectUri            string
+	FrontchannelLogoutUris []string
+}
+
+func (p *Provider) EndSessionConfirm(ctx context.Context,
+	req *connect.Request[oppb.EndSessionConfirmRequest]) (*connect.Response[oppb.EndSessionConfirmResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		// ログアウト要求を取り出す
+		lr := &model.LogoutRequest{
+			Details: model.LogoutRequestDetails{
+				Issuer: iss.Key,
+				Key: &oppb.CommonKey{
+					Id: req.Msg.LogoutId,
+				},
+			},
+		}
+		if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+			return dataprovider.Get(ctx, lr)
+		}); err != nil {
+			return nil, err
+		}
+
+		// ログアウト要求を削除する（１回限り）
+		if err := dataprovider.Delete(ctx, lr); err != nil {
+			return nil, err
+		}
+
+		// セッションを終了し、セッションに紐づくトークンを無効化する
+		sessionNames := []string{}
+		backchannelResults := []*oppb.BackchannelLogoutResult{}
+		frontchannelUris := []string{}
+		for _, sessionId := range lr.Details.SessionIds {
+			ses := &model.Session{
+				Details: model.SessionDetails{
+					Issuer: iss.Key,
+					Key: &oppb.CommonKey{
+						Id: sessionId,
+					},
+				},
+			}
+			if err := dataprovider.Get(ctx, ses); err != nil {
+				if status.Code(err) == codes.NotFound {
+					continue
+				}
+				return nil, err
+			}
+			// セッションに参加しているクライアントへログアウトを通知する
+			participants := getSessionClients(ctx, iss, ses)
+			backchannelResults = append(backchannelResults, backchannelLogout(ctx, p.subjectMapper(), iss, ses, participants)...)
+			frontchannelUris = append(frontchannelUris, frontchannelLogoutUris(iss, ses, participants)...)
+			if err := p.callbacks.DeleteTokensWithSessionId(ctx, iss.Key.Id, sessionId); err != nil {
+				log.Printf("[BACKEND_ERROR] DeleteTokensWithSessionId:%v", err)
+				return nil, err
+			}
+			if err := dataprovider.Delete(ctx, ses); err != nil {
+				return nil, err
+			}
+			sessionNames = append(sessionNames,
+				ses.Details.SessionGroup.Key.Id,
+				getBrowserStateName(ses.Details.SessionGroup.Key.Id))
+		}
+
+		out := loggedOut{
+			FrontchannelLogoutUris: frontchannelUris,
+		}
+		if lr.Details.PostLogoutRedirectUri != "" {
+			// https://openid.net/specs/openid-connect-rpinitiated-1_0.html#RedirectionAfterLogout
+			u, err := url.Parse(lr.Details.PostLogoutRedirectUri)
+			if err != nil {
+				return nil, err
+			}
+			if lr.Details.State != "" {
+				q := u.Query()
+				q.Set("state", lr.Details.State)
+				u.RawQuery = q.Encode()
+			}
+			if len(frontchannelUris) == 0 {
+				return connect.NewResponse(&oppb.EndSessionConfirmResponse{
+					EndSessionConfirmResponseOneof: &oppb.EndSessionConfirmResponse_Redirect{
+						Redirect: &oppb.AuthorizationRedirectResponse{
+							Url: u.String(),
+						},
+					},
+					SessionNames:             sessionNames,
+					BackchannelLogoutResults: backchannelResults,
+				}), nil
+			}
+			// フロントチャネルログアウトの iframe を読み込んでからリダイレクトする
+			out.RedirectUri = u.String()
+		}
+
+		t, err := template.New("LoggedOut").Parse(loggedOutHtml)
+		if err != nil {
+			return nil, err
+		}
+		buf := &bytes.Buffer{}
+		if err := t.Execute(buf, out); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&oppb.EndSessionConfirmResponse{
+			EndSessionConfirmResponseOneof: &oppb.EndSessionConfirmResponse_Html{
+				Html: &oppb.AuthorizationHtmlResponse{
+					Content: buf.String(),
+				},
+			},
+			SessionNames:             sessionNames,
+			BackchannelLogoutResults: backchannelResults,
+		}), nil
+	}
+}
+
+// getSessionClients returns the clients participating in the session.
+func getSessionClients(ctx context.Context, iss *model.Issuer, ses *model.Session) []*model.Client {
+	clients := []*model.Client{}
+	for _, clientId := range ses.Details.ClientIds {
+		client := &model.Client{
+			Identity: &oppb.ClientIdentity{
+				ClientId: clientId,
+			},
+			Issuer: iss.Key,
+		}
+		if err := dataprovider.Get(ctx, client); err != nil {
+			log.Printf("[BACKEND_ERROR] session client Get(%s):%v", clientId, err)
+			continue
+		}
+		clients = append(clients, client)
+	}
+	return clients
+}
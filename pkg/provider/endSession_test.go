@@ -0,0 +1,272 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestSession stores a session of the subject in a new session group
+// in which the clients participate.
+func newTestSession(t *testing.T, iss *model.Issuer, subject string, clients ...*model.Client) *model.Session {
+	t.Helper()
+	ctx := context.Background()
+	groupId, err := randutil.UniqueId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessionId, err := randutil.UniqueId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sg := &model.SessionGroup{
+		Key: &oppb.CommonKey{
+			Id: groupId,
+		},
+		Issuer: iss.Key,
+		Attribute: &oppb.SessionGroupAttribute{
+			AuthorizeSessionLifetimeSeconds: 3600,
+		},
+	}
+	if err := dataprovider.Create(ctx, sg); err != nil {
+		t.Fatal(err)
+	}
+	ses := model.NewSession(sg, iss, subject, sessionId, time.Now())
+	for _, client := range clients {
+		ses.Details.ClientIds = append(ses.Details.ClientIds, client.Identity.ClientId)
+	}
+	if err := dataprovider.Create(ctx, ses); err != nil {
+		t.Fatal(err)
+	}
+	return ses
+}
+
+func TestEndSession(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	sessionExists := func(ses *model.Session) bool {
+		return dataprovider.Get(ctx, &model.Session{
+			Details: model.SessionDetails{
+				Issuer: iss.Key,
+				Key:    ses.Details.Key,
+			},
+		}) == nil
+	}
+	tokenExists := func(identifier string) bool {
+		return dataprovider.Get(ctx, &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Identifier: identifier,
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Client: &model.Client{Issuer: iss.Key},
+					},
+				},
+			},
+		}) == nil
+	}
+	idTokenHint := func(t *testing.T, client *model.Client, subject string) string {
+		now := time.Now()
+		token, err := makeIdToken(ctx, iss, client, jwt.MapClaims{
+			"iss": iss.Meta.Issuer,
+			"sub": subject,
+			"aud": client.Identity.ClientId,
+			"iat": now.Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	type testCase struct {
+		name string
+		form func(t *testing.T, client *model.Client) url.Values
+		// failed は end_session_endpoint がエラーを返すこと
+		failed bool
+		// rpTerminated は RP のセッショングループのセッションが終了すること
+		rpTerminated bool
+		// otherTerminated は別のセッショングループのセッションが終了すること
+		otherTerminated bool
+		redirect        string
+	}
+
+	tests := []testCase{
+		{
+			name: "client_id terminates only the session of the client's session group",
+			form: func(t *testing.T, client *model.Client) url.Values {
+				return url.Values{
+					"client_id":                {client.Identity.ClientId},
+					"post_logout_redirect_uri": {"https://rp.example.com/logged-out"},
+					"state":                    {"state-1"},
+				}
+			},
+			rpTerminated:    true,
+			otherTerminated: false,
+			redirect:        "https://rp.example.com/logged-out?state=state-1",
+		},
+		{
+			name: "id_token_hint of the end-user terminates the session",
+			form: func(t *testing.T, client *model.Client) url.Values {
+				return url.Values{
+					"id_token_hint": {idTokenHint(t, client, "user-1")},
+				}
+			},
+			rpTerminated:    true,
+			otherTerminated: false,
+		},
+		{
+			name: "id_token_hint of another end-user keeps the session",
+			form: func(t *testing.T, client *model.Client) url.Values {
+				return url.Values{
+					"id_token_hint": {idTokenHint(t, client, "user-2")},
+				}
+			},
+			rpTerminated:    false,
+			otherTerminated: false,
+		},
+		{
+			name: "Without the client every session is terminated",
+			form: func(t *testing.T, client *model.Client) url.Values {
+				return url.Values{}
+			},
+			rpTerminated:    true,
+			otherTerminated: true,
+		},
+		{
+			name: "Unregistered post_logout_redirect_uri is rejected",
+			form: func(t *testing.T, client *model.Client) url.Values {
+				return url.Values{
+					"client_id":                {client.Identity.ClientId},
+					"post_logout_redirect_uri": {"https://attacker.example.com/"},
+				}
+			},
+			failed: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			other := newTestClient(t, iss)
+			otherSession := newTestSession(t, iss, "user-1", other)
+			rpSession := newTestSession(t, iss, "user-1")
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Attribute.SessionGroupId = rpSession.Details.SessionGroup.Key.Id
+				c.Meta.PostLogoutRedirectUris = []string{"https://rp.example.com/logged-out"}
+			})
+			rpSession.Details.ClientIds = []string{client.Identity.ClientId}
+			if err := dataprovider.Set(ctx, rpSession); err != nil {
+				t.Fatal(err)
+			}
+
+			// セッションに紐づくアクセストークン
+			authorized := newTestAuthorized(t, iss, client, "openid")
+			authorized.SessionId = rpSession.Details.Key.Id
+			access, err := makeAccessTokenIdentifier(authorized, time.Now(), "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dataprovider.Create(ctx, access); err != nil {
+				t.Fatal(err)
+			}
+
+			req := connect.NewRequest(&oppb.EndSessionRequest{
+				Method: http.MethodGet,
+				Url:    "https://op.example.com/end_session?" + tc.form(t, client).Encode(),
+				Sessions: map[string]string{
+					rpSession.Details.SessionGroup.Key.Id:    rpSession.Details.Key.Id,
+					otherSession.Details.SessionGroup.Key.Id: otherSession.Details.Key.Id,
+				},
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			res, err := testProvider.EndSession(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.failed {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, int32(http.StatusBadRequest), res.Msg.GetFail().StatusCode)
+				}
+				assert.True(t, sessionExists(rpSession))
+				return
+			}
+			if !assert.NotNil(t, res.Msg.GetConfirm()) {
+				return
+			}
+			// 確認されるまではセッションを終了しない
+			assert.True(t, sessionExists(rpSession))
+			assert.True(t, sessionExists(otherSession))
+
+			confirmReq := connect.NewRequest(&oppb.EndSessionConfirmRequest{
+				LogoutId: res.Msg.GetConfirm().LogoutId,
+			})
+			auth.SetAuth(confirmReq, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			confirmed, err := testProvider.EndSessionConfirm(ctx, confirmReq)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, !tc.rpTerminated, sessionExists(rpSession))
+			assert.Equal(t, !tc.rpTerminated, tokenExists(access.Details.Identifier))
+			assert.Equal(t, !tc.otherTerminated, sessionExists(otherSession))
+			// 終了したセッションの cookie を削除する
+			names := []string{}
+			if tc.rpTerminated {
+				names = append(names,
+					rpSession.Details.SessionGroup.Key.Id,
+					getBrowserStateName(rpSession.Details.SessionGroup.Key.Id))
+			}
+			if tc.otherTerminated {
+				names = append(names,
+					otherSession.Details.SessionGroup.Key.Id,
+					getBrowserStateName(otherSession.Details.SessionGroup.Key.Id))
+			}
+			assert.ElementsMatch(t, names, confirmed.Msg.SessionNames)
+			if tc.redirect != "" {
+				if assert.NotNil(t, confirmed.Msg.GetRedirect()) {
+					assert.Equal(t, tc.redirect, confirmed.Msg.GetRedirect().Url)
+				}
+			} else {
+				assert.NotNil(t, confirmed.Msg.GetHtml())
+			}
+
+			// ログアウト要求は１回限り
+			_, err = testProvider.EndSessionConfirm(ctx, confirmReq)
+			assert.Error(t, err)
+		})
+	}
+}
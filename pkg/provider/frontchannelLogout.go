@@ -0,0 +1,60 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"log"
+	"net/url"
+
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// frontchannelLogoutUris returns the iframe sources for every client participating in the session.
+// https://openid.net/specs/openid-connect-frontchannel-1_0.html#OPLogout
+func frontchannelLogoutUris(iss *model.Issuer, ses *model.Session, participants []*model.Client) []string {
+	if !iss.Meta.FrontchannelLogoutSupported {
+		return nil
+	}
+
+	uris := []string{}
+	for _, client := range participants {
+		if client.Meta.FrontchannelLogoutUri == "" {
+			continue
+		}
+		u, err := url.Parse(client.Meta.FrontchannelLogoutUri)
+		if err != nil {
+			log.Printf("frontchannel_logout_uri parse error(%s):%v", client.Identity.ClientId, err)
+			continue
+		}
+		// https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPLogout
+		// iss と sid はクライアントが要求した場合（または OP がサポートする場合）に付与する
+		if iss.Meta.FrontchannelLogoutSessionSupported || client.Meta.FrontchannelLogoutSessionRequired {
+			q := u.Query()
+			q.Set("iss", iss.Meta.Issuer)
+			q.Set("sid", ses.Details.Key.Id)
+			u.RawQuery = q.Encode()
+		}
+		uris = append(uris, u.String())
+	}
+	return uris
+}
@@ -0,0 +1,158 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://openid.net/specs/openid-connect-frontchannel-1_0.html#OPLogout
+func TestFrontchannelLogoutUris(t *testing.T) {
+	iss := newTestIssuer(t)
+
+	type testCase struct {
+		name     string
+		issuer   func(meta *oppb.IssuerMeta)
+		client   func(c *model.Client)
+		expected func(ses *model.Session) []string
+	}
+
+	tests := []testCase{
+		{
+			name: "frontchannel_logout_uri is rendered as is",
+			issuer: func(meta *oppb.IssuerMeta) {
+				meta.FrontchannelLogoutSupported = true
+			},
+			client: func(c *model.Client) {
+				c.Meta.FrontchannelLogoutUri = "https://rp.example.com/logout?x=1"
+			},
+			expected: func(ses *model.Session) []string {
+				return []string{"https://rp.example.com/logout?x=1"}
+			},
+		},
+		{
+			name: "iss and sid are added when the client requires them",
+			issuer: func(meta *oppb.IssuerMeta) {
+				meta.FrontchannelLogoutSupported = true
+			},
+			client: func(c *model.Client) {
+				c.Meta.FrontchannelLogoutUri = "https://rp.example.com/logout?x=1"
+				c.Meta.FrontchannelLogoutSessionRequired = true
+			},
+			expected: func(ses *model.Session) []string {
+				return []string{"https://rp.example.com/logout?" + url.Values{
+					"x":   {"1"},
+					"iss": {iss.Meta.Issuer},
+					"sid": {ses.Details.Key.Id},
+				}.Encode()}
+			},
+		},
+		{
+			name: "Client without frontchannel_logout_uri is skipped",
+			issuer: func(meta *oppb.IssuerMeta) {
+				meta.FrontchannelLogoutSupported = true
+			},
+			client: func(c *model.Client) {},
+			expected: func(ses *model.Session) []string {
+				return []string{}
+			},
+		},
+		{
+			name:   "Not rendered when the OP does not support frontchannel logout",
+			issuer: func(meta *oppb.IssuerMeta) {},
+			client: func(c *model.Client) {
+				c.Meta.FrontchannelLogoutUri = "https://rp.example.com/logout"
+			},
+			expected: func(ses *model.Session) []string {
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			caseIss := *iss
+			caseIss.Meta = &oppb.IssuerMeta{Issuer: iss.Meta.Issuer}
+			tc.issuer(caseIss.Meta)
+			client := newTestClient(t, iss, tc.client)
+			ses := newTestSession(t, iss, "user-1", client)
+			assert.Equal(t, tc.expected(ses), frontchannelLogoutUris(&caseIss, ses, []*model.Client{client}))
+		})
+	}
+}
+
+// https://openid.net/specs/openid-connect-frontchannel-1_0.html#OPLogout
+// OP はログアウト後のページに RP の frontchannel_logout_uri を iframe で描画する
+func TestEndSessionConfirmFrontchannelLogout(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.Meta.FrontchannelLogoutSupported = true
+	if err := dataprovider.Set(ctx, iss); err != nil {
+		t.Fatal(err)
+	}
+
+	client := newTestClient(t, iss, func(c *model.Client) {
+		c.Meta.FrontchannelLogoutUri = "https://rp.example.com/frontchannel-logout"
+		c.Meta.PostLogoutRedirectUris = []string{"https://rp.example.com/logged-out"}
+	})
+	ses := newTestSession(t, iss, "user-1", client)
+	lr := &model.LogoutRequest{
+		Details: model.LogoutRequestDetails{
+			Key: &oppb.CommonKey{
+				Id: "logout-" + ses.Details.Key.Id,
+			},
+			Issuer:                iss.Key,
+			Client:                client,
+			PostLogoutRedirectUri: "https://rp.example.com/logged-out",
+			SessionIds:            []string{ses.Details.Key.Id},
+		},
+	}
+	if err := dataprovider.Create(ctx, lr); err != nil {
+		t.Fatal(err)
+	}
+
+	req := connect.NewRequest(&oppb.EndSessionConfirmRequest{
+		LogoutId: lr.Details.Key.Id,
+	})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	res, err := testProvider.EndSessionConfirm(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// iframe を読み込ませるため、リダイレクトではなく HTML を返す
+	assert.Nil(t, res.Msg.GetRedirect())
+	if assert.NotNil(t, res.Msg.GetHtml()) {
+		content := res.Msg.GetHtml().Content
+		assert.Contains(t, content, `<iframe src="https://rp.example.com/frontchannel-logout"`)
+		assert.Contains(t, content, `<a href="https://rp.example.com/logged-out">`)
+	}
+}
@@ -23,6 +23,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/base64"
@@ -52,7 +53,7 @@ func makeClaimsRules(params *oppb.AuthorizationParameters) (*claims.ClaimRules,
 	return cr, nil
 }
 
-func makeIdTokenClaims(iss *model.Issuer, identifier *model.TokenIdentifier, now time.Time, code, accessToken, state string) (jwt.MapClaims, error) {
+func makeIdTokenClaims(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, identifier *model.TokenIdentifier, now time.Time, code, accessToken, state string) (jwt.MapClaims, error) {
 	cr, err := makeClaimsRules(identifier.Details.Authorized.Request.AuthParams)
 	if err != nil {
 		return nil, err
@@ -72,10 +73,17 @@ func makeIdTokenClaims(iss *model.Issuer, identifier *model.TokenIdentifier, now
 		}
 	}
 
+	// https://openid.net/specs/openid-connect-core-1_0.html#SubjectIDTypes
+	// クライアントに提示する sub（pairwise の場合はセクターごとの識別子）
+	sub, err := mapper.ClientSubject(ctx, iss, identifier.Details.Authorized.Request.Client, identifier.Details.Authorized.Subject)
+	if err != nil {
+		return nil, err
+	}
+
 	// 動的生成クレーム付与（優先度高）
 	// https://openid.net/specs/openid-connect-core-1_0.html#IDToken
 	c["iss"] = identifier.Details.Authorized.Request.Issuer                   // REQUIRED
-	c["sub"] = identifier.Details.Authorized.Subject                          // REQUIRED
+	c["sub"] = sub                                                            // REQUIRED
 	c["aud"] = identifier.Details.Authorized.Request.Client.Identity.ClientId // REQUIRED
 	c["exp"] = identifier.ExpireAt.Unix()                                     // REQUIRED
 	c["iat"] = now.Unix()                                                     // REQUIRED
@@ -145,18 +153,31 @@ func makeIdTokenIdentifier(authorized model.Authorized, now time.Time) (*model.T
 	}, nil
 }
 
-func makeAccessTokenIdentifier(authorized model.Authorized, now time.Time, tlsClientCertificate string) (*model.TokenIdentifier, error) {
+// dpopJkt is the thumbprint of the verified DPoP proof key (empty for bearer tokens).
+func makeAccessTokenIdentifier(authorized model.Authorized, now time.Time, tlsClientCertificate, dpopJkt string) (*model.TokenIdentifier, error) {
 	identifier, err := randutil.UuidV4()
 	if err != nil {
 		return nil, err
 	}
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-3
+	// 証明書バインドトークンの場合は証明書の x5t#S256 を記録する
+	thumbprint := ""
+	if authorized.Request.Client.Meta.TlsClientCertificateBoundAccessTokens && tlsClientCertificate != "" {
+		thumbprint, err = certificateThumbprint(tlsClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &model.TokenIdentifier{
 		CreateAt: now,
 		Details: model.TokenIdentifierDetails{
 			Authorized:           authorized,
 			Identifier:           identifier,
 			Type:                 model.TokenTypeAccessToken,
-			TlsClientCertificate: tlsClientCertificate,
+			TlsClientCertificate: thumbprint,
+			DpopJkt:              dpopJkt,
+			// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+			Audience: authorized.Resources,
 		},
 		ExpireAt:  now.Add(time.Duration(authorized.Request.Client.Attribute.AccessTokenLifetimeSeconds) * time.Second),
 		RequestId: authorized.Request.Key.Id,
@@ -164,24 +185,49 @@ func makeAccessTokenIdentifier(authorized model.Authorized, now time.Time, tlsCl
 	}, nil
 }
 
-func makeRefreshTokenIdentifier(authorized model.Authorized, now time.Time) (*model.TokenIdentifier, error) {
+// familyCreateAt is the first issue of the refresh token family (now for a new family).
+func makeRefreshTokenIdentifier(authorized model.Authorized, now, familyCreateAt time.Time, dpopJkt string) (*model.TokenIdentifier, error) {
 	identifier, err := randutil.UuidV4()
 	if err != nil {
 		return nil, err
 	}
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+	// パブリッククライアントのリフレッシュトークンは DPoP 鍵にバインドする
+	if authorized.Request.Client.Meta.TokenEndpointAuthMethod != oauth.TokenEndpointAuthMethodNone {
+		dpopJkt = ""
+	}
 	return &model.TokenIdentifier{
 		CreateAt: now,
 		Details: model.TokenIdentifierDetails{
-			Authorized: authorized,
-			Identifier: identifier,
-			Type:       model.TokenTypeRefreshToken,
+			Authorized:     authorized,
+			Identifier:     identifier,
+			Type:           model.TokenTypeRefreshToken,
+			FamilyCreateAt: familyCreateAt,
+			DpopJkt:        dpopJkt,
 		},
-		ExpireAt:  now.Add(time.Duration(authorized.Request.Client.Attribute.RefreshTokenLifetimeSeconds) * time.Second),
+		ExpireAt:  refreshTokenExpireAt(authorized.Request.Client.Attribute, now, now, familyCreateAt),
 		RequestId: authorized.Request.Key.Id,
 		SessionId: authorized.SessionId,
 	}, nil
 }
 
+// refreshTokenExpireAt returns the earliest of the per-token lifetime,
+// the idle timeout since the last use and the maximum lifetime of the family.
+func refreshTokenExpireAt(attr *oppb.ClientAttribute, createAt, lastUsedAt, familyCreateAt time.Time) time.Time {
+	expireAt := createAt.Add(time.Duration(attr.RefreshTokenLifetimeSeconds) * time.Second)
+	if attr.RefreshTokenIdleTimeoutSeconds > 0 {
+		if idle := lastUsedAt.Add(time.Duration(attr.RefreshTokenIdleTimeoutSeconds) * time.Second); idle.Before(expireAt) {
+			expireAt = idle
+		}
+	}
+	if attr.RefreshTokenMaxLifetimeSeconds > 0 {
+		if limit := familyCreateAt.Add(time.Duration(attr.RefreshTokenMaxLifetimeSeconds) * time.Second); limit.Before(expireAt) {
+			expireAt = limit
+		}
+	}
+	return expireAt
+}
+
 func createHash(signedAlg, target string) []byte {
 	switch signedAlg {
 	case jwt.SigningMethodRS256.Alg(), jwt.SigningMethodPS256.Alg(), jwt.SigningMethodES256.Alg():
@@ -196,3 +242,17 @@ func createHash(signedAlg, target string) []byte {
 	}
 	return nil
 }
+
+// makeIdToken signs the ID Token and encrypts it when the client registered id_token_encrypted_response_alg.
+// https://openid.net/specs/openid-connect-core-1_0.html#IDToken
+// ID Tokens MUST be signed using JWS and optionally both signed and then encrypted using JWS and JWE respectively
+func makeIdToken(ctx context.Context, iss *model.Issuer, client *model.Client, claims jwt.MapClaims) (string, error) {
+	signed, err := makeJwt(ctx, iss, claims, client.Meta.IdTokenSignedResponseAlg)
+	if err != nil {
+		return "", err
+	}
+	if client.Meta.IdTokenEncryptedResponseAlg == "" {
+		return signed, nil
+	}
+	return encryptJwt(ctx, client, signed, client.Meta.IdTokenEncryptedResponseAlg, client.Meta.IdTokenEncryptedResponseEnc)
+}
@@ -0,0 +1,249 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// https://www.rfc-editor.org/rfc/rfc7662.html
+func (p *Provider) Introspection(ctx context.Context,
+	req *connect.Request[oppb.IntrospectionRequest]) (*connect.Response[oppb.IntrospectionResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		// The protected resource calls the introspection endpoint using an HTTP
+		// POST request with parameters sent as "application/x-www-form-urlencoded" data.
+		if req.Msg.Method != http.MethodPost {
+			return introspectionJson(http.StatusMethodNotAllowed, &oppb.OauthError{
+				Error:            oauth.TokenErrorInvalidRequest,
+				ErrorDescription: "Method not allowed:" + req.Msg.Method,
+			})
+		}
+		if ct := req.Msg.ContentType; !strings.HasPrefix(ct, httphelper.MimeTypeWwwFormUnlencoded) {
+			return introspectionJson(http.StatusBadRequest, &oppb.OauthError{
+				Error:            oauth.TokenErrorInvalidRequest,
+				ErrorDescription: "Unsupported content type:" + ct,
+			})
+		}
+
+		vals := query.Parse(req.Msg.Form)
+		token := vals.Get("token")
+		if token == "" {
+			return introspectionJson(http.StatusBadRequest, &oppb.OauthError{
+				Error:            oauth.TokenErrorInvalidRequest,
+				ErrorDescription: "missing token parameter",
+			})
+		}
+
+		// Endpoint authentication check
+		client, terr, err := identifyClient(ctx, iss, req.Msg.BasicAuth, vals)
+		if err != nil {
+			return nil, err
+		}
+		if terr == nil {
+			terr = p.checkClientAuthentication(ctx, &clientAuthentication{
+				AllowAudience: []string{
+					iss.Meta.IntrospectionEndpoint,
+					iss.Meta.TokenEndpoint,
+					iss.Meta.Issuer,
+				},
+				BasicAuth:                         req.Msg.BasicAuth,
+				Client:                            client,
+				Issuer:                            iss,
+				Values:                            vals,
+				TlsClientCertificate:              req.Msg.TlsClientCertificate,
+				TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
+			})
+		}
+		if terr != nil {
+			return introspectionJson(terr.StatusCode, terr.Error)
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+		// DPoP を使用する保護リソースは introspection の呼び出しにも proof を付与する
+		if _, derr := checkDpopRequest(ctx, iss, client, req.Msg.Dpop, req.Msg.Method, iss.Meta.IntrospectionEndpoint); derr != nil {
+			res, err := introspectionJson(http.StatusBadRequest, &oppb.OauthError{
+				Error:            derr.Error,
+				ErrorDescription: derr.Description,
+			})
+			if err == nil && derr.Nonce != "" {
+				res.Msg.Headers[httphelper.HeaderDpopNonce] = derr.Nonce
+			}
+			return res, err
+		}
+
+		identifier := &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Identifier: accessTokenIdentifier(ctx, iss, token),
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Client: &model.Client{
+							Issuer: iss.Key,
+						},
+					},
+				},
+			},
+		}
+		var introspection jwt.MapClaims
+		now := time.Now()
+		if err := dataprovider.Get(ctx, identifier); err != nil {
+			// If the introspection call is properly authorized but the token is not
+			// active, does not exist on this server, or the protected resource is not
+			// allowed to introspect this particular token, then the authorization
+			// server MUST return an introspection response with the "active" field
+			// set to "false".
+			introspection = jwt.MapClaims{"active": false}
+		} else {
+			introspection, err = makeIntrospectionClaims(ctx, p.subjectMapper(), iss, identifier, now)
+			if err != nil {
+				log.Printf("[BACKEND_ERROR] makeIntrospectionClaims error:%v", err)
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9701.html#section-4
+		if strings.Contains(req.Msg.Accept, httphelper.MimeTypeTokenIntrospectionJwt) {
+			alg := client.Meta.IntrospectionSignedResponseAlg
+			if alg == "" {
+				// The default, if omitted, is RS256.
+				alg = jwt.SigningMethodRS256.Alg()
+			}
+			claims := jwt.MapClaims{
+				"iss":                 iss.Meta.Issuer,
+				"aud":                 client.Identity.ClientId,
+				"iat":                 now.Unix(),
+				"token_introspection": introspection,
+			}
+			signed, err := makeTypedJwt(ctx, iss, claims, alg, "token-introspection+jwt")
+			if err != nil {
+				return nil, err
+			}
+			headers := httphelper.DefaultJwtHeader()
+			headers[httphelper.HeaderContentType] = httphelper.MimeTypeTokenIntrospectionJwt
+			return connect.NewResponse(&oppb.IntrospectionResponse{
+				Headers:    headers,
+				StatusCode: http.StatusOK,
+				Body:       signed,
+			}), nil
+		}
+		return introspectionJson(http.StatusOK, introspection)
+	}
+}
+
+// https://www.rfc-editor.org/rfc/rfc7662.html#section-2.2
+func makeIntrospectionClaims(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, identifier *model.TokenIdentifier, now time.Time) (jwt.MapClaims, error) {
+	// ID tokens are not presented to protected resources.
+	// Rotated refresh tokens are no longer active.
+	if identifier.Details.Type == model.TokenTypeIdToken || identifier.Details.IsUsed || now.After(identifier.ExpireAt) {
+		return jwt.MapClaims{"active": false}, nil
+	}
+	c := jwt.MapClaims{
+		"active":    true,
+		"client_id": identifier.Details.Authorized.Request.Client.Identity.ClientId,
+		"exp":       identifier.ExpireAt.Unix(),
+		"iat":       identifier.CreateAt.Unix(),
+		"iss":       identifier.Details.Authorized.Request.Issuer,
+	}
+	if params := identifier.Details.Authorized.Request.AuthParams; params != nil && len(params.Scopes) > 0 {
+		c["scope"] = strings.Join(params.Scopes, " ")
+	}
+	if identifier.Details.Authorized.Subject != "" {
+		// https://openid.net/specs/openid-connect-core-1_0.html#SubjectIDTypes
+		// トークンの発行先クライアントに提示した sub（pairwise の場合はセクターごとの識別子）
+		sub, err := mapper.ClientSubject(ctx, iss, identifier.Details.Authorized.Request.Client, identifier.Details.Authorized.Subject)
+		if err != nil {
+			return nil, err
+		}
+		c["sub"] = sub
+	}
+	if identifier.Details.Type == model.TokenTypeAccessToken {
+		c["token_type"] = accessTokenType(identifier)
+	}
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-9.2
+	if details, ok := authorizationDetailsClaim(identifier.Details.Authorized.AuthorizationDetails); ok {
+		c["authorization_details"] = details
+	}
+	// https://www.rfc-editor.org/rfc/rfc8693.html#section-4
+	if len(identifier.Details.Audience) > 0 {
+		c["aud"] = identifier.Details.Audience
+	}
+	if identifier.Details.Actor != nil {
+		c["act"] = makeActClaim(identifier.Details.Actor)
+	}
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-3.2
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-6.2
+	if identifier.Details.TlsClientCertificate != "" || identifier.Details.DpopJkt != "" {
+		cnf := map[string]any{}
+		if identifier.Details.TlsClientCertificate != "" {
+			cnf["x5t#S256"] = identifier.Details.TlsClientCertificate
+		}
+		if identifier.Details.DpopJkt != "" {
+			cnf["jkt"] = identifier.Details.DpopJkt
+		}
+		c["cnf"] = cnf
+	}
+	return c, nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc8693.html#section-4.1
+func makeActClaim(actor *model.TokenActor) map[string]any {
+	act := map[string]any{}
+	if actor.Subject != "" {
+		act["sub"] = actor.Subject
+	}
+	if actor.ClientId != "" {
+		act["client_id"] = actor.ClientId
+	}
+	if actor.Actor != nil {
+		act["act"] = makeActClaim(actor.Actor)
+	}
+	return act
+}
+
+func introspectionJson(statusCode int32, body any) (*connect.Response[oppb.IntrospectionResponse], error) {
+	b, err := json.MarshalIndent(body, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&oppb.IntrospectionResponse{
+		Headers:    httphelper.DefaultJsonHeader(),
+		StatusCode: statusCode,
+		Body:       string(b),
+	}), nil
+}
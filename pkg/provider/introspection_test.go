@@ -0,0 +1,240 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntrospection(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	// 保護リソースは subject_type が public のクライアントとして登録されている
+	resourceServer := newTestClient(t, iss)
+
+	type testCase struct {
+		name   string
+		token  func(t *testing.T, client *model.Client) string
+		active bool
+	}
+
+	store := func(t *testing.T, identifier *model.TokenIdentifier) string {
+		if err := dataprovider.Create(ctx, identifier); err != nil {
+			t.Fatal(err)
+		}
+		return identifier.Details.Identifier
+	}
+
+	tests := []testCase{
+		{
+			name: "Access token is active",
+			token: func(t *testing.T, client *model.Client) string {
+				access, err := makeAccessTokenIdentifier(newTestAuthorized(t, iss, client, "openid", "profile"), time.Now(), "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				return store(t, access)
+			},
+			active: true,
+		},
+		{
+			name: "Expired access token is inactive",
+			token: func(t *testing.T, client *model.Client) string {
+				access, err := makeAccessTokenIdentifier(newTestAuthorized(t, iss, client, "openid", "profile"), time.Now(), "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				access.ExpireAt = time.Now().Add(-time.Minute)
+				return store(t, access)
+			},
+			active: false,
+		},
+		{
+			name: "Rotated refresh token is inactive",
+			token: func(t *testing.T, client *model.Client) string {
+				now := time.Now()
+				refresh, err := makeRefreshTokenIdentifier(newTestAuthorized(t, iss, client, "openid", "profile"), now, now, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				refresh.ExpireAt = now.Add(time.Hour)
+				refresh.Details.IsUsed = true
+				return store(t, refresh)
+			},
+			active: false,
+		},
+		{
+			name: "Unknown token is inactive",
+			token: func(t *testing.T, client *model.Client) string {
+				return "unknown-token"
+			},
+			active: false,
+		},
+	}
+
+	introspect := func(t *testing.T, token, accept string) *oppb.IntrospectionResponse {
+		req := connect.NewRequest(&oppb.IntrospectionRequest{
+			ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+			Method:      http.MethodPost,
+			Accept:      accept,
+			Form: url.Values{
+				"token":         {token},
+				"client_id":     {resourceServer.Identity.ClientId},
+				"client_secret": {resourceServer.Identity.ClientSecret},
+			}.Encode(),
+		})
+		auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+		res, err := testProvider.Introspection(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss)
+			res := introspect(t, tc.token(t, client), "")
+			assert.Equal(t, int32(http.StatusOK), res.StatusCode)
+			body := map[string]any{}
+			if err := json.Unmarshal([]byte(res.Body), &body); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.active, body["active"])
+			if tc.active {
+				assert.Equal(t, client.Identity.ClientId, body["client_id"])
+				assert.Equal(t, "openid profile", body["scope"])
+				assert.Equal(t, "user-1", body["sub"])
+				assert.Equal(t, iss.Meta.Issuer, body["iss"])
+			} else {
+				// 非アクティブなトークンの情報は返さない
+				assert.Len(t, body, 1)
+			}
+		})
+	}
+}
+
+// https://www.rfc-editor.org/rfc/rfc9701.html#section-5
+func TestIntrospectionJwtResponse(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	type testCase struct {
+		name        string
+		responseAlg string
+		expectedAlg string
+		active      bool
+	}
+
+	tests := []testCase{
+		{
+			name:        "RS256 is used when introspection_signed_response_alg is omitted",
+			responseAlg: "",
+			expectedAlg: "RS256",
+			active:      true,
+		},
+		{
+			name:        "introspection_signed_response_alg is used",
+			responseAlg: "ES256",
+			expectedAlg: "ES256",
+			active:      true,
+		},
+		{
+			name:        "Inactive token is also signed",
+			responseAlg: "",
+			expectedAlg: "RS256",
+			active:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceServer := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.IntrospectionSignedResponseAlg = tc.responseAlg
+			})
+			client := newTestClient(t, iss)
+			token := "unknown-token"
+			if tc.active {
+				access, err := makeAccessTokenIdentifier(newTestAuthorized(t, iss, client, "openid"), time.Now(), "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := dataprovider.Create(ctx, access); err != nil {
+					t.Fatal(err)
+				}
+				token = access.Details.Identifier
+			}
+
+			req := connect.NewRequest(&oppb.IntrospectionRequest{
+				ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+				Method:      http.MethodPost,
+				Accept:      httphelper.MimeTypeTokenIntrospectionJwt,
+				Form: url.Values{
+					"token":         {token},
+					"client_id":     {resourceServer.Identity.ClientId},
+					"client_secret": {resourceServer.Identity.ClientSecret},
+				}.Encode(),
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			res, err := testProvider.Introspection(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, int32(http.StatusOK), res.Msg.StatusCode)
+			assert.Equal(t, httphelper.MimeTypeTokenIntrospectionJwt, res.Msg.Headers[httphelper.HeaderContentType])
+
+			claims := jwt.MapClaims{}
+			parsed, err := jwt.NewParser(jwt.WithValidMethods([]string{tc.expectedAlg})).
+				ParseWithClaims(res.Msg.Body, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "token-introspection+jwt", parsed.Header["typ"])
+			assert.Equal(t, iss.Meta.Issuer, claims["iss"])
+			assert.Equal(t, resourceServer.Identity.ClientId, claims["aud"])
+			assert.NotNil(t, claims["iat"])
+			introspection, ok := claims["token_introspection"].(map[string]any)
+			if assert.True(t, ok) {
+				assert.Equal(t, tc.active, introspection["active"])
+				if tc.active {
+					assert.Equal(t, client.Identity.ClientId, introspection["client_id"])
+				}
+			}
+		})
+	}
+}
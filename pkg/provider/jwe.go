@@ -0,0 +1,282 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Eigen438/opgo/internal/convert"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/go-jose/go-jose/v4"
+)
+
+// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+// If id_token_encrypted_response_alg is specified, the default id_token_encrypted_response_enc value is A128CBC-HS256.
+const defaultEncryptionEnc = string(jose.A128CBC_HS256)
+
+// 対称鍵暗号のアルゴリズムと鍵長(bytes)
+var symmetricKeySize = map[string]int{
+	string(jose.A128KW):    16,
+	string(jose.A192KW):    24,
+	string(jose.A256KW):    32,
+	string(jose.A128GCMKW): 16,
+	string(jose.A192GCMKW): 24,
+	string(jose.A256GCMKW): 32,
+}
+
+// コンテンツ暗号のアルゴリズムと鍵長(bytes)
+var contentKeySize = map[string]int{
+	string(jose.A128GCM):       16,
+	string(jose.A192GCM):       24,
+	string(jose.A256GCM):       32,
+	string(jose.A128CBC_HS256): 32,
+	string(jose.A192CBC_HS384): 48,
+	string(jose.A256CBC_HS512): 64,
+}
+
+// checkEncryptionMeta checks the *_encrypted_response_alg/enc pair of the client metadata against the supported values.
+// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+// When *_encrypted_response_enc is included, *_encrypted_response_alg MUST also be provided.
+func checkEncryptionMeta(name, alg, enc string, algs, encs []string) error {
+	if alg == "" {
+		if enc != "" {
+			return fmt.Errorf("%s_enc requires %s_alg", name, name)
+		}
+		return nil
+	}
+	if !slices.Contains(algs, alg) {
+		return fmt.Errorf("%s_alg not supported:%s", name, alg)
+	}
+	if enc != "" && !slices.Contains(encs, enc) {
+		return fmt.Errorf("%s_enc not supported:%s", name, enc)
+	}
+	return nil
+}
+
+// encryptJwt encrypts the signed JWT to the client as a Nested JWT.
+// https://openid.net/specs/openid-connect-core-1_0.html#SigningOrder
+// If both signing and encryption are performed, it MUST be signed then encrypted,
+// with the result being a Nested JWT, as defined in [JWT].
+func encryptJwt(ctx context.Context, client *model.Client, signed, alg, enc string) (string, error) {
+	return encryptJwe(ctx, client, []byte(signed), alg, enc, "JWT")
+}
+
+// encryptClaims encrypts the JSON claims to the client without signing.
+func encryptClaims(ctx context.Context, client *model.Client, claims any, alg, enc string) (string, error) {
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return encryptJwe(ctx, client, b, alg, enc, "")
+}
+
+// encryptJwe encrypts the payload with the key management algorithm alg and the content encryption algorithm enc.
+// The cty header is set when cty is not empty.
+func encryptJwe(ctx context.Context, client *model.Client, payload []byte, alg, enc, cty string) (string, error) {
+	if enc == "" {
+		enc = defaultEncryptionEnc
+	}
+	if _, ok := contentKeySize[enc]; !ok {
+		return "", fmt.Errorf("unsupported enc:%s", enc)
+	}
+	rcpt := jose.Recipient{
+		Algorithm: jose.KeyAlgorithm(alg),
+	}
+	if size, ok := symmetricKeySize[alg]; ok {
+		rcpt.Key = secretKey(client.Identity.ClientSecret, size)
+	} else if alg == string(jose.DIRECT) {
+		rcpt.Key = secretKey(client.Identity.ClientSecret, contentKeySize[enc])
+	} else {
+		key, kid, err := clientEncryptionKey(ctx, client, alg)
+		if err != nil {
+			return "", err
+		}
+		rcpt.Key = key
+		rcpt.KeyID = kid
+	}
+	if rcpt.Key == nil {
+		return "", fmt.Errorf("no encryption key for alg:%s", alg)
+	}
+
+	opts := &jose.EncrypterOptions{}
+	if cty != "" {
+		opts = opts.WithContentType(jose.ContentType(cty))
+	}
+	encrypter, err := jose.NewEncrypter(jose.ContentEncryption(enc), rcpt, opts)
+	if err != nil {
+		return "", err
+	}
+	obj, err := encrypter.Encrypt(payload)
+	if err != nil {
+		return "", err
+	}
+	return obj.CompactSerialize()
+}
+
+// secretKey derives the symmetric key from the client_secret.
+// https://openid.net/specs/openid-connect-core-1_0.html#Encryption
+// the symmetric encryption key is derived from the client_secret value by using a left truncated SHA-2 hash of the octets
+// of the UTF-8 representation of the client_secret. For keys of 256 or fewer bits, SHA-256 is used;
+// for keys of 257-384 bits, SHA-384 is used; for keys of 385-512 bits, SHA-512 is used.
+func secretKey(secret string, size int) []byte {
+	if secret == "" || size == 0 {
+		return nil
+	}
+	switch {
+	case size <= 32:
+		sum := sha256.Sum256([]byte(secret))
+		return sum[:size]
+	case size <= 48:
+		sum := sha512.Sum384([]byte(secret))
+		return sum[:size]
+	default:
+		sum := sha512.Sum512([]byte(secret))
+		return sum[:size]
+	}
+}
+
+// clientEncryptionKey selects the client's public key for the key management algorithm from jwks or jwks_uri.
+func clientEncryptionKey(ctx context.Context, client *model.Client, alg string) (any, string, error) {
+	var kty jwkset.KTY
+	switch jose.KeyAlgorithm(alg) {
+	case jose.RSA_OAEP, jose.RSA_OAEP_256, jose.RSA1_5:
+		kty = jwkset.KtyRSA
+	case jose.ECDH_ES, jose.ECDH_ES_A128KW, jose.ECDH_ES_A192KW, jose.ECDH_ES_A256KW:
+		kty = jwkset.KtyEC
+	default:
+		return nil, "", fmt.Errorf("unsupported alg:%s", alg)
+	}
+
+	var storage jwkset.Storage
+	if len(client.Meta.JwksUri) > 0 {
+		s, err := jwkset.NewStorageFromHTTP(client.Meta.JwksUri, jwkset.HTTPClientStorageOptions{})
+		if err != nil {
+			return nil, "", err
+		}
+		storage = s
+	} else if client.Meta.Jwks != nil {
+		var jwks jwkset.JWKSMarshal
+		jwks.Keys = convert.JWKMarchalsFromKeys(client.Meta.Jwks.Keys)
+		s, err := jwks.ToStorage()
+		if err != nil {
+			return nil, "", err
+		}
+		storage = s
+	} else {
+		return nil, "", fmt.Errorf("no jwks/jwks_uri found for client %s", client.Identity.ClientId)
+	}
+
+	keys, err := storage.KeyReadAll(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, jwk := range keys {
+		m := jwk.Marshal()
+		if m.KTY != kty {
+			continue
+		}
+		if m.USE != "" && m.USE != jwkset.UseEnc {
+			continue
+		}
+		if m.ALG != "" && string(m.ALG) != alg {
+			continue
+		}
+		return jwk.Key(), m.KID, nil
+	}
+	return nil, "", fmt.Errorf("no encryption key found for alg:%s", alg)
+}
+
+// isJwe reports whether the compact serialization is a JWE (five parts) rather than a JWS (three parts).
+// https://www.rfc-editor.org/rfc/rfc7516.html#section-9
+func isJwe(s string) bool {
+	return strings.Count(s, ".") == 4
+}
+
+// decryptRequestObject decrypts the encrypted request object and returns the nested JWT.
+// https://openid.net/specs/openid-connect-core-1_0.html#EncryptedRequestObject
+// https://www.rfc-editor.org/rfc/rfc9101.html#section-6.1
+func decryptRequestObject(ctx context.Context, iss *model.Issuer, client *model.Client, jwe string) (string, error) {
+	algs := []jose.KeyAlgorithm{}
+	for _, v := range iss.Meta.RequestObjectEncryptionAlgValuesSupported {
+		algs = append(algs, jose.KeyAlgorithm(v))
+	}
+	encs := []jose.ContentEncryption{}
+	for _, v := range iss.Meta.RequestObjectEncryptionEncValuesSupported {
+		encs = append(encs, jose.ContentEncryption(v))
+	}
+	obj, err := jose.ParseEncryptedCompact(jwe, algs, encs)
+	if err != nil {
+		return "", err
+	}
+
+	alg := obj.Header.Algorithm
+	enc := fmt.Sprintf("%v", obj.Header.ExtraHeaders[jose.HeaderKey("enc")])
+	// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+	// request_object_encryption_alg / request_object_encryption_enc
+	if v := client.Meta.RequestObjectEncryptionAlg; v != "" && v != alg {
+		return "", fmt.Errorf("alg not match:%s", alg)
+	}
+	if v := client.Meta.RequestObjectEncryptionEnc; v != "" && v != enc {
+		return "", fmt.Errorf("enc not match:%s", enc)
+	}
+
+	var key any
+	if size, ok := symmetricKeySize[alg]; ok {
+		key = secretKey(client.Identity.ClientSecret, size)
+	} else if alg == string(jose.DIRECT) {
+		key = secretKey(client.Identity.ClientSecret, contentKeySize[enc])
+	} else if keyType, ok := keyutil.EncryptionKeyType(alg); !ok {
+		return "", fmt.Errorf("unsupported alg:%s", alg)
+	} else if kr, ok := iss.Resources.KeyMap[keyType]; !ok {
+		return "", fmt.Errorf("key not found")
+	} else {
+		// kidが無い場合は現在の鍵で復号する
+		keyId := obj.Header.KeyID
+		if keyId == "" {
+			keyId = kr.CurrentKeyId
+		} else if keyId != kr.CurrentKeyId && !slices.Contains(kr.ReservedKeyIds, keyId) {
+			return "", fmt.Errorf("unknown kid:%s", keyId)
+		}
+		key, err = keyutil.GetPrivateKey(ctx, iss, keyType, keyId)
+		if err != nil {
+			return "", err
+		}
+	}
+	if key == nil {
+		return "", fmt.Errorf("no decryption key for alg:%s", alg)
+	}
+
+	b, err := obj.Decrypt(key)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,540 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/convert"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEncryptionKeys are the private keys of the client for decrypting the responses.
+type testEncryptionKeys struct {
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+// newTestEncryptionKeys generates the client keys and returns the jwks publishing the public keys with use=enc.
+func newTestEncryptionKeys(t *testing.T) (*testEncryptionKeys, *oppb.Jwks) {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := []jwkset.JWKMarshal{}
+	for kid, pub := range map[string]any{"rsa-enc": rsaKey.Public(), "ec-enc": ecKey.Public()} {
+		jwk, err := jwkset.NewJWKFromKey(pub, jwkset.JWKOptions{
+			Metadata: jwkset.JWKMetadataOptions{
+				KID: kid,
+				USE: jwkset.UseEnc,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, jwk.Marshal())
+	}
+	return &testEncryptionKeys{rsa: rsaKey, ec: ecKey}, &oppb.Jwks{
+		Keys: convert.JwksFromJWKMarshals(keys),
+	}
+}
+
+// decryptionKey returns the key of the client to decrypt the JWE encrypted with alg.
+func (k *testEncryptionKeys) decryptionKey(client *model.Client, alg, enc string) any {
+	switch jose.KeyAlgorithm(alg) {
+	case jose.RSA_OAEP, jose.RSA_OAEP_256, jose.RSA1_5:
+		return k.rsa
+	case jose.ECDH_ES, jose.ECDH_ES_A128KW, jose.ECDH_ES_A192KW, jose.ECDH_ES_A256KW:
+		return k.ec
+	case jose.DIRECT:
+		return secretKey(client.Identity.ClientSecret, contentKeySize[enc])
+	default:
+		return secretKey(client.Identity.ClientSecret, symmetricKeySize[alg])
+	}
+}
+
+// decryptTestJwe decrypts the compact JWE and returns the plaintext and the protected header.
+func decryptTestJwe(t *testing.T, compact string, key any) (string, jose.Header) {
+	t.Helper()
+	obj, err := jose.ParseEncryptedCompact(compact,
+		[]jose.KeyAlgorithm{
+			jose.RSA_OAEP, jose.RSA_OAEP_256, jose.RSA1_5,
+			jose.ECDH_ES, jose.ECDH_ES_A128KW, jose.ECDH_ES_A192KW, jose.ECDH_ES_A256KW,
+			jose.A128KW, jose.A192KW, jose.A256KW, jose.A128GCMKW, jose.A256GCMKW, jose.DIRECT,
+		},
+		[]jose.ContentEncryption{
+			jose.A128GCM, jose.A192GCM, jose.A256GCM,
+			jose.A128CBC_HS256, jose.A192CBC_HS384, jose.A256CBC_HS512,
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := obj.Decrypt(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b), obj.Header
+}
+
+func TestEncryptJwt(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	keys, jwks := newTestEncryptionKeys(t)
+	client := newTestClient(t, iss, func(c *model.Client) {
+		c.Meta.Jwks = jwks
+	})
+	signed, err := makeJwt(ctx, iss, jwt.MapClaims{"sub": "user-1"}, jwt.SigningMethodRS256.Alg())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		name    string
+		alg     string
+		enc     string
+		wantEnc string
+		wantKid string
+	}
+
+	tests := []testCase{
+		{name: "RSA-OAEP", alg: "RSA-OAEP", enc: "A128GCM", wantEnc: "A128GCM", wantKid: "rsa-enc"},
+		{name: "RSA-OAEP-256 with the default enc", alg: "RSA-OAEP-256", wantEnc: "A128CBC-HS256", wantKid: "rsa-enc"},
+		{name: "ECDH-ES", alg: "ECDH-ES", enc: "A256GCM", wantEnc: "A256GCM", wantKid: "ec-enc"},
+		{name: "ECDH-ES+A128KW", alg: "ECDH-ES+A128KW", enc: "A128CBC-HS256", wantEnc: "A128CBC-HS256", wantKid: "ec-enc"},
+		{name: "A128KW with client_secret", alg: "A128KW", enc: "A128GCM", wantEnc: "A128GCM"},
+		{name: "dir with client_secret", alg: "dir", enc: "A256CBC-HS512", wantEnc: "A256CBC-HS512"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			encrypted, err := encryptJwt(ctx, client, signed, tc.alg, tc.enc)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.True(t, isJwe(encrypted))
+			plain, header := decryptTestJwe(t, encrypted, keys.decryptionKey(client, tc.alg, tc.wantEnc))
+			assert.Equal(t, signed, plain)
+			assert.Equal(t, tc.alg, header.Algorithm)
+			assert.Equal(t, tc.wantEnc, header.ExtraHeaders[jose.HeaderKey("enc")])
+			assert.Equal(t, "JWT", header.ExtraHeaders[jose.HeaderContentType])
+			assert.Equal(t, tc.wantKid, header.KeyID)
+		})
+	}
+}
+
+func TestEncryptJwtError(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	_, jwks := newTestEncryptionKeys(t)
+
+	sigOnly, err := jwkset.NewJWKFromKey(func() any {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key.Public()
+	}(), jwkset.JWKOptions{
+		Metadata: jwkset.JWKMetadataOptions{USE: jwkset.UseSig},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		name   string
+		client func(c *model.Client)
+		alg    string
+		enc    string
+	}
+
+	tests := []testCase{
+		{
+			name:   "No jwks registered",
+			client: func(c *model.Client) {},
+			alg:    "RSA-OAEP",
+		},
+		{
+			name: "Only signing keys registered",
+			client: func(c *model.Client) {
+				c.Meta.Jwks = &oppb.Jwks{Keys: convert.JwksFromJWKMarshals([]jwkset.JWKMarshal{sigOnly.Marshal()})}
+			},
+			alg: "RSA-OAEP",
+		},
+		{
+			name: "Unsupported enc",
+			client: func(c *model.Client) {
+				c.Meta.Jwks = jwks
+			},
+			alg: "RSA-OAEP",
+			enc: "A512GCM",
+		},
+		{
+			name: "Unsupported alg",
+			client: func(c *model.Client) {
+				c.Meta.Jwks = jwks
+			},
+			alg: "PBES2-HS256+A128KW",
+		},
+		{
+			name: "Symmetric alg without client_secret",
+			client: func(c *model.Client) {
+				c.Identity.ClientSecret = ""
+			},
+			alg: "A128KW",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, tc.client)
+			_, err := encryptJwt(ctx, client, "header.payload.signature", tc.alg, tc.enc)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCheckEncryptionMeta(t *testing.T) {
+	algs := []string{"RSA-OAEP", "ECDH-ES"}
+	encs := []string{"A128GCM", "A128CBC-HS256"}
+
+	type testCase struct {
+		name      string
+		alg       string
+		enc       string
+		wantError bool
+	}
+
+	tests := []testCase{
+		{name: "Not encrypted"},
+		{name: "Supported alg with the default enc", alg: "RSA-OAEP"},
+		{name: "Supported alg and enc", alg: "ECDH-ES", enc: "A128GCM"},
+		{name: "enc without alg", enc: "A128GCM", wantError: true},
+		{name: "Unsupported alg", alg: "RSA1_5", wantError: true},
+		{name: "Unsupported enc", alg: "RSA-OAEP", enc: "A256GCM", wantError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkEncryptionMeta("id_token_encrypted_response", tc.alg, tc.enc, algs, encs)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretKey(t *testing.T) {
+	type testCase struct {
+		name   string
+		secret string
+		size   int
+		want   int
+	}
+
+	tests := []testCase{
+		{name: "128 bits", secret: "secret", size: 16, want: 16},
+		{name: "256 bits", secret: "secret", size: 32, want: 32},
+		{name: "384 bits", secret: "secret", size: 48, want: 48},
+		{name: "512 bits", secret: "secret", size: 64, want: 64},
+		{name: "No client_secret", secret: "", size: 16, want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, secretKey(tc.secret, tc.size), tc.want)
+		})
+	}
+	// 同じ client_secret からは同じ鍵が導出され、鍵長が異なる場合は左側が共通になる
+	assert.Equal(t, secretKey("secret", 16), secretKey("secret", 16))
+	assert.Equal(t, secretKey("secret", 16), secretKey("secret", 32)[:16])
+	assert.NotEqual(t, secretKey("secret", 16), secretKey("other", 16))
+}
+
+func TestEncryptedIdToken(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	keys, jwks := newTestEncryptionKeys(t)
+
+	type testCase struct {
+		name string
+		alg  string
+		enc  string
+	}
+
+	tests := []testCase{
+		{name: "Signed ID token", alg: "", enc: ""},
+		{name: "Signed and encrypted with RSA-OAEP", alg: "RSA-OAEP", enc: "A128GCM"},
+		{name: "Signed and encrypted with ECDH-ES", alg: "ECDH-ES", enc: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.Jwks = jwks
+				c.Meta.IdTokenEncryptedResponseAlg = tc.alg
+				c.Meta.IdTokenEncryptedResponseEnc = tc.enc
+			})
+			authorized := newTestAuthorized(t, iss, client, "openid", "offline_access")
+			refresh, err := makeRefreshTokenIdentifier(authorized, time.Now(), time.Now(), "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dataprovider.Create(ctx, refresh); err != nil {
+				t.Fatal(err)
+			}
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {refresh.Details.Identifier},
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+
+			idToken := success.IdToken
+			assert.Equal(t, tc.alg != "", isJwe(idToken))
+			if tc.alg != "" {
+				enc := tc.enc
+				if enc == "" {
+					enc = defaultEncryptionEnc
+				}
+				var header jose.Header
+				idToken, header = decryptTestJwe(t, idToken, keys.decryptionKey(client, tc.alg, enc))
+				assert.Equal(t, enc, header.ExtraHeaders[jose.HeaderKey("enc")])
+			}
+			// 入れ子の JWT は OP の鍵で署名されている
+			claims := jwt.MapClaims{}
+			_, err = jwt.NewParser(jwt.WithValidMethods([]string{client.Meta.IdTokenSignedResponseAlg})).
+				ParseWithClaims(idToken, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+			if assert.NoError(t, err) {
+				assert.Equal(t, client.Identity.ClientId, claims["aud"])
+				assert.Equal(t, "user-1", claims["sub"])
+			}
+		})
+	}
+}
+
+// encryptTestRequestObject encrypts the request object to the OP as the client does.
+func encryptTestRequestObject(t *testing.T, payload, alg, enc string, key any, kid string) string {
+	t.Helper()
+	opts := (&jose.EncrypterOptions{}).WithContentType("JWT")
+	encrypter, err := jose.NewEncrypter(jose.ContentEncryption(enc), jose.Recipient{
+		Algorithm: jose.KeyAlgorithm(alg),
+		Key:       key,
+		KeyID:     kid,
+	}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := encrypter.Encrypt([]byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	compact, err := obj.CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return compact
+}
+
+// issuerEncryptionKey returns the current RSA encryption key of the OP published in the JWKS.
+func issuerEncryptionKey(t *testing.T, iss *model.Issuer) (*rsa.PrivateKey, string) {
+	t.Helper()
+	kid := iss.Resources.KeyMap[keyutil.RSAENC].CurrentKeyId
+	key, err := keyutil.GetPrivateKey(context.Background(), iss, keyutil.RSAENC, kid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key.(*rsa.PrivateKey), kid
+}
+
+func TestDecryptRequestObject(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	iss.Meta.RequestObjectEncryptionAlgValuesSupported = append(iss.Meta.RequestObjectEncryptionAlgValuesSupported, "A128KW")
+	opKey, kid := issuerEncryptionKey(t, iss)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const nested = "header.payload.signature"
+
+	type testCase struct {
+		name      string
+		client    func(c *model.Client)
+		jwe       func(client *model.Client) string
+		wantError bool
+	}
+
+	tests := []testCase{
+		{
+			name:   "RSA-OAEP with the kid of the OP key",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A128GCM", &opKey.PublicKey, kid)
+			},
+		},
+		{
+			name:   "RSA-OAEP without kid",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A128GCM", &opKey.PublicKey, "")
+			},
+		},
+		{
+			name: "Registered request_object_encryption_alg and enc",
+			client: func(c *model.Client) {
+				c.Meta.RequestObjectEncryptionAlg = "RSA-OAEP"
+				c.Meta.RequestObjectEncryptionEnc = "A128GCM"
+			},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A128GCM", &opKey.PublicKey, kid)
+			},
+		},
+		{
+			name:   "A128KW with client_secret",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "A128KW", "A128GCM", secretKey(client.Identity.ClientSecret, 16), "")
+			},
+		},
+		{
+			name:   "Unknown kid",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A128GCM", &opKey.PublicKey, "unknown")
+			},
+			wantError: true,
+		},
+		{
+			name:   "Encrypted to another key",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A128GCM", &otherKey.PublicKey, kid)
+			},
+			wantError: true,
+		},
+		{
+			name:   "alg not supported by the OP",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP-256", "A128GCM", &opKey.PublicKey, kid)
+			},
+			wantError: true,
+		},
+		{
+			name:   "enc not supported by the OP",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A256GCM", &opKey.PublicKey, kid)
+			},
+			wantError: true,
+		},
+		{
+			name: "alg differs from request_object_encryption_alg",
+			client: func(c *model.Client) {
+				c.Meta.RequestObjectEncryptionAlg = "A128KW"
+			},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "RSA-OAEP", "A128GCM", &opKey.PublicKey, kid)
+			},
+			wantError: true,
+		},
+		{
+			name:   "A128KW with another client_secret",
+			client: func(c *model.Client) {},
+			jwe: func(client *model.Client) string {
+				return encryptTestRequestObject(t, nested, "A128KW", "A128GCM", secretKey("other-secret", 16), "")
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, tc.client)
+			jwe := tc.jwe(client)
+			assert.True(t, isJwe(jwe))
+			decrypted, err := decryptRequestObject(ctx, iss, client, jwe)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, nested, decrypted)
+			}
+		})
+	}
+}
+
+func TestJwksEncryptionKey(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	_, kid := issuerEncryptionKey(t, iss)
+
+	req := connect.NewRequest(&oppb.JwksRequest{})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	res, err := testProvider.Jwks(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := slices.IndexFunc(res.Msg.Keys, func(k *oppb.Jwk) bool { return k.Kid == kid })
+	if assert.GreaterOrEqual(t, i, 0) {
+		// https://www.rfc-editor.org/rfc/rfc7517.html#section-4.2
+		assert.Equal(t, "enc", res.Msg.Keys[i].Use)
+		assert.Equal(t, "RSA", res.Msg.Keys[i].Kty)
+		assert.Empty(t, res.Msg.Keys[i].D)
+	}
+	// 署名鍵には use=enc は付与されない
+	sig := iss.Resources.KeyMap[keyutil.RSA256].CurrentKeyId
+	i = slices.IndexFunc(res.Msg.Keys, func(k *oppb.Jwk) bool { return k.Kid == sig })
+	if assert.GreaterOrEqual(t, i, 0) {
+		assert.NotEqual(t, "enc", res.Msg.Keys[i].Use)
+	}
+}
@@ -56,6 +56,10 @@ func (p *Provider) Jwks(ctx context.Context,
 						KID: keyId,
 					},
 				}
+				if keyutil.IsEncryptionKeyType(keyType) {
+					// https://www.rfc-editor.org/rfc/rfc7517.html#section-4.2
+					options.Metadata.USE = jwkset.UseEnc
+				}
 				jwk, err := jwkset.NewJWKFromKey(key, options)
 				if err != nil {
 					log.Printf("[BACKEND_ERROR] NewJWKFromKey:%v", err)
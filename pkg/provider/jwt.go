@@ -31,11 +31,20 @@ import (
 )
 
 func makeJwt(ctx context.Context, iss *model.Issuer, claims jwt.Claims, algorithm string) (string, error) {
+	return makeTypedJwt(ctx, iss, claims, algorithm, "")
+}
+
+// makeTypedJwt signs the claims and sets the typ header when typ is not empty.
+// (e.g. token-introspection+jwt, logout+jwt, at+jwt)
+func makeTypedJwt(ctx context.Context, iss *model.Issuer, claims jwt.Claims, algorithm, typ string) (string, error) {
 	keyInfo, err := keyutil.GetKeyInfo(ctx, iss, algorithm)
 	if err != nil {
 		return "", err
 	}
 	jwtToken := jwt.NewWithClaims(keyInfo.Method, claims)
+	if typ != "" {
+		jwtToken.Header["typ"] = typ
+	}
 	if algorithm == "none" {
 		ss, err := jwtToken.SigningString()
 		if err != nil {
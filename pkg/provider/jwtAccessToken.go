@@ -0,0 +1,163 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.1
+// JWT access tokens MUST include this media type in the "typ" header parameter to explicitly declare that the JWT represents an access token
+const typAccessTokenJwt = "at+jwt"
+
+// makeAccessToken returns the access token presented to the client.
+// When the client attribute access_token_signed_response_alg is set, it is a JWT access token (RFC 9068)
+// whose jti is the identifier of the TokenIdentifier. Otherwise it is the opaque identifier itself.
+func makeAccessToken(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, access *model.TokenIdentifier) (string, error) {
+	alg := access.Details.Authorized.Request.Client.Attribute.GetAccessTokenSignedResponseAlg()
+	if alg == "" {
+		return access.Details.Identifier, nil
+	}
+	claims, err := makeAccessTokenClaims(ctx, mapper, iss, access)
+	if err != nil {
+		return "", err
+	}
+	return makeTypedJwt(ctx, iss, claims, alg, typAccessTokenJwt)
+}
+
+// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.2
+func makeAccessTokenClaims(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, access *model.TokenIdentifier) (jwt.MapClaims, error) {
+	authorized := access.Details.Authorized
+	client := authorized.Request.Client
+	c := jwt.MapClaims{
+		"iss":       iss.Meta.Issuer,
+		"exp":       access.ExpireAt.Unix(),
+		"client_id": client.Identity.ClientId,
+		"iat":       access.CreateAt.Unix(),
+		"jti":       access.Details.Identifier,
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9068.html#section-3
+	// If the request does not include a resource parameter, the authorization server MUST use a default resource indicator in the aud claim.
+	if len(access.Details.Audience) > 0 {
+		c["aud"] = access.Details.Audience
+	} else {
+		c["aud"] = iss.Meta.Issuer
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.2
+	// In cases of access tokens obtained through grants where no resource owner is involved,
+	// such as the client credentials grant, the value of "sub" SHOULD correspond to an identifier the authorization server uses to indicate the client application.
+	if authorized.Subject != "" {
+		sub, err := mapper.ClientSubject(ctx, iss, client, authorized.Subject)
+		if err != nil {
+			return nil, err
+		}
+		c["sub"] = sub
+	} else {
+		c["sub"] = client.Identity.ClientId
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.2.1
+	if !authorized.AuthTime.IsZero() && authorized.Subject != "" {
+		c["auth_time"] = authorized.AuthTime.Unix()
+	}
+	if authorized.Claims != "" {
+		var userClaims map[string]any
+		if err := json.Unmarshal([]byte(authorized.Claims), &userClaims); err == nil {
+			if acr, ok := userClaims["acr"]; ok {
+				c["acr"] = acr
+			}
+			if amr, ok := userClaims["amr"]; ok {
+				c["amr"] = amr
+			}
+		}
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.2.3
+	if params := authorized.Request.AuthParams; params != nil && len(params.Scopes) > 0 {
+		c["scope"] = strings.Join(params.Scopes, " ")
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-9.1
+	if details, ok := authorizationDetailsClaim(authorized.AuthorizationDetails); ok {
+		c["authorization_details"] = details
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc8693.html#section-4.1
+	if access.Details.Actor != nil {
+		c["act"] = makeActClaim(access.Details.Actor)
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc8705.html#section-3.1
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-6.1
+	if access.Details.TlsClientCertificate != "" || access.Details.DpopJkt != "" {
+		cnf := map[string]any{}
+		if access.Details.TlsClientCertificate != "" {
+			cnf["x5t#S256"] = access.Details.TlsClientCertificate
+		}
+		if access.Details.DpopJkt != "" {
+			cnf["jkt"] = access.Details.DpopJkt
+		}
+		c["cnf"] = cnf
+	}
+	return c, nil
+}
+
+// accessTokenIdentifier returns the identifier of the TokenIdentifier for the presented token.
+// The jti of a JWT access token issued by this issuer, otherwise the token itself.
+func accessTokenIdentifier(ctx context.Context, iss *model.Issuer, token string) string {
+	if strings.Count(token, ".") != 2 {
+		return token
+	}
+	algs := slices.DeleteFunc(keyutil.SigningAlgValueSupported(), func(alg string) bool {
+		return alg == jwt.SigningMethodNone.Alg()
+	})
+	keyfunc := keyutil.GetKeyfunc(ctx, iss.Key)
+	claims := jwt.MapClaims{}
+	if _, err := jwt.NewParser(jwt.WithValidMethods(algs), jwt.WithIssuer(iss.Meta.Issuer)).ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		// https://www.rfc-editor.org/rfc/rfc9068.html#section-4
+		// Resource servers receiving a JWT access token MUST validate the typ header
+		if typ, _ := t.Header["typ"].(string); !strings.EqualFold(typ, typAccessTokenJwt) && !strings.EqualFold(typ, "application/"+typAccessTokenJwt) {
+			return nil, fmt.Errorf("typ not match:%s", typ)
+		}
+		if _, ok := t.Header["kid"].(string); !ok {
+			return nil, fmt.Errorf("kid not found")
+		}
+		return keyfunc(t)
+	}); err != nil {
+		return token
+	}
+	if jti, ok := claims["jti"].(string); ok && jti != "" {
+		return jti
+	}
+	return token
+}
@@ -0,0 +1,212 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.2
+func TestJwtAccessTokenClientCredentials(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	type testCase struct {
+		name             string
+		alg              string
+		form             url.Values
+		expectedAudience any
+	}
+
+	tests := []testCase{
+		{
+			name:             "RS256 access token for the issuer",
+			alg:              "RS256",
+			form:             url.Values{"scope": {"read"}},
+			expectedAudience: iss.Meta.Issuer,
+		},
+		{
+			name: "ES256 access token for the resource",
+			alg:  "ES256",
+			form: url.Values{
+				"scope":    {"read"},
+				"resource": {testResourceA},
+			},
+			expectedAudience: []any{testResourceA},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, clientCredentialsClient, func(c *model.Client) {
+				c.Attribute.AccessTokenSignedResponseAlg = tc.alg
+				c.Attribute.AllowedResources = []string{testResourceA}
+			})
+			form := url.Values{"grant_type": {oauth.GrantTypeClientCredentials}}
+			for k, v := range tc.form {
+				form[k] = v
+			}
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success, res.Msg.GetFail()) {
+				return
+			}
+
+			claims := jwt.MapClaims{}
+			token, err := jwt.NewParser(jwt.WithValidMethods([]string{tc.alg})).
+				ParseWithClaims(success.AccessToken, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+			if !assert.NoError(t, err) {
+				return
+			}
+			// JWT access tokens MUST include this media type in the "typ" header parameter
+			assert.Equal(t, "at+jwt", token.Header["typ"])
+			assert.NotEmpty(t, token.Header["kid"])
+
+			assert.Equal(t, iss.Meta.Issuer, claims["iss"])
+			// エンドユーザーが存在しないため、sub はクライアントを示す
+			assert.Equal(t, client.Identity.ClientId, claims["sub"])
+			assert.Equal(t, client.Identity.ClientId, claims["client_id"])
+			assert.Equal(t, tc.expectedAudience, claims["aud"])
+			assert.Equal(t, "read", claims["scope"])
+			assert.NotContains(t, claims, "auth_time")
+			iat, _ := claims.GetIssuedAt()
+			exp, _ := claims.GetExpirationTime()
+			if assert.NotNil(t, iat) && assert.NotNil(t, exp) {
+				assert.Equal(t, time.Duration(client.Attribute.AccessTokenLifetimeSeconds)*time.Second, exp.Sub(iat.Time))
+			}
+
+			// jti は記録されたトークンの識別子
+			jti, _ := claims["jti"].(string)
+			assert.Equal(t, jti, accessTokenIdentifier(ctx, iss, success.AccessToken))
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: jti,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			assert.NoError(t, dataprovider.Get(ctx, access))
+		})
+	}
+}
+
+// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.2.1
+func TestJwtAccessTokenEndUserClaims(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss, func(c *model.Client) {
+		c.Attribute.AccessTokenSignedResponseAlg = "RS256"
+	})
+	authorized := newTestAuthorized(t, iss, client, "openid", "profile")
+	authorized.Claims = `{"sub":"user-1","acr":"urn:example:loa:2","amr":["pwd","otp"]}`
+	access, err := makeAccessTokenIdentifier(authorized, time.Now(), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := makeAccessTokenClaims(ctx, testProvider.subjectMapper(), iss, access)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "user-1", claims["sub"])
+	assert.Equal(t, authorized.AuthTime.Unix(), claims["auth_time"])
+	assert.Equal(t, "urn:example:loa:2", claims["acr"])
+	assert.Equal(t, []any{"pwd", "otp"}, claims["amr"])
+	assert.Equal(t, "openid profile", claims["scope"])
+	assert.Equal(t, access.Details.Identifier, claims["jti"])
+}
+
+func TestAccessTokenIdentifier(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	other := newTestIssuer(t)
+
+	sign := func(t *testing.T, iss *model.Issuer, typ string) string {
+		token, err := makeTypedJwt(ctx, iss, jwt.MapClaims{
+			"iss": iss.Meta.Issuer,
+			"jti": "jti-1",
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}, "RS256", typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	type testCase struct {
+		name     string
+		token    func(t *testing.T) string
+		expected func(token string) string
+	}
+
+	tests := []testCase{
+		{
+			name:     "Opaque access token",
+			token:    func(t *testing.T) string { return "opaque-token" },
+			expected: func(token string) string { return token },
+		},
+		{
+			name:     "JWT access token of the issuer",
+			token:    func(t *testing.T) string { return sign(t, iss, "at+jwt") },
+			expected: func(token string) string { return "jti-1" },
+		},
+		{
+			name:     "JWT access token with the media type",
+			token:    func(t *testing.T) string { return sign(t, iss, "application/at+jwt") },
+			expected: func(token string) string { return "jti-1" },
+		},
+		{
+			name:     "JWT that is not an access token",
+			token:    func(t *testing.T) string { return sign(t, iss, "JWT") },
+			expected: func(token string) string { return token },
+		},
+		{
+			name:     "JWT access token of another issuer",
+			token:    func(t *testing.T) string { return sign(t, other, "at+jwt") },
+			expected: func(token string) string { return token },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			token := tc.token(t)
+			assert.Equal(t, tc.expected(token), accessTokenIdentifier(ctx, iss, token))
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// parseClientCertificate parses the client certificate forwarded to the provider (PEM or base64 DER).
+func parseClientCertificate(value string) (*x509.Certificate, error) {
+	if value == "" {
+		return nil, fmt.Errorf("no client certificate")
+	}
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if der, err := enc.DecodeString(value); err == nil {
+			return x509.ParseCertificate(der)
+		}
+	}
+	return nil, fmt.Errorf("invalid client certificate")
+}
+
+// https://www.rfc-editor.org/rfc/rfc8705.html#section-3.1
+// certificateThumbprint returns the x5t#S256 of the client certificate.
+func certificateThumbprint(value string) (string, error) {
+	cert, err := parseClientCertificate(value)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:]), nil
+}
+
+// intermediateCertificates returns the certificates following the client certificate in the PEM value.
+func intermediateCertificates(value string) *x509.CertPool {
+	pool := x509.NewCertPool()
+	_, rest := pem.Decode([]byte(value))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			pool.AddCert(cert)
+		}
+	}
+	return pool
+}
+
+// tlsClientAuthTrustAnchors returns the trust anchors for tls_client_auth of the issuer (nil if not configured).
+func (p *Provider) tlsClientAuthTrustAnchors(ctx context.Context, iss *model.Issuer) (*x509.CertPool, error) {
+	if cb, ok := p.callbacks.(model.TlsClientAuthCallbacks); ok {
+		return cb.TlsClientAuthTrustAnchors(ctx, iss)
+	}
+	return nil, nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1
+// checkTlsClientAuth verifies the certificate chain against the trust anchors
+// and the certificate against the subject DN or SAN registered for the client.
+func checkTlsClientAuth(roots *x509.CertPool, meta *oppb.ClientMeta, value string) error {
+	// 信頼アンカーが未設定の場合はシステムのルート証明書を使用せずに拒否する
+	if roots == nil {
+		return fmt.Errorf("no trust anchors configured")
+	}
+	cert, err := parseClientCertificate(value)
+	if err != nil {
+		return err
+	}
+	// 証明書はヘッダー経由や RequestClientCert の TLS サーバーから渡される場合があるため、
+	// TLS サーバーで検証済みとはみなさずに信頼アンカーまでのチェーンを検証する
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediateCertificates(value),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("certificate chain not trusted: %w", err)
+	}
+	// A client using the "tls_client_auth" authentication method MUST use exactly one of the below metadata parameters
+	switch {
+	case meta.TlsClientAuthSubjectDn != "":
+		if !equalDistinguishedName(cert.Subject.String(), meta.TlsClientAuthSubjectDn) {
+			return fmt.Errorf("subject DN not match:%s", cert.Subject.String())
+		}
+	case meta.TlsClientAuthSanDns != "":
+		if !slices.Contains(cert.DNSNames, meta.TlsClientAuthSanDns) {
+			return fmt.Errorf("SAN dNSName not match")
+		}
+	case meta.TlsClientAuthSanUri != "":
+		if !slices.ContainsFunc(cert.URIs, func(u *url.URL) bool { return u.String() == meta.TlsClientAuthSanUri }) {
+			return fmt.Errorf("SAN uniformResourceIdentifier not match")
+		}
+	case meta.TlsClientAuthSanIp != "":
+		ip := net.ParseIP(meta.TlsClientAuthSanIp)
+		if ip == nil || !slices.ContainsFunc(cert.IPAddresses, ip.Equal) {
+			return fmt.Errorf("SAN iPAddress not match")
+		}
+	case meta.TlsClientAuthSanEmail != "":
+		if !slices.Contains(cert.EmailAddresses, meta.TlsClientAuthSanEmail) {
+			return fmt.Errorf("SAN rfc822Name not match")
+		}
+	default:
+		return fmt.Errorf("no tls_client_auth metadata registered")
+	}
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.2
+// checkSelfSignedTlsClientAuth verifies that the certificate is registered in the x5c of the client's JWKS.
+// The certificate is accepted only from the TLS handshake, since the registered certificate itself is public.
+func checkSelfSignedTlsClientAuth(ctx context.Context, meta *oppb.ClientMeta, value string, fromHandshake bool) error {
+	// ヘッダー等のリクエストデータから渡された証明書では秘密鍵の所持が証明されない
+	if !fromHandshake {
+		return fmt.Errorf("certificate not presented in the TLS handshake")
+	}
+	cert, err := parseClientCertificate(value)
+	if err != nil {
+		return err
+	}
+	if err := checkCertificateValidity(cert); err != nil {
+		return err
+	}
+	kf, err := clientKeyfunc(ctx, meta)
+	if err != nil {
+		return err
+	}
+	keys, err := kf.Storage().KeyReadAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		// The first certificate in the x5c chain is the certificate of the key
+		if x5c := key.X509().X5C; len(x5c) > 0 && bytes.Equal(x5c[0].Raw, cert.Raw) {
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate not registered in jwks")
+}
+
+func checkCertificateValidity(cert *x509.Certificate) error {
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return fmt.Errorf("certificate is expired or not yet valid")
+	}
+	return nil
+}
+
+// equalDistinguishedName compares DNs in the RFC 4514 string representation,
+// ignoring whitespace around separators and the case of attribute types.
+func equalDistinguishedName(a, b string) bool {
+	normalize := func(dn string) []string {
+		rdns := strings.Split(dn, ",")
+		for i, rdn := range rdns {
+			typ, val, _ := strings.Cut(strings.TrimSpace(rdn), "=")
+			rdns[i] = strings.ToUpper(strings.TrimSpace(typ)) + "=" + strings.TrimSpace(val)
+		}
+		return rdns
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+// https://www.rfc-editor.org/rfc/rfc8705.html#section-3
+// checkCertificateBoundRequest rejects the token request without a valid client certificate
+// from a client registered with tls_client_certificate_bound_access_tokens.
+func checkCertificateBoundRequest(client *model.Client, value string) *connect.Response[oppb.TokenResponse] {
+	if !client.Meta.TlsClientCertificateBoundAccessTokens {
+		return nil
+	}
+	if value == "" {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "client certificate is required for certificate-bound access tokens")
+	}
+	if _, err := parseClientCertificate(value); err != nil {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "invalid client certificate: "+err.Error())
+	}
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc8705.html#section-3
+// checkCertificateBinding confirms the certificate presented with a certificate-bound token.
+func checkCertificateBinding(identifier *model.TokenIdentifier, value string) bool {
+	if identifier.Details.TlsClientCertificate == "" {
+		return true
+	}
+	thumbprint, err := certificateThumbprint(value)
+	if err != nil {
+		return false
+	}
+	return thumbprint == identifier.Details.TlsClientCertificate
+}
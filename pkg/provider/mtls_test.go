@@ -0,0 +1,632 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/convert"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCertificate returns a self-signed client certificate modified by opts.
+func newTestCertificate(t *testing.T, opts ...func(*x509.Certificate)) *x509.Certificate {
+	t.Helper()
+	cert, _ := createTestCertificate(t, clientCertificateTemplate(opts...), nil, nil)
+	return cert
+}
+
+func clientCertificateTemplate(opts ...func(*x509.Certificate)) *x509.Certificate {
+	san, _ := url.Parse("https://rp.example.com/client")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject: pkix.Name{
+			CommonName:   "client",
+			Organization: []string{"Example"},
+			Country:      []string{"JP"},
+		},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		DNSNames:       []string{"rp.example.com"},
+		URIs:           []*url.URL{san},
+		IPAddresses:    []net.IP{net.ParseIP("192.0.2.1")},
+		EmailAddresses: []string{"rp@example.com"},
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, opt := range opts {
+		opt(template)
+	}
+	return template
+}
+
+// createTestCertificate signs the template with the parent (self-signed if parent is nil).
+func createTestCertificate(t *testing.T, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// testCA issues client certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCA returns a CA certificate issued by the parent (a root CA if parent is nil).
+func newTestCA(t *testing.T, name string, parent *testCA) *testCA {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	ca := &testCA{}
+	if parent == nil {
+		ca.cert, ca.key = createTestCertificate(t, template, nil, nil)
+	} else {
+		ca.cert, ca.key = createTestCertificate(t, template, parent.cert, parent.key)
+	}
+	return ca
+}
+
+// issue returns a client certificate modified by opts and signed by the CA.
+func (ca *testCA) issue(t *testing.T, opts ...func(*x509.Certificate)) *x509.Certificate {
+	t.Helper()
+	cert, _ := createTestCertificate(t, clientCertificateTemplate(opts...), ca.cert, ca.key)
+	return cert
+}
+
+// pool returns the CA certificate as the trust anchor.
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+// testTrustAnchors provides the trust anchors as TlsClientAuthCallbacks.
+type testTrustAnchors struct {
+	model.ProviderCallbacks
+	roots *x509.CertPool
+}
+
+func (a testTrustAnchors) TlsClientAuthTrustAnchors(context.Context, *model.Issuer) (*x509.CertPool, error) {
+	return a.roots, nil
+}
+
+func pemCertificate(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// x5tS256 returns the x5t#S256 thumbprint of the certificate.
+func x5tS256(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func TestCheckTlsClientAuth(t *testing.T) {
+	root := newTestCA(t, "root", nil)
+	intermediate := newTestCA(t, "intermediate", root)
+	roots := root.pool()
+	cert := root.issue(t)
+	expired := root.issue(t, func(c *x509.Certificate) {
+		c.NotBefore = time.Now().Add(-2 * time.Hour)
+		c.NotAfter = time.Now().Add(-time.Hour)
+	})
+	serverAuth := root.issue(t, func(c *x509.Certificate) {
+		c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	})
+	chained := intermediate.issue(t)
+
+	type testCase struct {
+		name           string
+		noTrustAnchors bool
+		meta           *oppb.ClientMeta
+		value          string
+		wantError      bool
+	}
+
+	tests := []testCase{
+		{
+			name:  "Subject DN",
+			meta:  &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value: pemCertificate(cert),
+		},
+		{
+			name:  "Subject DN ignores whitespace and attribute type case",
+			meta:  &oppb.ClientMeta{TlsClientAuthSubjectDn: "cn=client, o=Example, c=JP"},
+			value: pemCertificate(cert),
+		},
+		{
+			name:      "Subject DN does not match",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=other,O=Example,C=JP"},
+			value:     pemCertificate(cert),
+			wantError: true,
+		},
+		{
+			name:  "SAN dNSName with base64 DER certificate",
+			meta:  &oppb.ClientMeta{TlsClientAuthSanDns: "rp.example.com"},
+			value: base64.StdEncoding.EncodeToString(cert.Raw),
+		},
+		{
+			name:      "SAN dNSName does not match",
+			meta:      &oppb.ClientMeta{TlsClientAuthSanDns: "other.example.com"},
+			value:     pemCertificate(cert),
+			wantError: true,
+		},
+		{
+			name:  "SAN uniformResourceIdentifier",
+			meta:  &oppb.ClientMeta{TlsClientAuthSanUri: "https://rp.example.com/client"},
+			value: pemCertificate(cert),
+		},
+		{
+			name:      "SAN uniformResourceIdentifier does not match",
+			meta:      &oppb.ClientMeta{TlsClientAuthSanUri: "https://rp.example.com/other"},
+			value:     pemCertificate(cert),
+			wantError: true,
+		},
+		{
+			name:  "SAN iPAddress",
+			meta:  &oppb.ClientMeta{TlsClientAuthSanIp: "192.0.2.1"},
+			value: pemCertificate(cert),
+		},
+		{
+			name:      "SAN iPAddress does not match",
+			meta:      &oppb.ClientMeta{TlsClientAuthSanIp: "192.0.2.2"},
+			value:     pemCertificate(cert),
+			wantError: true,
+		},
+		{
+			name:  "SAN rfc822Name",
+			meta:  &oppb.ClientMeta{TlsClientAuthSanEmail: "rp@example.com"},
+			value: pemCertificate(cert),
+		},
+		{
+			name:      "SAN rfc822Name does not match",
+			meta:      &oppb.ClientMeta{TlsClientAuthSanEmail: "other@example.com"},
+			value:     pemCertificate(cert),
+			wantError: true,
+		},
+		{
+			name:      "No tls_client_auth metadata",
+			meta:      &oppb.ClientMeta{},
+			value:     pemCertificate(cert),
+			wantError: true,
+		},
+		{
+			name:      "Expired certificate",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     pemCertificate(expired),
+			wantError: true,
+		},
+		{
+			name:      "No certificate",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     "",
+			wantError: true,
+		},
+		{
+			name:      "Thumbprint instead of certificate",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     x5tS256(cert),
+			wantError: true,
+		},
+		{
+			name:      "Self-signed certificate with the registered subject DN",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     pemCertificate(newTestCertificate(t)),
+			wantError: true,
+		},
+		{
+			name:      "Certificate issued by an untrusted CA",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     pemCertificate(newTestCA(t, "other", nil).issue(t)),
+			wantError: true,
+		},
+		{
+			name:      "Certificate not for client authentication",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     pemCertificate(serverAuth),
+			wantError: true,
+		},
+		{
+			name:  "Certificate chain with an intermediate CA",
+			meta:  &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value: pemCertificate(chained) + pemCertificate(intermediate.cert),
+		},
+		{
+			name:      "Intermediate CA not presented",
+			meta:      &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:     pemCertificate(chained),
+			wantError: true,
+		},
+		{
+			name:           "No trust anchors configured",
+			noTrustAnchors: true,
+			meta:           &oppb.ClientMeta{TlsClientAuthSubjectDn: "CN=client,O=Example,C=JP"},
+			value:          pemCertificate(cert),
+			wantError:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			roots := roots
+			if tc.noTrustAnchors {
+				roots = nil
+			}
+			err := checkTlsClientAuth(roots, tc.meta, tc.value)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckSelfSignedTlsClientAuth(t *testing.T) {
+	ctx := context.Background()
+	cert := newTestCertificate(t)
+	other := newTestCertificate(t)
+
+	jwk, err := jwkset.NewJWKFromX5C(jwkset.JWKOptions{
+		X509: jwkset.JWKX509Options{
+			X5C: []*x509.Certificate{cert},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := &oppb.ClientMeta{
+		Jwks: &oppb.Jwks{
+			Keys: convert.JwksFromJWKMarshals([]jwkset.JWKMarshal{jwk.Marshal()}),
+		},
+	}
+
+	type testCase struct {
+		name          string
+		meta          *oppb.ClientMeta
+		value         string
+		fromHandshake bool
+		wantError     bool
+	}
+
+	tests := []testCase{
+		{
+			name:          "Certificate registered in x5c",
+			meta:          meta,
+			value:         pemCertificate(cert),
+			fromHandshake: true,
+		},
+		{
+			// the registered certificate is public and can be copied into a header
+			name:          "Certificate registered in x5c but not from the TLS handshake",
+			meta:          meta,
+			value:         pemCertificate(cert),
+			fromHandshake: false,
+			wantError:     true,
+		},
+		{
+			name:          "Certificate not registered",
+			meta:          meta,
+			value:         pemCertificate(other),
+			fromHandshake: true,
+			wantError:     true,
+		},
+		{
+			name:          "No jwks registered",
+			meta:          &oppb.ClientMeta{},
+			value:         pemCertificate(cert),
+			fromHandshake: true,
+			wantError:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSelfSignedTlsClientAuth(ctx, tc.meta, tc.value, tc.fromHandshake)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCertificateBoundTokenRequest(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	root := newTestCA(t, "root", nil)
+	cert := root.issue(t)
+	other := newTestCertificate(t)
+	trusted := NewProvider(testTrustAnchors{
+		ProviderCallbacks: testProvider.callbacks,
+		roots:             root.pool(),
+	})
+
+	jwk, err := jwkset.NewJWKFromX5C(jwkset.JWKOptions{
+		X509: jwkset.JWKX509Options{
+			X5C: []*x509.Certificate{other},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	selfSigned := func(c *model.Client) {
+		c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodSelfSignedTlsClientAuth
+		c.Meta.Jwks = &oppb.Jwks{
+			Keys: convert.JwksFromJWKMarshals([]jwkset.JWKMarshal{jwk.Marshal()}),
+		}
+		c.Meta.TlsClientCertificateBoundAccessTokens = true
+	}
+
+	type testCase struct {
+		name           string
+		noTrustAnchors bool
+		client         func(c *model.Client)
+		certificate    string
+		fromHandshake  bool
+		wantError      string
+		wantBinding    string
+	}
+
+	tests := []testCase{
+		{
+			name: "tls_client_auth issues a certificate-bound access token",
+			client: func(c *model.Client) {
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodTlsClientAuth
+				c.Meta.TlsClientAuthSubjectDn = "CN=client,O=Example,C=JP"
+				c.Meta.TlsClientCertificateBoundAccessTokens = true
+			},
+			certificate: pemCertificate(cert),
+			wantBinding: x5tS256(cert),
+		},
+		{
+			name: "tls_client_auth with a certificate of another subject",
+			client: func(c *model.Client) {
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodTlsClientAuth
+				c.Meta.TlsClientAuthSubjectDn = "CN=other,O=Example,C=JP"
+			},
+			certificate: pemCertificate(cert),
+			wantError:   oauth.TokenErrorInvalidClient,
+		},
+		{
+			name: "tls_client_auth with a self-signed certificate of the registered subject",
+			client: func(c *model.Client) {
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodTlsClientAuth
+				c.Meta.TlsClientAuthSubjectDn = "CN=client,O=Example,C=JP"
+			},
+			certificate: pemCertificate(newTestCertificate(t)),
+			wantError:   oauth.TokenErrorInvalidClient,
+		},
+		{
+			name:           "tls_client_auth is refused without trust anchors",
+			noTrustAnchors: true,
+			client: func(c *model.Client) {
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodTlsClientAuth
+				c.Meta.TlsClientAuthSubjectDn = "CN=client,O=Example,C=JP"
+			},
+			certificate: pemCertificate(cert),
+			wantError:   oauth.TokenErrorInvalidClient,
+		},
+		{
+			name:          "self_signed_tls_client_auth with the certificate from the TLS handshake",
+			client:        selfSigned,
+			certificate:   pemCertificate(other),
+			fromHandshake: true,
+			wantBinding:   x5tS256(other),
+		},
+		{
+			name:          "self_signed_tls_client_auth with the certificate from request data",
+			client:        selfSigned,
+			certificate:   pemCertificate(other),
+			fromHandshake: false,
+			wantError:     oauth.TokenErrorInvalidClient,
+		},
+		{
+			name: "tls_client_auth does not accept a certificate hash",
+			client: func(c *model.Client) {
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodTlsClientAuth
+				c.Meta.TlsClientAuthSubjectDn = "CN=client,O=Example,C=JP"
+			},
+			certificate: x5tS256(cert),
+			wantError:   oauth.TokenErrorInvalidClient,
+		},
+		{
+			name: "Bound access tokens require a client certificate",
+			client: func(c *model.Client) {
+				c.Meta.TlsClientCertificateBoundAccessTokens = true
+			},
+			certificate: "",
+			wantError:   oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name: "Bound access tokens do not accept a certificate hash",
+			client: func(c *model.Client) {
+				c.Meta.TlsClientCertificateBoundAccessTokens = true
+			},
+			certificate: x5tS256(cert),
+			wantError:   oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:        "Unbound access token",
+			client:      func(c *model.Client) {},
+			certificate: pemCertificate(other),
+			wantBinding: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeClientCredentials)
+				tc.client(c)
+			})
+			req := newTestTokenRequest(iss, client, url.Values{
+				"grant_type": {oauth.GrantTypeClientCredentials},
+			})
+			req.Msg.TlsClientCertificate = tc.certificate
+			req.Msg.TlsClientCertificateFromHandshake = tc.fromHandshake
+			p := trusted
+			if tc.noTrustAnchors {
+				p = testProvider
+			}
+			res, err := p.Token(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantError != "" {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, tc.wantError, res.Msg.GetFail().Error.Error)
+				}
+				return
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: success.AccessToken,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			if assert.Nil(t, dataprovider.Get(ctx, access)) {
+				assert.Equal(t, tc.wantBinding, access.Details.TlsClientCertificate)
+			}
+		})
+	}
+}
+
+func TestCertificateBoundUserinfo(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	cert := newTestCertificate(t)
+	other := newTestCertificate(t)
+	client := newTestClient(t, iss, func(c *model.Client) {
+		c.Meta.TlsClientCertificateBoundAccessTokens = true
+	})
+	access, err := makeAccessTokenIdentifier(newTestAuthorized(t, iss, client, "openid"), time.Now(), pemCertificate(cert), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dataprovider.Create(ctx, access); err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		name        string
+		certificate string
+		wantStatus  int32
+	}
+
+	tests := []testCase{
+		{
+			name:        "Same certificate",
+			certificate: pemCertificate(cert),
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "Same certificate in base64 DER",
+			certificate: base64.StdEncoding.EncodeToString(cert.Raw),
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "Other certificate",
+			certificate: pemCertificate(other),
+			wantStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:        "No certificate",
+			certificate: "",
+			wantStatus:  http.StatusUnauthorized,
+		},
+		{
+			// cnf.x5t#S256 of the token is not a proof of the certificate
+			name:        "Thumbprint instead of certificate",
+			certificate: access.Details.TlsClientCertificate,
+			wantStatus:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := connect.NewRequest(&oppb.UserinfoRequest{
+				Authorization:        "Bearer " + access.Details.Identifier,
+				Method:               http.MethodGet,
+				TlsClientCertificate: tc.certificate,
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			res, err := testProvider.Userinfo(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantStatus, res.Msg.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+const subjectTypePairwise = "pairwise"
+
+// subjectMapper returns the SubjectMapper of the callbacks, or the default pairwise mapper.
+func (p *Provider) subjectMapper() model.SubjectMapper {
+	if m, ok := p.callbacks.(model.SubjectMapper); ok {
+		return m
+	}
+	return pairwiseSubjectMapper{}
+}
+
+// pairwiseSubjectMapper presents pairwise identifiers to the clients registered with subject_type "pairwise"
+// and the local subject to the other clients.
+type pairwiseSubjectMapper struct{}
+
+func (pairwiseSubjectMapper) ClientSubject(ctx context.Context, iss *model.Issuer, client *model.Client, subject string) (string, error) {
+	if client.Meta.SubjectType != subjectTypePairwise || subject == "" {
+		return subject, nil
+	}
+	if err := checkPairwiseSalt(iss); err != nil {
+		return "", err
+	}
+	sector, err := model.SectorIdentifier(client.Meta)
+	if err != nil {
+		return "", err
+	}
+	ps := &model.PairwiseSubject{
+		Details: model.PairwiseSubjectDetails{
+			Issuer:   iss.Key,
+			Pairwise: pairwiseSubject(iss, sector, subject),
+		},
+	}
+	// id_token_hint などで逆引きするために初回発行時のみ記録する
+	// 記録に失敗してもトークン発行は継続し、次回の発行時に再度記録する
+	if err := dataprovider.Get(ctx, ps); err != nil {
+		ps.CreateAt = time.Now()
+		ps.Details.Sector = sector
+		ps.Details.Subject = subject
+		if err := dataprovider.Create(ctx, ps); err != nil {
+			log.Printf("[BACKEND_ERROR] PairwiseSubject Create error:%v", err)
+		}
+	}
+	return ps.Details.Pairwise, nil
+}
+
+func (pairwiseSubjectMapper) InternalSubject(ctx context.Context, iss *model.Issuer, client *model.Client, clientSubject string) (string, error) {
+	if client.Meta.SubjectType != subjectTypePairwise || clientSubject == "" {
+		return clientSubject, nil
+	}
+	if err := checkPairwiseSalt(iss); err != nil {
+		return "", err
+	}
+	sector, err := model.SectorIdentifier(client.Meta)
+	if err != nil {
+		return "", err
+	}
+	ps := &model.PairwiseSubject{
+		Details: model.PairwiseSubjectDetails{
+			Issuer:   iss.Key,
+			Pairwise: clientSubject,
+		},
+	}
+	if err := dataprovider.Get(ctx, ps); err != nil {
+		return "", err
+	}
+	if ps.Details.Sector != sector {
+		return "", fmt.Errorf("pairwise subject of another sector")
+	}
+	return ps.Details.Subject, nil
+}
+
+// checkPairwiseSalt refuses pairwise identifiers for an issuer without a salt
+// (stored before the salt was introduced), because an unsalted hash of sector and subject is guessable.
+func checkPairwiseSalt(iss *model.Issuer) error {
+	if iss.Secret.GetPairwiseSalt() == "" {
+		return fmt.Errorf("pairwise subject requires the pairwise salt of the issuer")
+	}
+	return nil
+}
+
+// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+// sub = SHA-256 ( sector_identifier || local_account_id || salt )
+func pairwiseSubject(iss *model.Issuer, sector, subject string) string {
+	hash := sha256.Sum256([]byte(sector + subject + iss.Secret.GetPairwiseSalt()))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
@@ -0,0 +1,287 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func pairwiseClient(redirectUri, sectorIdentifierUri string) func(c *model.Client) {
+	return func(c *model.Client) {
+		c.Meta.SubjectType = subjectTypePairwise
+		c.Meta.RedirectUris = []string{redirectUri}
+		c.Meta.SectorIdentifierUri = sectorIdentifierUri
+	}
+}
+
+func expectedPairwiseSubject(sector, subject, salt string) string {
+	hash := sha256.Sum256([]byte(sector + subject + salt))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func TestPairwiseClientSubject(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	noSalt := newTestIssuer(t)
+	noSalt.Secret.PairwiseSalt = ""
+
+	type testCase struct {
+		name      string
+		issuer    *model.Issuer
+		client    func(c *model.Client)
+		subject   string
+		expected  string
+		wantError bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "Public client is presented the local subject",
+			issuer:   iss,
+			client:   func(c *model.Client) {},
+			subject:  "user-1",
+			expected: "user-1",
+		},
+		{
+			name:     "Pairwise client with the host of redirect_uri as sector",
+			issuer:   iss,
+			client:   pairwiseClient("https://rp.example.com/callback", ""),
+			subject:  "user-1",
+			expected: expectedPairwiseSubject("rp.example.com", "user-1", "test-salt"),
+		},
+		{
+			name:     "Pairwise client with sector_identifier_uri",
+			issuer:   iss,
+			client:   pairwiseClient("https://rp.example.com/callback", "https://sector.example.com/redirect_uris.json"),
+			subject:  "user-1",
+			expected: expectedPairwiseSubject("sector.example.com", "user-1", "test-salt"),
+		},
+		{
+			name:     "Pairwise client without end-user",
+			issuer:   iss,
+			client:   pairwiseClient("https://rp.example.com/callback", ""),
+			subject:  "",
+			expected: "",
+		},
+		{
+			name:      "Issuer without pairwise salt",
+			issuer:    noSalt,
+			client:    pairwiseClient("https://rp.example.com/callback", ""),
+			subject:   "user-1",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, tc.issuer, tc.client)
+			sub, err := pairwiseSubjectMapper{}.ClientSubject(ctx, tc.issuer, client, tc.subject)
+			if tc.wantError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, sub)
+
+			// 同じ入力からは常に同じ識別子が導出される
+			again, err := pairwiseSubjectMapper{}.ClientSubject(ctx, tc.issuer, client, tc.subject)
+			assert.NoError(t, err)
+			assert.Equal(t, sub, again)
+		})
+	}
+}
+
+func TestPairwiseSubjectSector(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	mapper := pairwiseSubjectMapper{}
+
+	client := newTestClient(t, iss, pairwiseClient("https://rp.example.com/callback", ""))
+	sameSector := newTestClient(t, iss, pairwiseClient("https://rp.example.com/other", ""))
+	otherSector := newTestClient(t, iss, pairwiseClient("https://other.example.com/callback", ""))
+
+	sub, err := mapper.ClientSubject(ctx, iss, client, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	same, err := mapper.ClientSubject(ctx, iss, sameSector, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := mapper.ClientSubject(ctx, iss, otherSector, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherUser, err := mapper.ClientSubject(ctx, iss, client, "user-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, sub, same)
+	assert.NotEqual(t, sub, other)
+	assert.NotEqual(t, sub, otherUser)
+	assert.NotEqual(t, "user-1", sub)
+}
+
+func TestPairwiseInternalSubject(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	mapper := pairwiseSubjectMapper{}
+
+	client := newTestClient(t, iss, pairwiseClient("https://rp.example.com/callback", ""))
+	sameSector := newTestClient(t, iss, pairwiseClient("https://rp.example.com/other", ""))
+	otherSector := newTestClient(t, iss, pairwiseClient("https://other.example.com/callback", ""))
+	public := newTestClient(t, iss)
+	sub, err := mapper.ClientSubject(ctx, iss, client, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		name          string
+		client        *model.Client
+		clientSubject string
+		expected      string
+		wantError     bool
+	}
+
+	tests := []testCase{
+		{
+			name:          "Reverse lookup of the pairwise subject",
+			client:        client,
+			clientSubject: sub,
+			expected:      "user-1",
+		},
+		{
+			name:          "Client of the same sector",
+			client:        sameSector,
+			clientSubject: sub,
+			expected:      "user-1",
+		},
+		{
+			name:          "Client of another sector",
+			client:        otherSector,
+			clientSubject: sub,
+			wantError:     true,
+		},
+		{
+			name:          "Unknown pairwise subject",
+			client:        client,
+			clientSubject: expectedPairwiseSubject("rp.example.com", "unknown", "test-salt"),
+			wantError:     true,
+		},
+		{
+			name:          "Public client",
+			client:        public,
+			clientSubject: "user-1",
+			expected:      "user-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			subject, err := mapper.InternalSubject(ctx, iss, tc.client, tc.clientSubject)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, subject)
+			}
+		})
+	}
+}
+
+func TestPairwiseIntrospection(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	// 保護リソースは subject_type が public のクライアントとして登録されている
+	resourceServer := newTestClient(t, iss)
+
+	type testCase struct {
+		name     string
+		client   func(c *model.Client)
+		expected string
+	}
+
+	tests := []testCase{
+		{
+			name:     "Token issued to a pairwise client",
+			client:   pairwiseClient("https://rp.example.com/callback", ""),
+			expected: expectedPairwiseSubject("rp.example.com", "user-1", "test-salt"),
+		},
+		{
+			name:     "Token issued to a public client",
+			client:   func(c *model.Client) {},
+			expected: "user-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, tc.client)
+			access, err := makeAccessTokenIdentifier(newTestAuthorized(t, iss, client, "openid"), time.Now(), "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dataprovider.Create(ctx, access); err != nil {
+				t.Fatal(err)
+			}
+
+			req := connect.NewRequest(&oppb.IntrospectionRequest{
+				ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+				Method:      http.MethodPost,
+				Form: url.Values{
+					"token":         {access.Details.Identifier},
+					"client_id":     {resourceServer.Identity.ClientId},
+					"client_secret": {resourceServer.Identity.ClientSecret},
+				}.Encode(),
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			res, err := testProvider.Introspection(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, int32(http.StatusOK), res.Msg.StatusCode)
+			body := map[string]any{}
+			if err := json.Unmarshal([]byte(res.Msg.Body), &body); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, true, body["active"])
+			assert.Equal(t, tc.expected, body["sub"])
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/inmemstore"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+const testIssuerPassword = "test-password"
+
+var testProvider *Provider
+
+func TestMain(m *testing.M) {
+	// Set up the in-memory store for testing
+	memstore := inmemstore.New(1 * time.Minute)
+	dataprovider.Initialize(memstore)
+	dataprovider.AddWriteOpInterceptor(&model.TokenIdentifier{}, inmemstore.TokenWriteInterceptor)
+	testProvider = NewProvider(memstore)
+	_ = m.Run()
+}
+
+// newTestIssuer stores an issuer with RS256, ES256 and RSA-OAEP keys.
+func newTestIssuer(t *testing.T) *model.Issuer {
+	t.Helper()
+	ctx := context.Background()
+	id, err := randutil.UniqueId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := "https://op.example.com/" + id
+	iss := &model.Issuer{
+		Key: &oppb.CommonKey{
+			Id: id,
+		},
+		Meta: &oppb.IssuerMeta{
+			Issuer:                           issuer,
+			TokenEndpoint:                    issuer + "/token",
+			IntrospectionEndpoint:            issuer + "/introspect",
+			IdTokenSigningAlgValuesSupported: []string{"RS256", "ES256"},
+			RequestObjectEncryptionAlgValuesSupported: []string{"RSA-OAEP"},
+			RequestObjectEncryptionEncValuesSupported: []string{"A128GCM"},
+			DpopSigningAlgValuesSupported:             []string{"ES256", "RS256"},
+			SubjectTypesSupported:                     []string{"public", "pairwise"},
+		},
+		Secret: &oppb.IssuerSecret{
+			Password:     testIssuerPassword,
+			PairwiseSalt: "test-salt",
+		},
+		Attribute: &oppb.IssuerAttribute{},
+		Resources: &oppb.IssuerResources{
+			KeyMap: map[string]*oppb.KeyRing{},
+		},
+	}
+	for _, keyType := range []string{keyutil.RSA256, keyutil.ECDSA256, keyutil.RSAENC} {
+		key, err := keyutil.GeneratePrivateKey(iss.Key, keyType, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dataprovider.Create(ctx, key); err != nil {
+			t.Fatal(err)
+		}
+		iss.Resources.KeyMap[keyType] = &oppb.KeyRing{
+			CurrentKeyId: key.Key.Id,
+		}
+	}
+	if err := dataprovider.Create(ctx, iss); err != nil {
+		t.Fatal(err)
+	}
+	return iss
+}
+
+// newTestClient stores a client_secret_post client modified by opts.
+func newTestClient(t *testing.T, iss *model.Issuer, opts ...func(*model.Client)) *model.Client {
+	t.Helper()
+	id, err := randutil.UniqueId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := model.MakeDefaultClient(iss, id, "", time.Now())
+	client.Meta.RedirectUris = []string{"https://rp.example.com/callback"}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if err := dataprovider.Create(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// newTestAuthorized returns an authorization of the end-user for the client.
+func newTestAuthorized(t *testing.T, iss *model.Issuer, client *model.Client, scopes ...string) model.Authorized {
+	t.Helper()
+	requestId, err := randutil.UuidV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return model.Authorized{
+		AuthTime: time.Now(),
+		Request: model.RequestDetails{
+			Key: &oppb.CommonKey{
+				Id: requestId,
+			},
+			Client: client,
+			AuthParams: &oppb.AuthorizationParameters{
+				ClientId: client.Identity.ClientId,
+				Scopes:   scopes,
+				MaxAge:   -1,
+			},
+			Issuer: iss.Meta.Issuer,
+		},
+		SessionId: "session-" + requestId,
+		Subject:   "user-1",
+		Claims:    `{"sub":"user-1","name":"Test User"}`,
+	}
+}
+
+// newTestTokenRequest returns a token request from the client.
+// client_secret is sent only when the client authenticates with client_secret_post.
+func newTestTokenRequest(iss *model.Issuer, client *model.Client, form url.Values) *connect.Request[oppb.TokenRequest] {
+	form.Set("client_id", client.Identity.ClientId)
+	if client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodClientSecretPost {
+		form.Set("client_secret", client.Identity.ClientSecret)
+	}
+	req := connect.NewRequest(&oppb.TokenRequest{
+		ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+		Method:      "POST",
+		Form:        form.Encode(),
+	})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	return req
+}
@@ -170,12 +170,14 @@ func (p *Provider) PushedAuthorization(ctx context.Context,
 				iss.Meta.TokenEndpoint,
 				iss.Meta.Issuer,
 			},
-			BasicAuth: req.Msg.BasicAuth,
-			Client:    client,
-			Issuer:    iss,
-			Values:    query.Parse(req.Msg.Form),
+			BasicAuth:                         req.Msg.BasicAuth,
+			Client:                            client,
+			Issuer:                            iss,
+			Values:                            query.Parse(req.Msg.Form),
+			TlsClientCertificate:              req.Msg.TlsClientCertificate,
+			TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
 		}
-		if terr := checkClientAuthentication(ctx, cauth); terr != nil {
+		if terr := p.checkClientAuthentication(ctx, cauth); terr != nil {
 			return connect.NewResponse(&oppb.PushedAuthorizationResponse{
 				PushedAuthorizationResponseOneof: &oppb.PushedAuthorizationResponse_Fail{
 					Fail: &oppb.PushedAuthorizationFailResponse{
@@ -186,6 +188,13 @@ func (p *Provider) PushedAuthorization(ctx context.Context,
 			}), nil
 		}
 
+		// https://www.rfc-editor.org/rfc/rfc9449.html#section-10.1
+		// PAR での DPoP proof は任意。提示された場合は認可コードを proof の鍵にバインドする
+		dpopJkt, derr := checkDpopRequest(ctx, iss, nil, req.Msg.Dpop, req.Msg.Method, iss.Meta.PushedAuthorizationRequestEndpoint)
+		if derr != nil {
+			return dpopPushedAuthorizationFail(derr), nil
+		}
+
 		// Request object processing
 		if len(params.Request) > 0 {
 			if !iss.Meta.RequestParameterSupported {
@@ -269,6 +278,47 @@ func (p *Provider) PushedAuthorization(ctx context.Context,
 			}
 		}
 
+		// https://www.rfc-editor.org/rfc/rfc9396.html#section-5
+		if desc := checkAuthorizationDetails(iss, client, params.AuthorizationDetails); desc != "" {
+			return connect.NewResponse(&oppb.PushedAuthorizationResponse{
+				PushedAuthorizationResponseOneof: &oppb.PushedAuthorizationResponse_Fail{
+					Fail: &oppb.PushedAuthorizationFailResponse{
+						StatusCode: http.StatusBadRequest,
+						Error: &oppb.OauthError{
+							Error:            oauth.AuthorizationErrorInvalidAuthorizationDetails,
+							ErrorDescription: desc,
+						},
+					},
+				},
+			}), nil
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+		if desc := checkResources(client, params.Resources); desc != "" {
+			return connect.NewResponse(&oppb.PushedAuthorizationResponse{
+				PushedAuthorizationResponseOneof: &oppb.PushedAuthorizationResponse_Fail{
+					Fail: &oppb.PushedAuthorizationFailResponse{
+						StatusCode: http.StatusBadRequest,
+						Error: &oppb.OauthError{
+							Error:            oauth.AuthorizationErrorInvalidTarget,
+							ErrorDescription: desc,
+						},
+					},
+				},
+			}), nil
+		}
+
+		if dpopJkt != "" {
+			// dpop_jkt パラメータと DPoP proof の両方がある場合は一致しなければならない
+			if params.DpopJkt != "" && params.DpopJkt != dpopJkt {
+				return dpopPushedAuthorizationFail(&dpopError{
+					Error:       oauth.TokenErrorInvalidDpopProof,
+					Description: "dpop_jkt does not match DPoP proof",
+				}), nil
+			}
+			params.DpopJkt = dpopJkt
+		}
+
 		// redirect_uri check
 		if len(client.Meta.RedirectUris) > 0 {
 			u, err := url.Parse(params.RedirectUri)
@@ -99,7 +99,7 @@ func (b *RedirectBuilder) Build(ctx context.Context, now time.Time) (string, err
 		claims["iss"] = b.issuer.Meta.Issuer
 		claims["exp"] = now.Add(time.Duration(b.client.Attribute.JwtResponseLifetimeSeconds) * time.Second).Unix()
 
-		response, err := makeJwt(ctx, b.issuer, claims, b.client.Meta.AuthorizationSignedResponseAlg)
+		response, err := b.makeResponse(ctx, claims)
 		if err != nil {
 			return "", err
 		}
@@ -143,6 +143,25 @@ func (b *RedirectBuilder) Build(ctx context.Context, now time.Time) (string, err
 	}
 }
 
+// makeResponse signs and/or encrypts the JWT response document.
+// https://openid.net/specs/oauth-v2-jarm.html#name-signing-and-encryption
+// If both signing and encryption are requested, the response will be signed then encrypted,
+// with the result being a Nested JWT, as defined in [RFC7519].
+func (b *RedirectBuilder) makeResponse(ctx context.Context, claims jwt.MapClaims) (string, error) {
+	meta := b.client.Meta
+	if meta.AuthorizationSignedResponseAlg == "" && meta.AuthorizationEncryptedResponseAlg != "" {
+		return encryptClaims(ctx, b.client, claims, meta.AuthorizationEncryptedResponseAlg, meta.AuthorizationEncryptedResponseEnc)
+	}
+	response, err := makeJwt(ctx, b.issuer, claims, meta.AuthorizationSignedResponseAlg)
+	if err != nil {
+		return "", err
+	}
+	if meta.AuthorizationEncryptedResponseAlg == "" {
+		return response, nil
+	}
+	return encryptJwt(ctx, b.client, response, meta.AuthorizationEncryptedResponseAlg, meta.AuthorizationEncryptedResponseEnc)
+}
+
 func (b RedirectBuilder) IsFormPost() bool {
 	return b.responseMode == oauth.ResponseModeFormPost ||
 		b.responseMode == oauth.ResponseModeFormPostJwt
@@ -0,0 +1,210 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://openid.net/specs/oauth-v2-jarm.html#name-signing-and-encryption
+func TestJarmResponse(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	keys, jwks := newTestEncryptionKeys(t)
+
+	type testCase struct {
+		name         string
+		signedAlg    string
+		encryptedAlg string
+		encryptedEnc string
+	}
+
+	tests := []testCase{
+		{
+			name:      "Signed response",
+			signedAlg: "RS256",
+		},
+		{
+			name:         "Signed then encrypted response with RSA-OAEP",
+			signedAlg:    "ES256",
+			encryptedAlg: "RSA-OAEP",
+			encryptedEnc: "A128GCM",
+		},
+		{
+			name:         "Signed then encrypted response with ECDH-ES and the default enc",
+			signedAlg:    "RS256",
+			encryptedAlg: "ECDH-ES+A128KW",
+		},
+		{
+			name:         "Signed then encrypted response with the client secret",
+			signedAlg:    "RS256",
+			encryptedAlg: "A128KW",
+		},
+		{
+			name:         "Encrypted only response",
+			encryptedAlg: "RSA-OAEP",
+			encryptedEnc: "A256GCM",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.Jwks = jwks
+				c.Meta.AuthorizationSignedResponseAlg = tc.signedAlg
+				c.Meta.AuthorizationEncryptedResponseAlg = tc.encryptedAlg
+				c.Meta.AuthorizationEncryptedResponseEnc = tc.encryptedEnc
+			})
+			builder, err := newRedirectBuilder(iss, client, &oppb.AuthorizationParameters{
+				RedirectUri:  "https://rp.example.com/callback",
+				ResponseType: oauth.ResponseTypeCode,
+				ResponseMode: oauth.ResponseModeJwt,
+			}, map[string]string{
+				"code":  "code-1",
+				"state": "state-1",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := builder.Build(ctx, time.Now())
+			if !assert.NoError(t, err) {
+				return
+			}
+			// response_mode=jwt は response_type=code では query.jwt になる
+			u, err := url.Parse(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "rp.example.com", u.Host)
+			response := u.Query().Get("response")
+			assert.Empty(t, u.Query().Get("code"))
+
+			assert.Equal(t, tc.encryptedAlg != "", isJwe(response))
+			if tc.encryptedAlg != "" {
+				enc := tc.encryptedEnc
+				if enc == "" {
+					enc = defaultEncryptionEnc
+				}
+				var header jose.Header
+				response, header = decryptTestJwe(t, response, keys.decryptionKey(client, tc.encryptedAlg, enc))
+				assert.Equal(t, tc.encryptedAlg, header.Algorithm)
+				assert.Equal(t, enc, header.ExtraHeaders[jose.HeaderKey("enc")])
+				if tc.signedAlg != "" {
+					// 署名してから暗号化した Nested JWT
+					assert.Equal(t, "JWT", header.ExtraHeaders[jose.HeaderContentType])
+				} else {
+					assert.Nil(t, header.ExtraHeaders[jose.HeaderContentType])
+				}
+			}
+
+			claims := jwt.MapClaims{}
+			if tc.signedAlg != "" {
+				_, err := jwt.NewParser(jwt.WithValidMethods([]string{tc.signedAlg})).
+					ParseWithClaims(response, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+				if !assert.NoError(t, err) {
+					return
+				}
+			} else if err := json.Unmarshal([]byte(response), &claims); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, iss.Meta.Issuer, claims["iss"])
+			assert.Equal(t, client.Identity.ClientId, claims["aud"])
+			assert.NotNil(t, claims["exp"])
+			assert.Equal(t, "code-1", claims["code"])
+			assert.Equal(t, "state-1", claims["state"])
+		})
+	}
+}
+
+// https://openid.net/specs/oauth-v2-jarm.html#name-response-mode-fragmentjwt
+func TestJarmResponseMode(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss)
+
+	type testCase struct {
+		name         string
+		responseType string
+		responseMode string
+		assert       func(assert *assert.Assertions, out string)
+	}
+
+	tests := []testCase{
+		{
+			name:         "jwt is fragment.jwt for the implicit flow",
+			responseType: oauth.ResponseTypeIdToken,
+			responseMode: oauth.ResponseModeJwt,
+			assert: func(assert *assert.Assertions, out string) {
+				u, err := url.Parse(out)
+				if assert.NoError(err) {
+					values, err := url.ParseQuery(u.Fragment)
+					assert.NoError(err)
+					assert.NotEmpty(values.Get("response"))
+					assert.Empty(u.RawQuery)
+				}
+			},
+		},
+		{
+			name:         "form_post.jwt posts the response",
+			responseType: oauth.ResponseTypeCode,
+			responseMode: oauth.ResponseModeFormPostJwt,
+			assert: func(assert *assert.Assertions, out string) {
+				assert.Contains(out, `action="https://rp.example.com/callback"`)
+				assert.Contains(out, `name="response"`)
+				assert.NotContains(out, `name="code"`)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			builder, err := newRedirectBuilder(iss, client, &oppb.AuthorizationParameters{
+				RedirectUri:  "https://rp.example.com/callback",
+				ResponseType: tc.responseType,
+				ResponseMode: tc.responseMode,
+			}, map[string]string{
+				"code":  "code-1",
+				"state": "state-1",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := builder.Build(ctx, time.Now())
+			if assert.NoError(t, err) {
+				tc.assert(assert.New(t), out)
+			}
+		})
+	}
+}
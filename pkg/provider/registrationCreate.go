@@ -160,6 +160,82 @@ func (p *Provider) RegistrationCreate(ctx context.Context,
 			}
 		}
 
+		// check subject_type
+		// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+		if req.Msg.SubjectType == subjectTypePairwise {
+			if err := checkPairwiseSalt(iss); err != nil {
+				return connect.NewResponse(&oppb.RegistrationCreateResponse{
+					RegistrationCreateResponseOneof: &oppb.RegistrationCreateResponse_Fail{
+						Fail: &oppb.RegistrationFailResponse{
+							StatusCode: http.StatusBadRequest,
+							Error: &oppb.RegistrationError{
+								Error:            "invalid_client_metadata",
+								ErrorDescription: "subject_type pairwise:" + err.Error(),
+							},
+						},
+					},
+				}), nil
+			}
+			if _, err := model.SectorIdentifier(&oppb.ClientMeta{
+				RedirectUris:        req.Msg.RedirectUris,
+				SectorIdentifierUri: req.Msg.SectorIdentifierUri,
+			}); err != nil {
+				return connect.NewResponse(&oppb.RegistrationCreateResponse{
+					RegistrationCreateResponseOneof: &oppb.RegistrationCreateResponse_Fail{
+						Fail: &oppb.RegistrationFailResponse{
+							StatusCode: http.StatusBadRequest,
+							Error: &oppb.RegistrationError{
+								Error:            "invalid_client_metadata",
+								ErrorDescription: "subject_type pairwise:" + err.Error(),
+							},
+						},
+					},
+				}), nil
+			}
+		}
+		// check encryption
+		for _, v := range []struct {
+			name       string
+			alg, enc   string
+			algs, encs []string
+		}{
+			{"id_token_encrypted_response", req.Msg.IdTokenEncryptedResponseAlg, req.Msg.IdTokenEncryptedResponseEnc,
+				iss.Meta.IdTokenEncryptionAlgValuesSupported, iss.Meta.IdTokenEncryptionEncValuesSupported},
+			{"userinfo_encrypted_response", req.Msg.UserinfoEncryptedResponseAlg, req.Msg.UserinfoEncryptedResponseEnc,
+				iss.Meta.UserinfoEncryptionAlgValuesSupported, iss.Meta.UserinfoEncryptionEncValuesSupported},
+			{"request_object_encryption", req.Msg.RequestObjectEncryptionAlg, req.Msg.RequestObjectEncryptionEnc,
+				iss.Meta.RequestObjectEncryptionAlgValuesSupported, iss.Meta.RequestObjectEncryptionEncValuesSupported},
+			{"authorization_encrypted_response", req.Msg.AuthorizationEncryptedResponseAlg, req.Msg.AuthorizationEncryptedResponseEnc,
+				iss.Meta.AuthorizationEncryptionAlgValuesSupported, iss.Meta.AuthorizationEncryptionEncValuesSupported},
+		} {
+			if err := checkEncryptionMeta(v.name, v.alg, v.enc, v.algs, v.encs); err != nil {
+				return connect.NewResponse(&oppb.RegistrationCreateResponse{
+					RegistrationCreateResponseOneof: &oppb.RegistrationCreateResponse_Fail{
+						Fail: &oppb.RegistrationFailResponse{
+							StatusCode: http.StatusBadRequest,
+							Error: &oppb.RegistrationError{
+								Error:            "invalid_client_metadata",
+								ErrorDescription: err.Error(),
+							},
+						},
+					},
+				}), nil
+			}
+		}
+		if len(req.Msg.SubjectType) > 0 && !slices.Contains(iss.Meta.SubjectTypesSupported, req.Msg.SubjectType) {
+			return connect.NewResponse(&oppb.RegistrationCreateResponse{
+				RegistrationCreateResponseOneof: &oppb.RegistrationCreateResponse_Fail{
+					Fail: &oppb.RegistrationFailResponse{
+						StatusCode: http.StatusBadRequest,
+						Error: &oppb.RegistrationError{
+							Error:            "invalid_client_metadata",
+							ErrorDescription: "subject_type not supported:" + req.Msg.SubjectType,
+						},
+					},
+				},
+			}), nil
+		}
+
 		// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
 		// The default, if omitted, is web.
 		if req.Msg.ApplicationType == "" {
@@ -171,6 +247,26 @@ func (p *Provider) RegistrationCreate(ctx context.Context,
 			req.Msg.IdTokenSignedResponseAlg = "RS256"
 		}
 		// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+		// If id_token_encrypted_response_alg is specified, the default id_token_encrypted_response_enc value is A128CBC-HS256.
+		if req.Msg.IdTokenEncryptedResponseAlg != "" && req.Msg.IdTokenEncryptedResponseEnc == "" {
+			req.Msg.IdTokenEncryptedResponseEnc = defaultEncryptionEnc
+		}
+		// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+		// If userinfo_encrypted_response_alg is specified, the default userinfo_encrypted_response_enc value is A128CBC-HS256.
+		if req.Msg.UserinfoEncryptedResponseAlg != "" && req.Msg.UserinfoEncryptedResponseEnc == "" {
+			req.Msg.UserinfoEncryptedResponseEnc = defaultEncryptionEnc
+		}
+		// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+		// If request_object_encryption_alg is specified, the default request_object_encryption_enc value is A128CBC-HS256.
+		if req.Msg.RequestObjectEncryptionAlg != "" && req.Msg.RequestObjectEncryptionEnc == "" {
+			req.Msg.RequestObjectEncryptionEnc = defaultEncryptionEnc
+		}
+		// https://openid.net/specs/oauth-v2-jarm.html#name-client-metadata
+		// If authorization_encrypted_response_alg is specified, the default for this value is A128CBC-HS256.
+		if req.Msg.AuthorizationEncryptedResponseAlg != "" && req.Msg.AuthorizationEncryptedResponseEnc == "" {
+			req.Msg.AuthorizationEncryptedResponseEnc = defaultEncryptionEnc
+		}
+		// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
 		// If omitted, the default is that the Client will use only the code Response Type.
 		if len(req.Msg.ResponseTypes) == 0 {
 			req.Msg.ResponseTypes = []string{"code"}
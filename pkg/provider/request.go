@@ -57,6 +57,8 @@ func (p *Provider) Request(ctx context.Context,
 		return connect.NewResponse(&oppb.RequestResponse{
 			Client:     r.Details.Client.Meta,
 			AuthParams: r.Details.AuthParams,
+			Subject:    r.Details.ConsentSubject,
+			SessionId:  r.Details.ConsentSessionId,
 		}), nil
 	}
 }
@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"net/url"
+	"slices"
+
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// checkResources validates the resource parameters and returns the error description.
+// https://www.rfc-editor.org/rfc/rfc8707.html#section-2
+func checkResources(client *model.Client, resources []string) string {
+	for _, resource := range resources {
+		// Its value MUST be an absolute URI, as specified by Section 4.3 of [RFC3986].
+		// The URI MUST NOT include a fragment component.
+		u, err := url.Parse(resource)
+		if err != nil || !u.IsAbs() || u.Fragment != "" || u.RawFragment != "" {
+			return "resource must be an absolute URI without a fragment:" + resource
+		}
+		if !slices.Contains(client.Attribute.GetAllowedResources(), resource) {
+			return "resource not allowed:" + resource
+		}
+	}
+	return ""
+}
+
+// accessTokenAudience resolves the audience of the access token from the resource parameters of the token request.
+// If resource is omitted, all the resources granted by the authorization are used.
+// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+func accessTokenAudience(authorized model.Authorized, resources []string) ([]string, string) {
+	if len(resources) == 0 {
+		return authorized.Resources, ""
+	}
+	if desc := checkResources(authorized.Request.Client, resources); desc != "" {
+		return nil, desc
+	}
+	// リフレッシュトークン等では認可時のリソースの範囲内でのみダウンスコープできる
+	if len(authorized.Resources) > 0 {
+		for _, resource := range resources {
+			if !slices.Contains(authorized.Resources, resource) {
+				return nil, "resource not granted:" + resource
+			}
+		}
+	}
+	return resources, ""
+}
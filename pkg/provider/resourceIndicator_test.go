@@ -0,0 +1,306 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testResourceA = "https://api.example.com/a"
+	testResourceB = "https://api.example.com/b"
+)
+
+// resourceClient allows the resources A and B and the client_credentials grant.
+func resourceClient(c *model.Client) {
+	c.Attribute.AllowedResources = []string{testResourceA, testResourceB}
+	c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeClientCredentials)
+}
+
+// storedAudience returns the audience stored with the access token.
+func storedAudience(t *testing.T, client *model.Client, token string) []string {
+	t.Helper()
+	access := &model.TokenIdentifier{
+		Details: model.TokenIdentifierDetails{
+			Identifier: token,
+			Authorized: model.Authorized{
+				Request: model.RequestDetails{
+					Client: &model.Client{Issuer: client.Issuer},
+				},
+			},
+		},
+	}
+	if err := dataprovider.Get(context.Background(), access); err != nil {
+		t.Fatal(err)
+	}
+	return access.Details.Audience
+}
+
+func TestCheckResources(t *testing.T) {
+	client := &model.Client{
+		Meta:      &oppb.ClientMeta{},
+		Attribute: &oppb.ClientAttribute{},
+	}
+	resourceClient(client)
+
+	type testCase struct {
+		name      string
+		resources []string
+		wantError bool
+	}
+
+	tests := []testCase{
+		{name: "Omitted", resources: nil},
+		{name: "Allowed resource", resources: []string{testResourceA}},
+		{name: "Multiple allowed resources", resources: []string{testResourceA, testResourceB}},
+		{name: "Resource not allowed", resources: []string{"https://other.example.com/"}, wantError: true},
+		{name: "One of the resources not allowed", resources: []string{testResourceA, "https://other.example.com/"}, wantError: true},
+		{name: "Relative URI", resources: []string{"/a"}, wantError: true},
+		{name: "Fragment", resources: []string{testResourceA + "#section"}, wantError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			desc := checkResources(client, tc.resources)
+			if tc.wantError {
+				assert.NotEmpty(t, desc)
+			} else {
+				assert.Empty(t, desc)
+			}
+		})
+	}
+}
+
+func TestAccessTokenAudience(t *testing.T) {
+	client := &model.Client{
+		Meta:      &oppb.ClientMeta{},
+		Attribute: &oppb.ClientAttribute{},
+	}
+	resourceClient(client)
+
+	type testCase struct {
+		name      string
+		granted   []string
+		resources []string
+		expected  []string
+		wantError bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "Omitted resource uses the granted resources",
+			granted:  []string{testResourceA, testResourceB},
+			expected: []string{testResourceA, testResourceB},
+		},
+		{
+			name:      "Downscoped to one of the granted resources",
+			granted:   []string{testResourceA, testResourceB},
+			resources: []string{testResourceB},
+			expected:  []string{testResourceB},
+		},
+		{
+			name:      "Resource not granted",
+			granted:   []string{testResourceA},
+			resources: []string{testResourceB},
+			wantError: true,
+		},
+		{
+			name:      "Resource requested only at the token endpoint",
+			resources: []string{testResourceA},
+			expected:  []string{testResourceA},
+		},
+		{
+			name:      "Resource not allowed for the client",
+			resources: []string{"https://other.example.com/"},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := model.Authorized{
+				Request: model.RequestDetails{
+					Client: client,
+				},
+				Resources: tc.granted,
+			}
+			audience, desc := accessTokenAudience(authorized, tc.resources)
+			if tc.wantError {
+				assert.NotEmpty(t, desc)
+				return
+			}
+			assert.Empty(t, desc)
+			assert.Equal(t, tc.expected, audience)
+		})
+	}
+}
+
+func TestPushedAuthorizationResource(t *testing.T) {
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss, resourceClient)
+
+	type testCase struct {
+		name      string
+		resources []string
+		wantError string
+	}
+
+	tests := []testCase{
+		{
+			name:      "Allowed resources",
+			resources: []string{testResourceA, testResourceB},
+		},
+		{
+			name:      "Resource not allowed",
+			resources: []string{"https://other.example.com/"},
+			wantError: oauth.AuthorizationErrorInvalidTarget,
+		},
+		{
+			name:      "Resource with a fragment",
+			resources: []string{testResourceA + "#section"},
+			wantError: oauth.AuthorizationErrorInvalidTarget,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			code, params := pushTestAuthorizationRequest(t, iss, client, url.Values{
+				"resource": tc.resources,
+			})
+			assert.Equal(t, tc.wantError, code)
+			if tc.wantError == "" && assert.NotNil(t, params) {
+				assert.Equal(t, tc.resources, params.Resources)
+			}
+		})
+	}
+}
+
+func TestTokenResource(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss, resourceClient)
+
+	type testCase struct {
+		name      string
+		form      func(t *testing.T) url.Values
+		wantError string
+		expected  []string
+	}
+
+	// 認可時にリソース A と B を付与したリフレッシュトークン
+	refreshToken := func(t *testing.T) string {
+		authorized := newTestAuthorized(t, iss, client, "openid", "offline_access")
+		authorized.Resources = []string{testResourceA, testResourceB}
+		token, err := makeRefreshTokenIdentifier(authorized, time.Now(), time.Now(), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dataprovider.Create(ctx, token); err != nil {
+			t.Fatal(err)
+		}
+		return token.Details.Identifier
+	}
+
+	tests := []testCase{
+		{
+			name: "client_credentials with resource",
+			form: func(t *testing.T) url.Values {
+				return url.Values{
+					"grant_type": {oauth.GrantTypeClientCredentials},
+					"resource":   {testResourceA},
+				}
+			},
+			expected: []string{testResourceA},
+		},
+		{
+			name: "client_credentials with a resource not allowed",
+			form: func(t *testing.T) url.Values {
+				return url.Values{
+					"grant_type": {oauth.GrantTypeClientCredentials},
+					"resource":   {"https://other.example.com/"},
+				}
+			},
+			wantError: oauth.TokenErrorInvalidTarget,
+		},
+		{
+			name: "refresh_token without resource keeps the granted resources",
+			form: func(t *testing.T) url.Values {
+				return url.Values{
+					"grant_type":    {oauth.GrantTypeRefreshToken},
+					"refresh_token": {refreshToken(t)},
+				}
+			},
+			expected: []string{testResourceA, testResourceB},
+		},
+		{
+			name: "refresh_token downscoped to a granted resource",
+			form: func(t *testing.T) url.Values {
+				return url.Values{
+					"grant_type":    {oauth.GrantTypeRefreshToken},
+					"refresh_token": {refreshToken(t)},
+					"resource":      {testResourceB},
+				}
+			},
+			expected: []string{testResourceB},
+		},
+		{
+			name: "refresh_token with a resource not allowed",
+			form: func(t *testing.T) url.Values {
+				return url.Values{
+					"grant_type":    {oauth.GrantTypeRefreshToken},
+					"refresh_token": {refreshToken(t)},
+					"resource":      {"https://other.example.com/"},
+				}
+			},
+			wantError: oauth.TokenErrorInvalidTarget,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, tc.form(t)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantError != "" {
+				if fail := res.Msg.GetFail(); assert.NotNil(t, fail) {
+					assert.Equal(t, tc.wantError, fail.Error.Error)
+				}
+				return
+			}
+			success := res.Msg.GetSuccess()
+			if assert.NotNil(t, success) {
+				assert.Equal(t, tc.expected, storedAudience(t, client, success.AccessToken))
+			}
+		})
+	}
+}
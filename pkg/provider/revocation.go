@@ -0,0 +1,224 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// https://www.rfc-editor.org/rfc/rfc7009.html
+func (p *Provider) Revocation(ctx context.Context,
+	req *connect.Request[oppb.RevocationRequest]) (*connect.Response[oppb.RevocationResponse], error) {
+	if iss, err := auth.GetIssuer(ctx, req); err != nil {
+		return nil, err
+	} else {
+		// The client requests the revocation of a particular token by making an
+		// HTTP POST request to the token revocation endpoint URL.
+		if req.Msg.Method != http.MethodPost {
+			return revocationFail(http.StatusMethodNotAllowed, oauth.TokenErrorInvalidRequest, "Method not allowed:"+req.Msg.Method), nil
+		}
+		if ct := req.Msg.ContentType; !strings.HasPrefix(ct, httphelper.MimeTypeWwwFormUnlencoded) {
+			return revocationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "Unsupported content type:"+ct), nil
+		}
+
+		vals := query.Parse(req.Msg.Form)
+		token := vals.Get("token")
+		if token == "" {
+			return revocationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "missing token parameter"), nil
+		}
+
+		// Endpoint authentication check
+		client, terr, err := identifyClient(ctx, iss, req.Msg.BasicAuth, vals)
+		if err != nil {
+			return nil, err
+		}
+		if terr == nil {
+			terr = p.checkClientAuthentication(ctx, &clientAuthentication{
+				AllowAudience: []string{
+					iss.Meta.RevocationEndpoint,
+					iss.Meta.TokenEndpoint,
+					iss.Meta.Issuer,
+				},
+				BasicAuth:                         req.Msg.BasicAuth,
+				Client:                            client,
+				Issuer:                            iss,
+				Values:                            vals,
+				TlsClientCertificate:              req.Msg.TlsClientCertificate,
+				TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
+			})
+		}
+		if terr != nil {
+			return connect.NewResponse(&oppb.RevocationResponse{
+				RevocationResponseOneof: &oppb.RevocationResponse_Fail{
+					Fail: &oppb.RevocationFailResponse{
+						StatusCode: terr.StatusCode,
+						Error:      terr.Error,
+					},
+				},
+			}), nil
+		}
+
+		identifier := &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Identifier: accessTokenIdentifier(ctx, iss, token),
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Client: &model.Client{
+							Issuer: iss.Key,
+						},
+					},
+				},
+			},
+		}
+		if err := dataprovider.Get(ctx, identifier); err != nil {
+			// https://www.rfc-editor.org/rfc/rfc7009.html#section-2.2
+			// Note: invalid tokens do not cause an error response since the client
+			// cannot handle such an error in a reasonable way.
+			return revocationSuccess(), nil
+		}
+
+		// The authorization server first validates the client credentials and
+		// then verifies whether the token was issued to the client making the
+		// revocation request.
+		if identifier.Details.Authorized.Request.Client.Identity.ClientId != client.Identity.ClientId {
+			return revocationFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "token was not issued to the client"), nil
+		}
+
+		switch identifier.Details.Type {
+		case model.TokenTypeRefreshToken:
+			// If the particular token is a refresh token and the authorization
+			// server supports the revocation of access tokens, then the
+			// authorization server SHOULD also invalidate all access tokens based
+			// on the same authorization grant.
+			if err := p.callbacks.DeleteTokensWithRequetId(ctx, iss.Key.Id, identifier.RequestId); err != nil {
+				log.Printf("[BACKEND_ERROR] DeleteTokensWithRequetId:%v", err)
+				return nil, err
+			}
+			if err := dataprovider.Delete(ctx, identifier); err != nil && status.Code(err) != codes.NotFound {
+				return nil, err
+			}
+		case model.TokenTypeAccessToken:
+			if err := dataprovider.Delete(ctx, identifier); err != nil && status.Code(err) != codes.NotFound {
+				return nil, err
+			}
+		default:
+			return revocationFail(http.StatusBadRequest, oauth.TokenErrorUnsupportedTokenType,
+				fmt.Sprintf("unsupported token type:%s", identifier.Details.Type)), nil
+		}
+		return revocationSuccess(), nil
+	}
+}
+
+// identifyClient identifies the client which is authenticating at an endpoint
+// that is not bound to an authorization code or token (e.g. revocation).
+// The client_id is taken from the basic authorization, the client_id
+// parameter or the issuer of the client_assertion.
+func identifyClient(ctx context.Context, iss *model.Issuer, basicAuth *oppb.BasicAuth, vals *query.Result) (*model.Client, *oppb.TokenFailResponse, error) {
+	clientId := vals.Get("client_id")
+	if basicAuth != nil && basicAuth.Username != "" {
+		clientId = basicAuth.Username
+	} else if clientId == "" {
+		if clientAssertion := vals.Get("client_assertion"); clientAssertion != "" {
+			rc := &jwt.RegisteredClaims{}
+			if _, _, err := jwt.NewParser().ParseUnverified(clientAssertion, rc); err != nil {
+				return nil, &oppb.TokenFailResponse{
+					StatusCode: http.StatusBadRequest,
+					Error: &oppb.OauthError{
+						Error:            oauth.TokenErrorInvalidRequest,
+						ErrorDescription: "Could not parse client_assertion",
+					},
+				}, nil
+			}
+			clientId = rc.Issuer
+		}
+	}
+	if clientId == "" {
+		return nil, &oppb.TokenFailResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error: &oppb.OauthError{
+				Error:            oauth.TokenErrorInvalidClient,
+				ErrorDescription: "client_id not found",
+			},
+		}, nil
+	}
+
+	client := &model.Client{
+		Issuer: iss.Key,
+		Identity: &oppb.ClientIdentity{
+			ClientId: clientId,
+		},
+	}
+	if err := dataprovider.Get(ctx, client); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, &oppb.TokenFailResponse{
+				StatusCode: http.StatusUnauthorized,
+				Error: &oppb.OauthError{
+					Error:            oauth.TokenErrorInvalidClient,
+					ErrorDescription: "Client not found:" + clientId,
+				},
+			}, nil
+		}
+		return nil, nil, err
+	}
+	return client, nil, nil
+}
+
+func revocationSuccess() *connect.Response[oppb.RevocationResponse] {
+	return connect.NewResponse(&oppb.RevocationResponse{
+		RevocationResponseOneof: &oppb.RevocationResponse_Success{
+			Success: &oppb.RevocationSuccessResponse{
+				StatusCode: http.StatusOK,
+			},
+		},
+	})
+}
+
+func revocationFail(statusCode int32, errorCode, description string) *connect.Response[oppb.RevocationResponse] {
+	return connect.NewResponse(&oppb.RevocationResponse{
+		RevocationResponseOneof: &oppb.RevocationResponse_Fail{
+			Fail: &oppb.RevocationFailResponse{
+				StatusCode: statusCode,
+				Error: &oppb.OauthError{
+					Error:            errorCode,
+					ErrorDescription: description,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,173 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRevocationRequest returns a revocation request of the token from the client.
+func newTestRevocationRequest(iss *model.Issuer, client *model.Client, token string) *connect.Request[oppb.RevocationRequest] {
+	form := url.Values{
+		"token":         {token},
+		"client_id":     {client.Identity.ClientId},
+		"client_secret": {client.Identity.ClientSecret},
+	}
+	req := connect.NewRequest(&oppb.RevocationRequest{
+		ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+		Method:      http.MethodPost,
+		Form:        form.Encode(),
+	})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	return req
+}
+
+func TestRevocation(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	exists := func(identifier string) bool {
+		return dataprovider.Get(ctx, &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Identifier: identifier,
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Client: &model.Client{Issuer: iss.Key},
+					},
+				},
+			},
+		}) == nil
+	}
+
+	// tokens は refresh_token とそれを使って発行したアクセストークン
+	type tokens struct {
+		refreshToken string
+		accessToken  string
+	}
+
+	type testCase struct {
+		name   string
+		token  func(tokens tokens) string
+		other  bool
+		assert func(assert *assert.Assertions, res *oppb.RevocationResponse, tokens tokens)
+	}
+
+	tests := []testCase{
+		{
+			name:  "Revoking a refresh token revokes its token family",
+			token: func(tokens tokens) string { return tokens.refreshToken },
+			assert: func(assert *assert.Assertions, res *oppb.RevocationResponse, tokens tokens) {
+				if assert.NotNil(res.GetSuccess()) {
+					assert.Equal(int32(http.StatusOK), res.GetSuccess().StatusCode)
+				}
+				assert.False(exists(tokens.refreshToken))
+				assert.False(exists(tokens.accessToken))
+			},
+		},
+		{
+			name:  "Revoking an access token keeps the refresh token",
+			token: func(tokens tokens) string { return tokens.accessToken },
+			assert: func(assert *assert.Assertions, res *oppb.RevocationResponse, tokens tokens) {
+				assert.NotNil(res.GetSuccess())
+				assert.True(exists(tokens.refreshToken))
+				assert.False(exists(tokens.accessToken))
+			},
+		},
+		{
+			name:  "Unknown token is reported as revoked",
+			token: func(tokens tokens) string { return "unknown-token" },
+			assert: func(assert *assert.Assertions, res *oppb.RevocationResponse, tokens tokens) {
+				assert.NotNil(res.GetSuccess())
+				assert.True(exists(tokens.refreshToken))
+				assert.True(exists(tokens.accessToken))
+			},
+		},
+		{
+			name:  "Token issued to another client is not revoked",
+			token: func(tokens tokens) string { return tokens.refreshToken },
+			other: true,
+			assert: func(assert *assert.Assertions, res *oppb.RevocationResponse, tokens tokens) {
+				if assert.NotNil(res.GetFail()) {
+					assert.Equal(int32(http.StatusBadRequest), res.GetFail().StatusCode)
+					assert.Equal(oauth.TokenErrorInvalidRequest, res.GetFail().Error.Error)
+				}
+				assert.True(exists(tokens.refreshToken))
+				assert.True(exists(tokens.accessToken))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss)
+			authorized := newTestAuthorized(t, iss, client, "openid", "offline_access")
+			now := time.Now()
+			refreshToken, err := makeRefreshTokenIdentifier(authorized, now, now, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			refreshToken.ExpireAt = refreshTokenExpireAt(client.Attribute, now, now, now)
+			if err := dataprovider.Create(ctx, refreshToken); err != nil {
+				t.Fatal(err)
+			}
+			// 同じ認可から発行されたアクセストークンを refresh_token grant で取得する
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {refreshToken.Details.Identifier},
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Msg.GetSuccess() == nil {
+				t.Fatal(res.Msg.GetFail())
+			}
+			issued := tokens{
+				refreshToken: refreshToken.Details.Identifier,
+				accessToken:  res.Msg.GetSuccess().AccessToken,
+			}
+
+			requester := client
+			if tc.other {
+				requester = newTestClient(t, iss)
+			}
+			revoked, err := testProvider.Revocation(ctx, newTestRevocationRequest(iss, requester, tc.token(issued)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.assert(assert.New(t), revoked.Msg, issued)
+		})
+	}
+}
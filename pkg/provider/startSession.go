@@ -27,6 +27,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"log"
+	"net/url"
 	"time"
 
 	"connectrpc.com/connect"
@@ -38,6 +39,11 @@ import (
 	"github.com/Eigen438/opgo/pkg/model"
 )
 
+const (
+	browserStateCookiePrefix = "opbs_"
+	sessionStateSaltLength   = 16
+)
+
 func (p *Provider) StartSession(ctx context.Context,
 	req *connect.Request[oppb.StartSessionRequest]) (*connect.Response[oppb.StartSessionResponse], error) {
 	if iss, err := auth.GetIssuer(ctx, req); err != nil {
@@ -86,14 +92,37 @@ func (p *Provider) StartSession(ctx context.Context,
 		}
 
 		return connect.NewResponse(&oppb.StartSessionResponse{
-			Name:  ses.Details.SessionGroup.Key.Id,
-			Value: ses.Details.Key.Id,
+			Name:              ses.Details.SessionGroup.Key.Id,
+			Value:             ses.Details.Key.Id,
+			BrowserStateName:  getBrowserStateName(ses.Details.SessionGroup.Key.Id),
+			BrowserStateValue: getBrowserState(ses.Details.Key.Id),
 		}), nil
 	}
 }
 
-func getSessionState(issuerId, clientId, sessionId string) string {
-	// accessToken値が入力されたらat_hashを作成する
-	hash := sha256.Sum256([]byte(sessionId + clientId + issuerId))
+// https://openid.net/specs/openid-connect-session-1_0.html#CreatingUpdatingSessions
+// session_state = hash(client_id + " " + origin + " " + browser_state + " " + salt) + "." + salt
+func getSessionState(clientId, redirectUri, sessionId string) (string, error) {
+	u, err := url.Parse(redirectUri)
+	if err != nil {
+		return "", err
+	}
+	origin := u.Scheme + "://" + u.Host
+	salt, err := randutil.String(sessionStateSaltLength, randutil.RunesNumAndAlpha)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(clientId + " " + origin + " " + getBrowserState(sessionId) + " " + salt))
+	return base64.RawURLEncoding.EncodeToString(hash[:]) + "." + salt, nil
+}
+
+// getBrowserState returns the JS-readable value that represents the session,
+// without exposing the session id itself.
+func getBrowserState(sessionId string) string {
+	hash := sha256.Sum256([]byte(sessionId))
 	return base64.RawURLEncoding.EncodeToString(hash[:])
 }
+
+func getBrowserStateName(sessionGroupId string) string {
+	return browserStateCookiePrefix + sessionGroupId
+}
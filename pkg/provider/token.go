@@ -25,13 +25,14 @@ package provider
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"slices"
 	"strings"
-	"sync"
 	"time"
 
 	"connectrpc.com/connect"
@@ -46,6 +47,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+var errRefreshTokenReused = errors.New("refresh token reused")
+
 type tokenRequest struct {
 	ClientAssertion     string
 	ClientAssertionType string
@@ -56,6 +59,7 @@ type tokenRequest struct {
 	GrantType           string
 	RedirectUri         string
 	RefreshToken        string
+	Resources           []string
 	Scope               string
 }
 
@@ -87,6 +91,7 @@ func (p *Provider) Token(ctx context.Context,
 			GrantType:    vals.Get("grant_type"),
 			RedirectUri:  vals.Get("redirect_uri"),
 			RefreshToken: vals.Get("refresh_token"),
+			Resources:    vals.GetAll("resource"),
 			Scope:        vals.Get("scope"),
 		}
 
@@ -126,12 +131,14 @@ func (p *Provider) Token(ctx context.Context,
 					iss.Meta.TokenEndpoint,
 					iss.Meta.Issuer,
 				},
-				BasicAuth: req.Msg.BasicAuth,
-				Client:    authCode.Details.Authorized.Request.Client,
-				Issuer:    iss,
-				Values:    vals,
+				BasicAuth:                         req.Msg.BasicAuth,
+				Client:                            authCode.Details.Authorized.Request.Client,
+				Issuer:                            iss,
+				Values:                            vals,
+				TlsClientCertificate:              req.Msg.TlsClientCertificate,
+				TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
 			}
-			if terr := checkClientAuthentication(ctx, params); terr != nil {
+			if terr := p.checkClientAuthentication(ctx, params); terr != nil {
 				return connect.NewResponse(&oppb.TokenResponse{
 					TokenResponseOneof: &oppb.TokenResponse_Fail{
 						Fail: terr,
@@ -139,12 +146,23 @@ func (p *Provider) Token(ctx context.Context,
 				}), nil
 			}
 
+			// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+			dpopJkt, derr := checkDpopRequest(ctx, iss, params.Client, req.Msg.Dpop, req.Msg.Method, iss.Meta.TokenEndpoint)
+			if derr != nil {
+				return dpopTokenFail(derr), nil
+			}
+			if res := checkCertificateBoundRequest(params.Client, req.Msg.TlsClientCertificate); res != nil {
+				return res, nil
+			}
+
 			if params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_1_0 ||
-				params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0 {
+				(params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0 && dpopJkt == "") {
 				// FAPIの場合はさらにClientCertificateをチェックする
 				// checkClientAuthenticationの中では実施しない。
 				// pushedAuthenticationRequestでもcheckClientAuthenticationを使用しているため。
-				if !slices.Contains(authCode.Details.Authorized.Request.Client.Extensions.TlsClientCertificates, req.Msg.TlsClientCertificate) {
+				// FAPI 2.0 では mTLS の代わりに DPoP による送信者制約を使用できる
+				thumbprint, err := certificateThumbprint(req.Msg.TlsClientCertificate)
+				if err != nil || !slices.Contains(authCode.Details.Authorized.Request.Client.Extensions.TlsClientCertificates, thumbprint) {
 					return connect.NewResponse(&oppb.TokenResponse{
 						TokenResponseOneof: &oppb.TokenResponse_Fail{
 							Fail: &oppb.TokenFailResponse{
@@ -159,6 +177,12 @@ func (p *Provider) Token(ctx context.Context,
 				}
 			}
 
+			// https://www.rfc-editor.org/rfc/rfc9449.html#section-10
+			// 認可リクエストで dpop_jkt が指定された場合は DPoP proof の鍵と照合する
+			if jkt := authCode.Details.Authorized.Request.AuthParams.DpopJkt; jkt != "" && jkt != dpopJkt {
+				return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidDpopProof, "DPoP key does not match dpop_jkt"), nil
+			}
+
 			// 認可コード検証①：期限切れ
 			if time.Now().After(authCode.ExpireAt) {
 				return connect.NewResponse(&oppb.TokenResponse{
@@ -245,7 +269,13 @@ func (p *Provider) Token(ctx context.Context,
 				}
 			}
 
-			success := &oppb.TokenSuccessResponse{}
+			// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+			audience, desc := accessTokenAudience(authCode.Details.Authorized, tr.Resources)
+			if desc != "" {
+				return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidTarget, desc), nil
+			}
+
+			var success *oppb.TokenSuccessResponse
 			err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
 				// トランザクションのためauthCodeを再取得
 				if err := dataprovider.Get(ctx, authCode); err != nil {
@@ -257,90 +287,14 @@ func (p *Provider) Token(ctx context.Context,
 					return fmt.Errorf("authCode was used")
 				}
 
-				// トランザクション内の並行処理
-				var goError error
-				wg := sync.WaitGroup{}
-
-				// アクセストークン生成
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-					access, err := makeAccessTokenIdentifier(authCode.Details.Authorized, time.Now(), req.Msg.TlsClientCertificate)
-					if err != nil {
-						log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
-						goError = err
-						return
-					}
-					if err := dataprovider.Create(ctx, access); err != nil {
-						log.Printf("create access token error:%s", err.Error())
-						goError = err
-						return
-					}
-					success.AccessToken = access.Details.Identifier
-					success.ExpiresIn = authCode.Details.Authorized.Request.Client.Attribute.AccessTokenLifetimeSeconds
-					success.TokenType = "Bearer"
-				}()
-
-				// リフレッシュトークン生成
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-					if slices.Contains(authCode.Details.Authorized.Request.AuthParams.Scopes, "offline_access") {
-						refresh, err := makeRefreshTokenIdentifier(authCode.Details.Authorized, time.Now())
-						if err != nil {
-							log.Printf("makeRefreshTokenIdentifier error:%s", err.Error())
-							goError = err
-							return
-						}
-						if err := dataprovider.Create(ctx, refresh); err != nil {
-							log.Printf("create refresh token error:%s", err.Error())
-							goError = err
-							return
-						}
-						success.RefreshToken = refresh.Details.Identifier
-					}
-				}()
-
-				// IDトークン生成
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-					if slices.Contains(authCode.Details.Authorized.Request.AuthParams.Scopes, "openid") {
-						id, err := makeIdTokenIdentifier(authCode.Details.Authorized, time.Now())
-						if err != nil {
-							log.Printf("makeIdTokenIdentifier error:%s", err.Error())
-							goError = err
-							return
-						}
-
-						// IDトークン署名
-						wg.Add(1)
-						go func() {
-							defer wg.Done()
-							claims, err := makeIdTokenClaims(iss, id, time.Now(), "", "", "")
-							if err != nil {
-								goError = err
-								return
-							}
-							success.IdToken, err = makeJwt(ctx, iss, claims, id.Details.Authorized.Request.Client.Meta.IdTokenSignedResponseAlg)
-							if err != nil {
-								goError = err
-								return
-							}
-						}()
-
-						if err := dataprovider.Create(ctx, id); err != nil {
-							log.Printf("create idToken error:%s", err.Error())
-							goError = err
-							return
-						}
-					}
-				}()
-				wg.Wait()
-
-				// 並列処理中のエラーを判定する
-				if goError != nil {
-					return goError
+				var err error
+				success, err = issueTokens(ctx, p.subjectMapper(), iss, authCode.Details.Authorized, tokenIssueOptions{
+					TlsClientCertificate: req.Msg.TlsClientCertificate,
+					DpopJkt:              dpopJkt,
+					Audience:             audience,
+				}, time.Now())
+				if err != nil {
+					return err
 				}
 
 				authCode.Details.IsUsed = true // 使用済みにする
@@ -403,12 +357,14 @@ func (p *Provider) Token(ctx context.Context,
 					iss.Meta.TokenEndpoint,
 					iss.Meta.Issuer,
 				},
-				BasicAuth: req.Msg.BasicAuth,
-				Client:    refreshToken.Details.Authorized.Request.Client,
-				Issuer:    iss,
-				Values:    vals,
+				BasicAuth:                         req.Msg.BasicAuth,
+				Client:                            refreshToken.Details.Authorized.Request.Client,
+				Issuer:                            iss,
+				Values:                            vals,
+				TlsClientCertificate:              req.Msg.TlsClientCertificate,
+				TlsClientCertificateFromHandshake: req.Msg.TlsClientCertificateFromHandshake,
 			}
-			if terr := checkClientAuthentication(ctx, params); terr != nil {
+			if terr := p.checkClientAuthentication(ctx, params); terr != nil {
 				return connect.NewResponse(&oppb.TokenResponse{
 					TokenResponseOneof: &oppb.TokenResponse_Fail{
 						Fail: terr,
@@ -416,12 +372,23 @@ func (p *Provider) Token(ctx context.Context,
 				}), nil
 			}
 
+			// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+			dpopJkt, derr := checkDpopRequest(ctx, iss, params.Client, req.Msg.Dpop, req.Msg.Method, iss.Meta.TokenEndpoint)
+			if derr != nil {
+				return dpopTokenFail(derr), nil
+			}
+			if res := checkCertificateBoundRequest(params.Client, req.Msg.TlsClientCertificate); res != nil {
+				return res, nil
+			}
+
 			if params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_1_0 ||
-				params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0 {
+				(params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0 && dpopJkt == "") {
 				// FAPIの場合はさらにClientCertificateをチェックする
 				// checkClientAuthenticationの中では実施しない。
 				// pushedAuthenticationRequestでもcheckClientAuthenticationを使用しているため。
-				if !slices.Contains(refreshToken.Details.Authorized.Request.Client.Extensions.TlsClientCertificates, req.Msg.TlsClientCertificate) {
+				// FAPI 2.0 では mTLS の代わりに DPoP による送信者制約を使用できる
+				thumbprint, err := certificateThumbprint(req.Msg.TlsClientCertificate)
+				if err != nil || !slices.Contains(refreshToken.Details.Authorized.Request.Client.Extensions.TlsClientCertificates, thumbprint) {
 					return connect.NewResponse(&oppb.TokenResponse{
 						TokenResponseOneof: &oppb.TokenResponse_Fail{
 							Fail: &oppb.TokenFailResponse{
@@ -436,7 +403,13 @@ func (p *Provider) Token(ctx context.Context,
 				}
 			}
 
-			// リフレッシュトークン有効期限切れチェック
+			// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+			// DPoP バインドされたリフレッシュトークンは同じ鍵の proof でのみ使用できる
+			if refreshToken.Details.DpopJkt != "" && refreshToken.Details.DpopJkt != dpopJkt {
+				return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidDpopProof, "DPoP key does not match the refresh token"), nil
+			}
+
+			// リフレッシュトークン有効期限切れチェック（アイドルタイムアウト・最大有効期間を含む）
 			if time.Now().After(refreshToken.ExpireAt) {
 				return connect.NewResponse(&oppb.TokenResponse{
 					TokenResponseOneof: &oppb.TokenResponse_Fail{
@@ -451,6 +424,12 @@ func (p *Provider) Token(ctx context.Context,
 				}), nil
 			}
 
+			// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+			audience, desc := accessTokenAudience(refreshToken.Details.Authorized, tr.Resources)
+			if desc != "" {
+				return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidTarget, desc), nil
+			}
+
 			success := &oppb.TokenSuccessResponse{}
 			err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
 				// トランザクションのためrefreshTokenを再取得
@@ -458,23 +437,40 @@ func (p *Provider) Token(ctx context.Context,
 					log.Printf("refreshToken get error:%s", err.Error())
 					return err
 				}
+				// リフレッシュトークン再利用チェック
+				if refreshToken.Details.IsUsed {
+					return errRefreshTokenReused
+				}
+				now := time.Now()
+				attr := refreshToken.Details.Authorized.Request.Client.Attribute
+				familyCreateAt := refreshToken.Details.FamilyCreateAt
+				if familyCreateAt.IsZero() {
+					familyCreateAt = refreshToken.CreateAt
+				}
 
 				tlsClientCertificate := req.Msg.TlsClientCertificate
-				access, err := makeAccessTokenIdentifier(refreshToken.Details.Authorized, time.Now(), tlsClientCertificate)
+				access, err := makeAccessTokenIdentifier(refreshToken.Details.Authorized, time.Now(), tlsClientCertificate, dpopJkt)
 				if err != nil {
 					log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
 					return err
 				}
+				access.Details.Audience = audience
 				if err := dataprovider.Create(ctx, access); err != nil {
 					log.Printf("create access token error:%s", err.Error())
 					return err
 				}
-				success.AccessToken = access.Details.Identifier
+				success.AccessToken, err = makeAccessToken(ctx, p.subjectMapper(), iss, access)
+				if err != nil {
+					log.Printf("makeAccessToken error:%s", err.Error())
+					return err
+				}
 				success.ExpiresIn = refreshToken.Details.Authorized.Request.Client.Attribute.AccessTokenLifetimeSeconds
-				success.TokenType = "Bearer"
+				success.TokenType = accessTokenType(access)
+				// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+				success.AuthorizationDetails = refreshToken.Details.Authorized.AuthorizationDetails
 
 				if slices.Contains(refreshToken.Details.Authorized.Request.AuthParams.Scopes, "offline_access") {
-					refresh, err := makeRefreshTokenIdentifier(refreshToken.Details.Authorized, time.Now())
+					refresh, err := makeRefreshTokenIdentifier(refreshToken.Details.Authorized, now, familyCreateAt, dpopJkt)
 					if err != nil {
 						log.Printf("makeRefreshTokenIdentifier error:%s", err.Error())
 						return err
@@ -496,19 +492,41 @@ func (p *Provider) Token(ctx context.Context,
 						return err
 					}
 
-					claims, err := makeIdTokenClaims(iss, id, time.Now(), "", "", "")
+					claims, err := makeIdTokenClaims(ctx, p.subjectMapper(), iss, id, time.Now(), "", "", "")
 					if err != nil {
 						return err
 					}
-					success.IdToken, err = makeJwt(ctx, iss, claims, id.Details.Authorized.Request.Client.Meta.IdTokenSignedResponseAlg)
+					success.IdToken, err = makeIdToken(ctx, iss, id.Details.Authorized.Request.Client, claims)
 					if err != nil {
 						return err
 					}
 				}
+
+				if attr.RefreshTokenRotation {
+					// 再利用を検知するため、削除せずに使用済みにする
+					refreshToken.Details.IsUsed = true
+				} else if attr.RefreshTokenIdleTimeoutSeconds > 0 {
+					// アイドルタイムアウトを延長する
+					refreshToken.ExpireAt = refreshTokenExpireAt(attr, refreshToken.CreateAt, now, familyCreateAt)
+				} else {
+					return nil
+				}
+				if err := dataprovider.Set(ctx, refreshToken); err != nil {
+					log.Printf("update refreshToken error:%s", err.Error())
+					return err
+				}
 				return nil
-				// リフレッシュトークンを１回限りの使用にするかは拡張フラグで制御すること
-				// return dataprovider.Delete(ctx, refreshToken)
 			})
+			if errors.Is(err, errRefreshTokenReused) {
+				// https://www.rfc-editor.org/rfc/rfc9700.html#section-4.14.2
+				// ローテーション済みのリフレッシュトークンが再利用された場合は漏洩が疑われるため、
+				// 同じ認可から発行されたトークンを全て無効化する
+				if err := p.callbacks.DeleteTokensWithRequetId(ctx, iss.Key.Id, refreshToken.RequestId); err != nil {
+					log.Printf("[BACKEND_ERROR] DeleteTokensWithRequetId error:%v", err)
+					return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("revoke tokens error"))
+				}
+				return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "Refresh token was used:"+tr.RefreshToken), nil
+			}
 			if err != nil {
 				log.Printf("[ERROR] exchange refresh token exchange:%#v", err)
 				return connect.NewResponse(&oppb.TokenResponse{
@@ -529,6 +547,21 @@ func (p *Provider) Token(ctx context.Context,
 				},
 			}), nil
 
+		case oauth.GrantTypeClientCredentials:
+			return p.clientCredentialsGrant(ctx, iss, req.Msg, vals, tr)
+
+		case oauth.GrantTypeDeviceCode:
+			return p.deviceCodeGrant(ctx, iss, req.Msg, vals)
+
+		case oauth.GrantTypeCiba:
+			return p.cibaGrant(ctx, iss, req.Msg, vals)
+
+		case oauth.GrantTypeTokenExchange:
+			return p.tokenExchangeGrant(ctx, iss, req.Msg, vals, tr)
+
+		case oauth.GrantTypeJwtBearer:
+			return p.jwtBearerGrant(ctx, iss, req.Msg, vals, tr)
+
 		default:
 			return connect.NewResponse(&oppb.TokenResponse{
 				TokenResponseOneof: &oppb.TokenResponse_Fail{
@@ -545,15 +578,52 @@ func (p *Provider) Token(ctx context.Context,
 	}
 }
 
+func tokenFail(statusCode int32, code, description string) *connect.Response[oppb.TokenResponse] {
+	return connect.NewResponse(&oppb.TokenResponse{
+		TokenResponseOneof: &oppb.TokenResponse_Fail{
+			Fail: &oppb.TokenFailResponse{
+				StatusCode: statusCode,
+				Error: &oppb.OauthError{
+					Error:            code,
+					ErrorDescription: description,
+				},
+			},
+		},
+	})
+}
+
 type clientAuthentication struct {
 	AllowAudience []string
 	BasicAuth     *oppb.BasicAuth
 	Client        *model.Client
 	Issuer        *model.Issuer
 	Values        *query.Result
+	// クライアント証明書（tls_client_auth, self_signed_tls_client_auth で使用）
+	TlsClientCertificate string
+	// クライアント証明書が SDK との TLS ハンドシェイクで提示された（秘密鍵の所持が証明された）
+	TlsClientCertificateFromHandshake bool
+}
+
+// https://openid.net/specs/openid-connect-core-1_0.html#ClientAuthentication
+// client_secret_jwt: the JWT is signed with an HMAC SHA algorithm using the client_secret as the shared key.
+func parseClientSecretJwt(client *model.Client, jwtString string, out jwt.Claims) (*jwt.Token, error) {
+	if client.Identity.ClientSecret == "" {
+		return nil, fmt.Errorf("no client_secret found for client %s", client.Identity.ClientId)
+	}
+	// nbf, expをプログラムでチェックするためにWithLeewayオプションを使用する
+	return jwt.NewParser(
+		jwt.WithLeeway(24*time.Hour),
+		jwt.WithValidMethods([]string{
+			jwt.SigningMethodHS256.Alg(),
+			jwt.SigningMethodHS384.Alg(),
+			jwt.SigningMethodHS512.Alg(),
+		}),
+	).ParseWithClaims(jwtString, out, func(t *jwt.Token) (any, error) {
+		return []byte(client.Identity.ClientSecret), nil
+	})
 }
 
-func checkClientAuthentication(ctx context.Context, params *clientAuthentication) *oppb.TokenFailResponse {
+func (p *Provider) checkClientAuthentication(ctx context.Context, params *clientAuthentication) *oppb.TokenFailResponse {
 	if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodNone {
 		return nil
 	}
@@ -593,8 +663,10 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 				},
 			}
 		}
-	} else if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodPrivateKeyJwt {
-		// private_key_jwt
+	} else if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodPrivateKeyJwt ||
+		params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodClientSecretJwt {
+		// private_key_jwt, client_secret_jwt
+		method := params.Client.Meta.TokenEndpointAuthMethod
 		clientAssertion := params.Values.Get("client_assertion")
 		clientAssertionType := params.Values.Get("client_assertion_type")
 		if (len(clientAssertion) > 0) && (len(clientAssertionType) > 0) {
@@ -605,14 +677,34 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 			// invalid, expired, revoked, does not match the redirection
 			// URI used in the authorization request, or was issued to
 			// another client.
-			token, err := parseJwt(ctx, params.Client.Meta, clientAssertion, rc)
+			var token *jwt.Token
+			var err error
+			if method == oauth.TokenEndpointAuthMethodClientSecretJwt {
+				token, err = parseClientSecretJwt(params.Client, clientAssertion, rc)
+			} else {
+				token, err = parseJwt(ctx, params.Client.Meta, clientAssertion, rc)
+			}
 			if err != nil {
 				log.Printf("parseJwt error: %v", err)
 				return &oppb.TokenFailResponse{
 					StatusCode: http.StatusBadRequest,
 					Error: &oppb.OauthError{
 						Error:            oauth.TokenErrorInvalidGrant,
-						ErrorDescription: "private_jwt parse error",
+						ErrorDescription: method + " parse error",
+					},
+				}
+			}
+			// https://openid.net/specs/openid-connect-registration-1_0.html#ClientMetadata
+			// token_endpoint_auth_signing_alg: All Token Requests using these authentication methods from this Client
+			// MUST be rejected, if the JWT is not signed with this algorithm.
+			alg := fmt.Sprintf("%v", token.Header["alg"])
+			if alg == "none" ||
+				(params.Client.Meta.TokenEndpointAuthSigningAlg != "" && params.Client.Meta.TokenEndpointAuthSigningAlg != alg) {
+				return &oppb.TokenFailResponse{
+					StatusCode: http.StatusBadRequest,
+					Error: &oppb.OauthError{
+						Error:            oauth.TokenErrorInvalidClient,
+						ErrorDescription: fmt.Sprintf("signing alg not allow:%v", alg),
 					},
 				}
 			}
@@ -622,7 +714,7 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 					StatusCode: http.StatusBadRequest,
 					Error: &oppb.OauthError{
 						Error:            oauth.TokenErrorInvalidGrant,
-						ErrorDescription: "private_jwt no exp:" + err.Error(),
+						ErrorDescription: method + " no exp:" + err.Error(),
 					},
 				}
 			}
@@ -631,7 +723,7 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 					StatusCode: http.StatusBadRequest,
 					Error: &oppb.OauthError{
 						Error:            oauth.TokenErrorInvalidRequest,
-						ErrorDescription: "private_jwt expired",
+						ErrorDescription: method + " expired",
 					},
 				}
 			}
@@ -640,12 +732,14 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 				params.Client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0 {
 				// https://openid.net/specs/openid-financial-api-part-2-1_0.html#algorithm-considerations
 				// FAPIではクライアントjwtの署名アルゴリズムは制限がある
-				if slices.Contains(fapiRejectionAlg, fmt.Sprintf("%v", token.Header["alg"])) {
+				// https://openid.net/specs/openid-financial-api-part-2-1_0.html#authorization-server
+				// 共通鍵（client_secret_jwt）によるクライアント認証は許可されない
+				if slices.Contains(fapiRejectionAlg, alg) || method == oauth.TokenEndpointAuthMethodClientSecretJwt {
 					return &oppb.TokenFailResponse{
 						StatusCode: http.StatusBadRequest,
 						Error: &oppb.OauthError{
 							Error:            oauth.TokenErrorInvalidClient,
-							ErrorDescription: fmt.Sprintf("signing alg not allow:%v", token.Header["alg"]),
+							ErrorDescription: fmt.Sprintf("signing alg not allow:%v", alg),
 						},
 					}
 				}
@@ -687,7 +781,9 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 				},
 			}
 		}
-	} else if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodTlsClientAuth {
+	} else if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodTlsClientAuth ||
+		params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodSelfSignedTlsClientAuth {
+		// https://www.rfc-editor.org/rfc/rfc8705.html#section-2
 		if params.Client.Identity.ClientId != params.Values.Get("client_id") {
 			return &oppb.TokenFailResponse{
 				StatusCode: http.StatusBadRequest,
@@ -697,14 +793,25 @@ func checkClientAuthentication(ctx context.Context, params *clientAuthentication
 				},
 			}
 		}
-		return nil
-	} else if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodSelfSignedTlsClientAuth {
-		if params.Client.Identity.ClientId != params.Values.Get("client_id") {
+		// 証明書ハッシュはクライアントが任意に設定できるヘッダーから渡される場合があるため、
+		// 証明書そのものが渡された場合のみ認証する
+		var err error
+		if params.Client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodTlsClientAuth {
+			var roots *x509.CertPool
+			roots, err = p.tlsClientAuthTrustAnchors(ctx, params.Issuer)
+			if err == nil {
+				err = checkTlsClientAuth(roots, params.Client.Meta, params.TlsClientCertificate)
+			}
+		} else {
+			err = checkSelfSignedTlsClientAuth(ctx, params.Client.Meta, params.TlsClientCertificate, params.TlsClientCertificateFromHandshake)
+		}
+		if err != nil {
+			log.Printf("mutual-TLS client authentication error: %v", err)
 			return &oppb.TokenFailResponse{
-				StatusCode: http.StatusBadRequest,
+				StatusCode: http.StatusUnauthorized,
 				Error: &oppb.OauthError{
-					Error:            oauth.TokenErrorInvalidGrant,
-					ErrorDescription: "The client is invalid",
+					Error:            oauth.TokenErrorInvalidClient,
+					ErrorDescription: params.Client.Meta.TokenEndpointAuthMethod + ": " + err.Error(),
 				},
 			}
 		}
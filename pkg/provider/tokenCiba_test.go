@@ -0,0 +1,289 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testClientNotificationToken = "notification-token"
+
+// testNotification records the requests to the client notification endpoint.
+type testNotification struct {
+	mu            sync.Mutex
+	authorization []string
+	bodies        []map[string]any
+}
+
+func (n *testNotification) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	body := map[string]any{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	n.authorization = append(n.authorization, r.Header.Get("Authorization"))
+	n.bodies = append(n.bodies, body)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// cibaClient allows the CIBA grant with the token delivery mode.
+func cibaClient(mode, endpoint string) func(c *model.Client) {
+	return func(c *model.Client) {
+		c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeCiba)
+		c.Meta.BackchannelTokenDeliveryMode = mode
+		if mode != oauth.BackchannelTokenDeliveryModePoll {
+			c.Meta.BackchannelClientNotificationEndpoint = endpoint
+		}
+	}
+}
+
+// newTestBackchannelAuthentication sends the backchannel authentication request of the client.
+func newTestBackchannelAuthentication(t *testing.T, iss *model.Issuer, client *model.Client) *oppb.BackchannelAuthenticationSuccessResponse {
+	t.Helper()
+	form := url.Values{
+		"client_id":     {client.Identity.ClientId},
+		"client_secret": {client.Identity.ClientSecret},
+		"scope":         {"openid"},
+		"login_hint":    {"user-1"},
+	}
+	if client.Meta.BackchannelTokenDeliveryMode != oauth.BackchannelTokenDeliveryModePoll {
+		form.Set("client_notification_token", testClientNotificationToken)
+	}
+	req := connect.NewRequest(&oppb.BackchannelAuthenticationRequest{
+		ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+		Method:      http.MethodPost,
+		Form:        form.Encode(),
+	})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	res, err := testProvider.BackchannelAuthentication(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Msg.GetSuccess() == nil {
+		t.Fatal(res.Msg.GetFail())
+	}
+	return res.Msg.GetSuccess()
+}
+
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10
+func TestCibaGrant(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	poll := func(t *testing.T, client *model.Client, authReqId string) (*oppb.TokenSuccessResponse, *oppb.TokenFailResponse) {
+		res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+			"grant_type":  {oauth.GrantTypeCiba},
+			"auth_req_id": {authReqId},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg.GetSuccess(), res.Msg.GetFail()
+	}
+	issue := func(t *testing.T, authReqId string) {
+		req := connect.NewRequest(&oppb.BackchannelAuthenticationIssueRequest{
+			AuthReqId: authReqId,
+			Subject:   "user-1",
+			Claims:    `{"sub":"user-1"}`,
+		})
+		auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+		if _, err := testProvider.BackchannelAuthenticationIssue(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cancel := func(t *testing.T, authReqId string) {
+		req := connect.NewRequest(&oppb.BackchannelAuthenticationCancelRequest{
+			AuthReqId: authReqId,
+		})
+		auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+		if _, err := testProvider.BackchannelAuthenticationCancel(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type testCase struct {
+		name string
+		mode string
+		// prepare は認証完了前に認証リクエストの状態を変更する
+		prepare func(ba *model.BackchannelAuthentication)
+		// complete はエンドユーザーによる認証の完了（nil は未完了）
+		complete func(t *testing.T, authReqId string)
+		// expected はトークンエンドポイントへの各ポーリングのエラーコード（空文字列はトークン発行）
+		expected []string
+		// notification はクライアントへの通知内容（nil は通知なし）
+		notification func(assert *assert.Assertions, client *model.Client, authReqId string, body map[string]any)
+	}
+
+	tests := []testCase{
+		{
+			name:     "Poll mode before the end-user authenticates",
+			mode:     oauth.BackchannelTokenDeliveryModePoll,
+			expected: []string{oauth.TokenErrorAuthorizationPending, oauth.TokenErrorSlowDown},
+		},
+		{
+			name:     "Poll mode after the end-user authenticated",
+			mode:     oauth.BackchannelTokenDeliveryModePoll,
+			complete: issue,
+			// auth_req_id は１回限り
+			expected: []string{"", oauth.TokenErrorInvalidGrant},
+		},
+		{
+			name:     "Poll mode after the end-user denied",
+			mode:     oauth.BackchannelTokenDeliveryModePoll,
+			complete: cancel,
+			expected: []string{oauth.TokenErrorAccessDenied},
+		},
+		{
+			name: "Poll mode with an expired auth_req_id",
+			mode: oauth.BackchannelTokenDeliveryModePoll,
+			prepare: func(ba *model.BackchannelAuthentication) {
+				ba.ExpireAt = time.Now().Add(-time.Second)
+			},
+			expected: []string{oauth.TokenErrorExpiredToken},
+		},
+		{
+			name:     "Ping mode notifies the client and the token is polled",
+			mode:     oauth.BackchannelTokenDeliveryModePing,
+			complete: issue,
+			expected: []string{"", oauth.TokenErrorInvalidGrant},
+			notification: func(assert *assert.Assertions, client *model.Client, authReqId string, body map[string]any) {
+				assert.Equal(map[string]any{"auth_req_id": authReqId}, body)
+			},
+		},
+		{
+			name:     "Ping mode notifies the denial",
+			mode:     oauth.BackchannelTokenDeliveryModePing,
+			complete: cancel,
+			expected: []string{oauth.TokenErrorAccessDenied},
+			notification: func(assert *assert.Assertions, client *model.Client, authReqId string, body map[string]any) {
+				assert.Equal(map[string]any{"auth_req_id": authReqId}, body)
+			},
+		},
+		{
+			name:     "Push mode delivers the tokens to the client",
+			mode:     oauth.BackchannelTokenDeliveryModePush,
+			complete: issue,
+			// push モードのクライアントはトークンエンドポイントを使用できない
+			expected: []string{oauth.TokenErrorUnauthorizedClient},
+			notification: func(assert *assert.Assertions, client *model.Client, authReqId string, body map[string]any) {
+				assert.Equal(authReqId, body["auth_req_id"])
+				assert.NotEmpty(body["access_token"])
+				assert.Equal("Bearer", body["token_type"])
+				idToken, _ := body["id_token"].(string)
+				claims := jwt.MapClaims{}
+				_, err := jwt.NewParser(jwt.WithValidMethods([]string{client.Meta.IdTokenSignedResponseAlg})).
+					ParseWithClaims(idToken, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+				if assert.NoError(err) {
+					// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.3.1
+					assert.Equal(authReqId, claims["urn:openid:params:jwt:claim:auth_req_id"])
+					assert.NotEmpty(claims["at_hash"])
+				}
+			},
+		},
+		{
+			name:     "Push mode delivers the denial to the client",
+			mode:     oauth.BackchannelTokenDeliveryModePush,
+			complete: cancel,
+			expected: []string{oauth.TokenErrorUnauthorizedClient},
+			notification: func(assert *assert.Assertions, client *model.Client, authReqId string, body map[string]any) {
+				assert.Equal(authReqId, body["auth_req_id"])
+				assert.Equal(oauth.TokenErrorAccessDenied, body["error"])
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			notification := &testNotification{}
+			server := httptest.NewServer(notification)
+			defer server.Close()
+
+			client := newTestClient(t, iss, cibaClient(tc.mode, server.URL))
+			success := newTestBackchannelAuthentication(t, iss, client)
+			if tc.mode == oauth.BackchannelTokenDeliveryModePush {
+				assert.Zero(t, success.Interval)
+			} else {
+				assert.Equal(t, int32(backchannelAuthenticationInterval), success.Interval)
+			}
+
+			if tc.prepare != nil {
+				ba := &model.BackchannelAuthentication{
+					Details: model.BackchannelAuthenticationDetails{
+						Issuer:    iss.Key,
+						AuthReqId: success.AuthReqId,
+					},
+				}
+				if err := dataprovider.Get(ctx, ba); err != nil {
+					t.Fatal(err)
+				}
+				tc.prepare(ba)
+				if err := dataprovider.Set(ctx, ba); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.complete != nil {
+				tc.complete(t, success.AuthReqId)
+			}
+
+			if tc.notification != nil {
+				if assert.Len(t, notification.bodies, 1) {
+					assert.Equal(t, "Bearer "+testClientNotificationToken, notification.authorization[0])
+					tc.notification(assert.New(t), client, success.AuthReqId, notification.bodies[0])
+				}
+			} else {
+				assert.Empty(t, notification.bodies)
+			}
+
+			for _, expected := range tc.expected {
+				success, fail := poll(t, client, success.AuthReqId)
+				if expected == "" {
+					if assert.NotNil(t, success) {
+						assert.NotEmpty(t, success.AccessToken)
+						assert.NotEmpty(t, success.IdToken)
+					}
+					continue
+				}
+				if assert.NotNil(t, fail) {
+					assert.Equal(t, expected, fail.Error.Error)
+				}
+			}
+		})
+	}
+}
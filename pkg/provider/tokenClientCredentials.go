@@ -0,0 +1,187 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// https://www.rfc-editor.org/rfc/rfc6749.html#section-4.4
+func (p *Provider) clientCredentialsGrant(ctx context.Context,
+	iss *model.Issuer,
+	msg *oppb.TokenRequest,
+	vals *query.Result,
+	tr *tokenRequest) (*connect.Response[oppb.TokenResponse], error) {
+	client, fail, err := identifyClient(ctx, iss, msg.BasicAuth, vals)
+	if err != nil {
+		return nil, err
+	} else if fail != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: fail,
+			},
+		}), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc6749.html#section-4.4
+	// The client credentials grant type MUST only be used by confidential clients.
+	if client.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodNone {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "public client cannot use client_credentials"), nil
+	}
+
+	// エンドポイント認証チェック
+	params := &clientAuthentication{
+		AllowAudience: []string{
+			iss.Meta.TokenEndpoint,
+			iss.Meta.Issuer,
+		},
+		BasicAuth:                         msg.BasicAuth,
+		Client:                            client,
+		Issuer:                            iss,
+		Values:                            vals,
+		TlsClientCertificate:              msg.TlsClientCertificate,
+		TlsClientCertificateFromHandshake: msg.TlsClientCertificateFromHandshake,
+	}
+	if terr := p.checkClientAuthentication(ctx, params); terr != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: terr,
+			},
+		}), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+	dpopJkt, derr := checkDpopRequest(ctx, iss, params.Client, msg.Dpop, msg.Method, iss.Meta.TokenEndpoint)
+	if derr != nil {
+		return dpopTokenFail(derr), nil
+	}
+	if res := checkCertificateBoundRequest(params.Client, msg.TlsClientCertificate); res != nil {
+		return res, nil
+	}
+
+	if !slices.Contains(client.Meta.GrantTypes, oauth.GrantTypeClientCredentials) {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "client_credentials is not allowed for this client"), nil
+	}
+
+	scopes, ok := grantedScopes(iss, client, tr.Scope)
+	if !ok {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidScope, "scope not allowed:"+tr.Scope), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+	if desc := checkResources(client, tr.Resources); desc != "" {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidTarget, desc), nil
+	}
+
+	requestId, err := randutil.UuidV4()
+	if err != nil {
+		return nil, err
+	}
+	// エンドユーザーが存在しないため、subject と session は設定しない
+	authorized := model.Authorized{
+		AuthTime: time.Now(),
+		Request: model.RequestDetails{
+			Key: &oppb.CommonKey{
+				Id: requestId,
+			},
+			Client: client,
+			AuthParams: &oppb.AuthorizationParameters{
+				ClientId: client.Identity.ClientId,
+				Scopes:   scopes,
+			},
+			Issuer: iss.Meta.Issuer,
+		},
+		Resources: tr.Resources,
+	}
+
+	success := &oppb.TokenSuccessResponse{}
+	if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+		access, err := makeAccessTokenIdentifier(authorized, time.Now(), msg.TlsClientCertificate, dpopJkt)
+		if err != nil {
+			log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
+			return err
+		}
+		if err := dataprovider.Create(ctx, access); err != nil {
+			log.Printf("create access token error:%s", err.Error())
+			return err
+		}
+		success.AccessToken, err = makeAccessToken(ctx, p.subjectMapper(), iss, access)
+		if err != nil {
+			log.Printf("makeAccessToken error:%s", err.Error())
+			return err
+		}
+		success.ExpiresIn = client.Attribute.AccessTokenLifetimeSeconds
+		success.TokenType = accessTokenType(access)
+		// https://www.rfc-editor.org/rfc/rfc6749.html#section-4.4.3
+		// A refresh token SHOULD NOT be included.
+		return nil
+	}); err != nil {
+		log.Printf("[BACKEND_ERROR] DB write error(TokenIdentifier):%v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if strings.Join(scopes, " ") != tr.Scope {
+		// https://www.rfc-editor.org/rfc/rfc6749.html#section-5.1
+		// scope: OPTIONAL, if identical to the scope requested by the client; otherwise, REQUIRED.
+		success.Scope = strings.Join(scopes, " ")
+	}
+	return connect.NewResponse(&oppb.TokenResponse{
+		TokenResponseOneof: &oppb.TokenResponse_Success{
+			Success: success,
+		},
+	}), nil
+}
+
+// grantedScopes resolves the scope of a grant without end-user interaction.
+// If scope is omitted, the scope registered for the client is used.
+// https://www.rfc-editor.org/rfc/rfc6749.html#section-3.3
+func grantedScopes(iss *model.Issuer, client *model.Client, scope string) ([]string, bool) {
+	registered := strings.Fields(client.Meta.Scope)
+	if scope == "" {
+		return registered, true
+	}
+	scopes := strings.Fields(scope)
+	for _, s := range scopes {
+		if len(registered) > 0 {
+			if !slices.Contains(registered, s) {
+				return nil, false
+			}
+		} else if len(iss.Meta.ScopesSupported) > 0 && !slices.Contains(iss.Meta.ScopesSupported, s) {
+			return nil, false
+		}
+	}
+	return scopes, true
+}
@@ -0,0 +1,156 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// clientCredentialsClient allows the client_credentials grant with the registered scope.
+func clientCredentialsClient(c *model.Client) {
+	c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeClientCredentials)
+	c.Meta.Scope = "read write"
+}
+
+// https://www.rfc-editor.org/rfc/rfc6749.html#section-4.4
+func TestClientCredentialsGrant(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	type testCase struct {
+		name          string
+		client        func(c *model.Client)
+		form          url.Values
+		expectedScope []string
+		// responseScope は応答の scope（要求と同じ場合は省略される）
+		responseScope string
+		expectedError string
+	}
+
+	tests := []testCase{
+		{
+			name:          "Requested scope is granted",
+			client:        clientCredentialsClient,
+			form:          url.Values{"scope": {"read"}},
+			expectedScope: []string{"read"},
+			responseScope: "",
+		},
+		{
+			name:          "Registered scope is granted when scope is omitted",
+			client:        clientCredentialsClient,
+			form:          url.Values{},
+			expectedScope: []string{"read", "write"},
+			responseScope: "read write",
+		},
+		{
+			name:          "Unregistered scope is rejected",
+			client:        clientCredentialsClient,
+			form:          url.Values{"scope": {"read admin"}},
+			expectedError: oauth.TokenErrorInvalidScope,
+		},
+		{
+			name:          "Client without the client_credentials grant type",
+			client:        func(c *model.Client) {},
+			form:          url.Values{},
+			expectedError: oauth.TokenErrorUnauthorizedClient,
+		},
+		{
+			name: "Public client cannot use client_credentials",
+			client: func(c *model.Client) {
+				clientCredentialsClient(c)
+				c.Meta.TokenEndpointAuthMethod = oauth.TokenEndpointAuthMethodNone
+			},
+			form:          url.Values{},
+			expectedError: oauth.TokenErrorUnauthorizedClient,
+		},
+		{
+			name:          "Wrong client_secret",
+			client:        clientCredentialsClient,
+			form:          url.Values{"client_secret": {"wrong"}},
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, tc.client)
+			form := url.Values{"grant_type": {oauth.GrantTypeClientCredentials}}
+			for k, v := range tc.form {
+				form[k] = v
+			}
+			req := newTestTokenRequest(iss, client, form)
+			if secret := tc.form.Get("client_secret"); secret != "" {
+				// newTestTokenRequest が設定した正しい client_secret を置き換える
+				form.Set("client_secret", secret)
+				req.Msg.Form = form.Encode()
+			}
+			res, err := testProvider.Token(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.expectedError != "" {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, tc.expectedError, res.Msg.GetFail().Error.Error)
+				}
+				return
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+			assert.NotEmpty(t, success.AccessToken)
+			assert.Equal(t, "Bearer", success.TokenType)
+			assert.Equal(t, client.Attribute.AccessTokenLifetimeSeconds, success.ExpiresIn)
+			assert.Equal(t, tc.responseScope, success.Scope)
+			// A refresh token SHOULD NOT be included.
+			assert.Empty(t, success.RefreshToken)
+			assert.Empty(t, success.IdToken)
+
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: success.AccessToken,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			if err := dataprovider.Get(ctx, access); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, model.TokenTypeAccessToken, access.Details.Type)
+			assert.Equal(t, tc.expectedScope, access.Details.Authorized.Request.AuthParams.Scopes)
+			// エンドユーザーが存在しないため subject と session は持たない
+			assert.Empty(t, access.Details.Authorized.Subject)
+			assert.Empty(t, access.Details.Authorized.SessionId)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.4
+func (p *Provider) deviceCodeGrant(ctx context.Context,
+	iss *model.Issuer,
+	msg *oppb.TokenRequest,
+	vals *query.Result) (*connect.Response[oppb.TokenResponse], error) {
+	deviceCode := vals.Get("device_code")
+	if deviceCode == "" {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "device_code is required"), nil
+	}
+
+	client, fail, err := identifyClient(ctx, iss, msg.BasicAuth, vals)
+	if err != nil {
+		return nil, err
+	} else if fail != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: fail,
+			},
+		}), nil
+	}
+
+	// エンドポイント認証チェック
+	params := &clientAuthentication{
+		AllowAudience: []string{
+			iss.Meta.TokenEndpoint,
+			iss.Meta.Issuer,
+		},
+		BasicAuth:                         msg.BasicAuth,
+		Client:                            client,
+		Issuer:                            iss,
+		Values:                            vals,
+		TlsClientCertificate:              msg.TlsClientCertificate,
+		TlsClientCertificateFromHandshake: msg.TlsClientCertificateFromHandshake,
+	}
+	if terr := p.checkClientAuthentication(ctx, params); terr != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: terr,
+			},
+		}), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+	dpopJkt, derr := checkDpopRequest(ctx, iss, params.Client, msg.Dpop, msg.Method, iss.Meta.TokenEndpoint)
+	if derr != nil {
+		return dpopTokenFail(derr), nil
+	}
+	if res := checkCertificateBoundRequest(params.Client, msg.TlsClientCertificate); res != nil {
+		return res, nil
+	}
+
+	da := &model.DeviceAuthorization{
+		Details: model.DeviceAuthorizationDetails{
+			Issuer:     iss.Key,
+			DeviceCode: deviceCode,
+		},
+	}
+	if err := dataprovider.Get(ctx, da); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "device_code not found"), nil
+		}
+		return nil, err
+	}
+	if da.Details.ClientId != client.Identity.ClientId {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "device_code was issued to another client"), nil
+	}
+
+	now := time.Now()
+	if now.After(da.ExpireAt) {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorExpiredToken, "device_code is expired"), nil
+	}
+
+	switch da.Details.Status {
+	case model.DeviceAuthorizationStatusPending:
+		// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.5
+		// slow_down: the interval MUST be increased by 5 seconds for this and all subsequent requests.
+		code, description := oauth.TokenErrorAuthorizationPending, "the authorization request is still pending"
+		if now.Before(da.Details.LastPolledAt.Add(time.Duration(da.Details.Interval) * time.Second)) {
+			code, description = oauth.TokenErrorSlowDown, "polling too frequently"
+			da.Details.Interval += 5
+		}
+		da.Details.LastPolledAt = now
+		if err := dataprovider.Set(ctx, da); err != nil {
+			log.Printf("[BACKEND_ERROR] DeviceAuthorization Set error:%v", err)
+			return nil, err
+		}
+		return tokenFail(http.StatusBadRequest, code, description), nil
+
+	case model.DeviceAuthorizationStatusDenied:
+		if err := dataprovider.Delete(ctx, da); err != nil {
+			return nil, err
+		}
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorAccessDenied, "the authorization request was denied"), nil
+
+	case model.DeviceAuthorizationStatusApproved:
+		// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+		audience, desc := accessTokenAudience(*da.Details.Authorized, vals.GetAll("resource"))
+		if desc != "" {
+			return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidTarget, desc), nil
+		}
+		// device_code は１回限り
+		if err := dataprovider.Delete(ctx, da); err != nil {
+			return nil, err
+		}
+		var success *oppb.TokenSuccessResponse
+		if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+			var err error
+			success, err = issueTokens(ctx, p.subjectMapper(), iss, *da.Details.Authorized, tokenIssueOptions{
+				TlsClientCertificate: msg.TlsClientCertificate,
+				DpopJkt:              dpopJkt,
+				Audience:             audience,
+			}, now)
+			return err
+		}); err != nil {
+			log.Printf("[ERROR] device_code exchange:%v", err)
+			return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "device_code exchange error:"+err.Error()), nil
+		}
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Success{
+				Success: success,
+			},
+		}), nil
+	}
+	return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "unknown device authorization status"), nil
+}
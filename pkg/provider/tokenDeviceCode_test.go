@@ -0,0 +1,201 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// deviceCodeClient allows the device_code grant.
+func deviceCodeClient(c *model.Client) {
+	c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeDeviceCode)
+}
+
+// newTestDeviceAuthorization starts the device authorization of the client
+// and returns the stored device authorization.
+func newTestDeviceAuthorization(t *testing.T, iss *model.Issuer, client *model.Client) *model.DeviceAuthorization {
+	t.Helper()
+	ctx := context.Background()
+	req := connect.NewRequest(&oppb.DeviceAuthorizationRequest{
+		ContentType: httphelper.MimeTypeWwwFormUnlencoded,
+		Method:      http.MethodPost,
+		Form: url.Values{
+			"client_id":     {client.Identity.ClientId},
+			"client_secret": {client.Identity.ClientSecret},
+			"scope":         {"openid"},
+		}.Encode(),
+	})
+	auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+	res, err := testProvider.DeviceAuthorization(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	success := res.Msg.GetSuccess()
+	if success == nil {
+		t.Fatal(res.Msg.GetFail())
+	}
+	da := &model.DeviceAuthorization{
+		Details: model.DeviceAuthorizationDetails{
+			Issuer:     iss.Key,
+			DeviceCode: success.DeviceCode,
+		},
+	}
+	if err := dataprovider.Get(ctx, da); err != nil {
+		t.Fatal(err)
+	}
+	return da
+}
+
+// https://www.rfc-editor.org/rfc/rfc8628.html#section-3.5
+func TestDeviceCodeGrant(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	poll := func(t *testing.T, client *model.Client, deviceCode string) (*oppb.TokenSuccessResponse, *oppb.TokenFailResponse) {
+		res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+			"grant_type":  {oauth.GrantTypeDeviceCode},
+			"device_code": {deviceCode},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg.GetSuccess(), res.Msg.GetFail()
+	}
+
+	type testCase struct {
+		name string
+		// prepare はポーリング前にデバイス認可の状態を変更する
+		prepare func(t *testing.T, client *model.Client, da *model.DeviceAuthorization)
+		// expected は各ポーリングのエラーコード（空文字列はトークン発行）
+		expected []string
+		// interval はポーリング後の最小間隔（秒）
+		interval int32
+	}
+
+	tests := []testCase{
+		{
+			name:     "Pending authorization",
+			prepare:  func(t *testing.T, client *model.Client, da *model.DeviceAuthorization) {},
+			expected: []string{oauth.TokenErrorAuthorizationPending},
+			interval: deviceCodeInterval,
+		},
+		{
+			name:     "Polling faster than the interval slows down",
+			prepare:  func(t *testing.T, client *model.Client, da *model.DeviceAuthorization) {},
+			expected: []string{oauth.TokenErrorAuthorizationPending, oauth.TokenErrorSlowDown, oauth.TokenErrorSlowDown},
+			// slow_down のたびに 5 秒ずつ延長する
+			interval: deviceCodeInterval + 10,
+		},
+		{
+			name: "Polling after the interval is pending",
+			prepare: func(t *testing.T, client *model.Client, da *model.DeviceAuthorization) {
+				da.Details.LastPolledAt = time.Now().Add(-(deviceCodeInterval + 1) * time.Second)
+			},
+			expected: []string{oauth.TokenErrorAuthorizationPending},
+			interval: deviceCodeInterval,
+		},
+		{
+			name: "Expired device_code",
+			prepare: func(t *testing.T, client *model.Client, da *model.DeviceAuthorization) {
+				da.ExpireAt = time.Now().Add(-time.Second)
+			},
+			expected: []string{oauth.TokenErrorExpiredToken},
+		},
+		{
+			name: "Denied authorization",
+			prepare: func(t *testing.T, client *model.Client, da *model.DeviceAuthorization) {
+				da.Details.Status = model.DeviceAuthorizationStatusDenied
+			},
+			// 拒否された device_code は削除される
+			expected: []string{oauth.TokenErrorAccessDenied, oauth.TokenErrorInvalidGrant},
+		},
+		{
+			name: "Approved authorization issues tokens once",
+			prepare: func(t *testing.T, client *model.Client, da *model.DeviceAuthorization) {
+				authorized := newTestAuthorized(t, iss, client, "openid")
+				da.Details.Status = model.DeviceAuthorizationStatusApproved
+				da.Details.Authorized = &authorized
+			},
+			expected: []string{"", oauth.TokenErrorInvalidGrant},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, deviceCodeClient)
+			da := newTestDeviceAuthorization(t, iss, client)
+			tc.prepare(t, client, da)
+			if err := dataprovider.Set(ctx, da); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, expected := range tc.expected {
+				success, fail := poll(t, client, da.Details.DeviceCode)
+				if expected == "" {
+					if assert.NotNil(t, success) {
+						assert.NotEmpty(t, success.AccessToken)
+						assert.NotEmpty(t, success.IdToken)
+					}
+					continue
+				}
+				if assert.NotNil(t, fail) {
+					assert.Equal(t, expected, fail.Error.Error)
+				}
+			}
+			if tc.interval > 0 {
+				stored := &model.DeviceAuthorization{
+					Details: model.DeviceAuthorizationDetails{
+						Issuer:     iss.Key,
+						DeviceCode: da.Details.DeviceCode,
+					},
+				}
+				if err := dataprovider.Get(ctx, stored); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, tc.interval, stored.Details.Interval)
+			}
+		})
+	}
+
+	t.Run("device_code issued to another client", func(t *testing.T) {
+		client := newTestClient(t, iss, deviceCodeClient)
+		da := newTestDeviceAuthorization(t, iss, client)
+		_, fail := poll(t, newTestClient(t, iss, deviceCodeClient), da.Details.DeviceCode)
+		if assert.NotNil(t, fail) {
+			assert.Equal(t, oauth.TokenErrorInvalidGrant, fail.Error.Error)
+		}
+	})
+}
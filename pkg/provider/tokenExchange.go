@@ -0,0 +1,277 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+// https://www.rfc-editor.org/rfc/rfc8693.html#section-2
+func (p *Provider) tokenExchangeGrant(ctx context.Context,
+	iss *model.Issuer,
+	msg *oppb.TokenRequest,
+	vals *query.Result,
+	tr *tokenRequest) (*connect.Response[oppb.TokenResponse], error) {
+	client, fail, err := identifyClient(ctx, iss, msg.BasicAuth, vals)
+	if err != nil {
+		return nil, err
+	} else if fail != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: fail,
+			},
+		}), nil
+	}
+
+	// エンドポイント認証チェック
+	params := &clientAuthentication{
+		AllowAudience: []string{
+			iss.Meta.TokenEndpoint,
+			iss.Meta.Issuer,
+		},
+		BasicAuth:                         msg.BasicAuth,
+		Client:                            client,
+		Issuer:                            iss,
+		Values:                            vals,
+		TlsClientCertificate:              msg.TlsClientCertificate,
+		TlsClientCertificateFromHandshake: msg.TlsClientCertificateFromHandshake,
+	}
+	if terr := p.checkClientAuthentication(ctx, params); terr != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: terr,
+			},
+		}), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+	dpopJkt, derr := checkDpopRequest(ctx, iss, params.Client, msg.Dpop, msg.Method, iss.Meta.TokenEndpoint)
+	if derr != nil {
+		return dpopTokenFail(derr), nil
+	}
+	if res := checkCertificateBoundRequest(params.Client, msg.TlsClientCertificate); res != nil {
+		return res, nil
+	}
+
+	if !slices.Contains(client.Meta.GrantTypes, oauth.GrantTypeTokenExchange) {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "token-exchange is not allowed for this client"), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc8693.html#section-2.1
+	// requested_token_type: 発行できるのはアクセストークンのみ
+	if v := vals.Get("requested_token_type"); v != "" && v != oauth.TokenTypeAccessToken {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "unsupported requested_token_type:"+v), nil
+	}
+
+	subject, desc := getExchangeToken(ctx, iss, vals.Get("subject_token"), vals.Get("subject_token_type"))
+	if subject == nil {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "subject_token "+desc), nil
+	}
+	var actor *model.TokenIdentifier
+	if vals.Get("actor_token") != "" || vals.Get("actor_token_type") != "" {
+		// actor_token_type: REQUIRED when the actor_token parameter is present in the request
+		// but MUST NOT be included otherwise.
+		actor, desc = getExchangeToken(ctx, iss, vals.Get("actor_token"), vals.Get("actor_token_type"))
+		if actor == nil {
+			return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "actor_token "+desc), nil
+		}
+	}
+
+	// 元のトークンより広いスコープは許可しない
+	subjectScopes := subject.Details.Authorized.Request.AuthParams.GetScopes()
+	scopes := subjectScopes
+	if tr.Scope != "" {
+		scopes = strings.Fields(tr.Scope)
+		for _, s := range scopes {
+			if !slices.Contains(subjectScopes, s) {
+				return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidScope, "scope not allowed:"+s), nil
+			}
+		}
+	}
+
+	exchange := &model.TokenExchangeRequest{
+		Client:       client,
+		SubjectToken: subject,
+		ActorToken:   actor,
+		Audience:     vals.GetAll("audience"),
+		Resource:     vals.GetAll("resource"),
+		Scopes:       scopes,
+	}
+	var decision *model.TokenExchangeDecision
+	if cb, ok := p.callbacks.(model.TokenExchangeCallbacks); ok {
+		decision, err = cb.TokenExchangePolicyCallback(ctx, exchange)
+	} else {
+		decision, err = defaultTokenExchangePolicy(exchange)
+	}
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidTarget) {
+			return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidTarget, err.Error()), nil
+		}
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, err.Error()), nil
+	}
+	for _, s := range decision.Scopes {
+		if !slices.Contains(scopes, s) {
+			log.Printf("[ERROR] token exchange policy granted unrequested scope:%s", s)
+			return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidScope, "scope not allowed:"+s), nil
+		}
+	}
+
+	// 元の認可情報を引き継ぎ、要求元クライアント向けに付け替える
+	authorized := subject.Details.Authorized
+	authorized.Request.Client = client
+	authorized.Request.AuthParams = &oppb.AuthorizationParameters{
+		ClientId: client.Identity.ClientId,
+		Scopes:   decision.Scopes,
+	}
+
+	success := &oppb.TokenSuccessResponse{}
+	if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+		access, err := makeAccessTokenIdentifier(authorized, time.Now(), msg.TlsClientCertificate, dpopJkt)
+		if err != nil {
+			log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
+			return err
+		}
+		access.Details.Audience = decision.Audience
+		access.Details.Lineage = append(slices.Clone(subject.Details.Lineage), subject.Details.Authorized)
+		// https://www.rfc-editor.org/rfc/rfc8693.html#section-4.1
+		// 委任の場合は act クレームとして actor を記録する
+		if actor != nil {
+			access.Details.Actor = &model.TokenActor{
+				Subject:  actor.Details.Authorized.Subject,
+				ClientId: actor.Details.Authorized.Request.Client.Identity.ClientId,
+				Actor:    actor.Details.Actor,
+			}
+		}
+		if err := dataprovider.Create(ctx, access); err != nil {
+			log.Printf("create access token error:%s", err.Error())
+			return err
+		}
+		success.AccessToken, err = makeAccessToken(ctx, p.subjectMapper(), iss, access)
+		if err != nil {
+			log.Printf("makeAccessToken error:%s", err.Error())
+			return err
+		}
+		success.ExpiresIn = client.Attribute.AccessTokenLifetimeSeconds
+		success.TokenType = accessTokenType(access)
+		success.IssuedTokenType = oauth.TokenTypeAccessToken
+		return nil
+	}); err != nil {
+		log.Printf("[BACKEND_ERROR] DB write error(TokenIdentifier):%v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if strings.Join(decision.Scopes, " ") != tr.Scope {
+		success.Scope = strings.Join(decision.Scopes, " ")
+	}
+	return connect.NewResponse(&oppb.TokenResponse{
+		TokenResponseOneof: &oppb.TokenResponse_Success{
+			Success: success,
+		},
+	}), nil
+}
+
+// getExchangeToken resolves a subject_token or actor_token issued by this server.
+// On failure, it returns nil and the error description.
+func getExchangeToken(ctx context.Context, iss *model.Issuer, token, tokenType string) (*model.TokenIdentifier, string) {
+	if token == "" || tokenType == "" {
+		return nil, "and its token type are required"
+	}
+
+	identifier := &model.TokenIdentifier{
+		Details: model.TokenIdentifierDetails{
+			Identifier: accessTokenIdentifier(ctx, iss, token),
+			Authorized: model.Authorized{
+				Request: model.RequestDetails{
+					Client: &model.Client{
+						Issuer: iss.Key,
+					},
+				},
+			},
+		},
+	}
+	var expected model.TokenType
+	switch tokenType {
+	case oauth.TokenTypeAccessToken:
+		expected = model.TokenTypeAccessToken
+	case oauth.TokenTypeRefreshToken:
+		expected = model.TokenTypeRefreshToken
+	case oauth.TokenTypeIdToken:
+		// ID トークンは jti で識別子を引く
+		claims, err := verifyIdToken(ctx, iss, token)
+		if err != nil {
+			return nil, "is invalid"
+		}
+		// verifyIdToken は id_token_hint 用に期限切れを許容するため、ここで exp を厳密に確認する
+		if claims.ExpiresAt == nil || time.Now().After(claims.ExpiresAt.Time) {
+			return nil, "is invalid or expired"
+		}
+		expected = model.TokenTypeIdToken
+		identifier.Details.Identifier = claims.ID
+	default:
+		return nil, "type is not supported:" + tokenType
+	}
+
+	if identifier.Details.Identifier == "" {
+		return nil, "is invalid"
+	}
+	if err := dataprovider.Get(ctx, identifier); err != nil {
+		return nil, "is invalid"
+	}
+	if identifier.Details.Type != expected || identifier.Details.IsUsed || time.Now().After(identifier.ExpireAt) {
+		return nil, "is invalid or expired"
+	}
+	return identifier, ""
+}
+
+// defaultTokenExchangePolicy is applied when no TokenExchangeCallbacks is provided.
+// It only allows a client to downscope tokens issued to itself or to its audience,
+// without delegation or other targets.
+func defaultTokenExchangePolicy(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error) {
+	clientId := req.Client.Identity.ClientId
+	if req.SubjectToken.Details.Authorized.Request.Client.Identity.ClientId != clientId &&
+		!slices.Contains(req.SubjectToken.Details.Audience, clientId) {
+		return nil, errors.New("subject_token was issued to another client")
+	}
+	if req.ActorToken != nil {
+		return nil, errors.New("delegation is not allowed")
+	}
+	if len(req.Audience) > 0 || len(req.Resource) > 0 {
+		return nil, model.ErrInvalidTarget
+	}
+	return &model.TokenExchangeDecision{
+		Scopes: req.Scopes,
+	}, nil
+}
@@ -0,0 +1,490 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// testExchangePolicy applies the policy function as TokenExchangeCallbacks.
+type testExchangePolicy struct {
+	model.ProviderCallbacks
+	policy func(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error)
+}
+
+func (p testExchangePolicy) TokenExchangePolicyCallback(_ context.Context, req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error) {
+	return p.policy(req)
+}
+
+// testTokens issues the tokens of the end-user to the client.
+type testTokens struct {
+	access  *model.TokenIdentifier
+	refresh *model.TokenIdentifier
+	idToken string
+}
+
+func newTestTokens(t *testing.T, iss *model.Issuer, client *model.Client, idTokenExpireAt time.Time, scopes ...string) *testTokens {
+	t.Helper()
+	ctx := context.Background()
+	authorized := newTestAuthorized(t, iss, client, scopes...)
+	now := time.Now()
+	access, err := makeAccessTokenIdentifier(authorized, now, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refresh, err := makeRefreshTokenIdentifier(authorized, now, now, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := makeIdTokenIdentifier(authorized, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, identifier := range []*model.TokenIdentifier{access, refresh, id} {
+		if err := dataprovider.Create(ctx, identifier); err != nil {
+			t.Fatal(err)
+		}
+	}
+	claims, err := makeIdTokenClaims(ctx, pairwiseSubjectMapper{}, iss, id, now, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 記録された ID トークンは有効なまま、ID トークン自体の exp だけを変更する
+	claims["exp"] = idTokenExpireAt.Unix()
+	idToken, err := makeIdToken(ctx, iss, client, claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testTokens{access: access, refresh: refresh, idToken: idToken}
+}
+
+func tokenExchangeClient(c *model.Client) {
+	c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeTokenExchange)
+}
+
+func TestTokenExchangeDefaultPolicy(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss, tokenExchangeClient)
+	other := newTestClient(t, iss, tokenExchangeClient)
+	noGrant := newTestClient(t, iss)
+
+	own := newTestTokens(t, iss, client, time.Now().Add(time.Hour), "openid", "profile", "offline_access")
+	expiredIdToken := newTestTokens(t, iss, client, time.Now().Add(-time.Minute), "openid")
+	othersToken := newTestTokens(t, iss, other, time.Now().Add(time.Hour), "openid")
+	// 要求元クライアントを audience に含むトークン
+	audienceToken := newTestTokens(t, iss, other, time.Now().Add(time.Hour), "openid")
+	audienceToken.access.Details.Audience = []string{client.Identity.ClientId}
+	if err := dataprovider.Set(ctx, audienceToken.access); err != nil {
+		t.Fatal(err)
+	}
+	usedRefresh := newTestTokens(t, iss, client, time.Now().Add(time.Hour), "openid", "offline_access")
+	usedRefresh.refresh.Details.IsUsed = true
+	if err := dataprovider.Set(ctx, usedRefresh.refresh); err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		name      string
+		client    *model.Client
+		form      url.Values
+		wantError string
+		wantScope string
+	}
+
+	tests := []testCase{
+		{
+			name:   "Downscope an access token issued to the client",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"scope":              {"openid"},
+			},
+		},
+		{
+			name:   "Scope of the subject_token when omitted",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+			},
+			wantScope: "openid profile offline_access",
+		},
+		{
+			name:   "Refresh token as subject_token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.refresh.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeRefreshToken},
+				"scope":              {"openid"},
+			},
+		},
+		{
+			name:   "ID token as subject_token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.idToken},
+				"subject_token_type": {oauth.TokenTypeIdToken},
+				"scope":              {"openid"},
+			},
+		},
+		{
+			name:   "Token whose audience includes the client",
+			client: client,
+			form: url.Values{
+				"subject_token":      {audienceToken.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"scope":              {"openid"},
+			},
+		},
+		{
+			name:   "Expired ID token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {expiredIdToken.idToken},
+				"subject_token_type": {oauth.TokenTypeIdToken},
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Used refresh token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {usedRefresh.refresh.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeRefreshToken},
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Token type does not match",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeRefreshToken},
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Missing subject_token_type",
+			client: client,
+			form: url.Values{
+				"subject_token": {own.access.Details.Identifier},
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Unsupported requested_token_type",
+			client: client,
+			form: url.Values{
+				"subject_token":        {own.access.Details.Identifier},
+				"subject_token_type":   {oauth.TokenTypeAccessToken},
+				"requested_token_type": {oauth.TokenTypeIdToken},
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Scope broader than the subject_token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"scope":              {"openid email"},
+			},
+			wantError: oauth.TokenErrorInvalidScope,
+		},
+		{
+			name:   "Token issued to another client",
+			client: client,
+			form: url.Values{
+				"subject_token":      {othersToken.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+			},
+			wantError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name:   "Delegation with actor_token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"actor_token":        {othersToken.access.Details.Identifier},
+				"actor_token_type":   {oauth.TokenTypeAccessToken},
+			},
+			wantError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name:   "actor_token_type without actor_token",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"actor_token_type":   {oauth.TokenTypeAccessToken},
+			},
+			wantError: oauth.TokenErrorInvalidRequest,
+		},
+		{
+			name:   "Audience is not allowed",
+			client: client,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"audience":           {"https://api.example.com"},
+			},
+			wantError: oauth.TokenErrorInvalidTarget,
+		},
+		{
+			name:   "Client without the token-exchange grant",
+			client: noGrant,
+			form: url.Values{
+				"subject_token":      {own.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+			},
+			wantError: oauth.TokenErrorUnauthorizedClient,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.form.Set("grant_type", oauth.GrantTypeTokenExchange)
+			res, err := testProvider.Token(ctx, newTestTokenRequest(iss, tc.client, tc.form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantError != "" {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, tc.wantError, res.Msg.GetFail().Error.Error)
+				}
+				return
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+			assert.Equal(t, oauth.TokenTypeAccessToken, success.IssuedTokenType)
+			assert.Equal(t, oauth.AccessTokenTypeBearer, success.TokenType)
+			assert.Equal(t, tc.wantScope, success.Scope)
+
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: success.AccessToken,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			if assert.Nil(t, dataprovider.Get(ctx, access)) {
+				// 元の認可を引き継ぎ、要求元クライアントに発行される
+				assert.Equal(t, "user-1", access.Details.Authorized.Subject)
+				assert.Equal(t, tc.client.Identity.ClientId, access.Details.Authorized.Request.Client.Identity.ClientId)
+				assert.Len(t, access.Details.Lineage, 1)
+				assert.Nil(t, access.Details.Actor)
+			}
+		})
+	}
+}
+
+func TestTokenExchangeCallbackPolicy(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	client := newTestClient(t, iss, tokenExchangeClient)
+	actorClient := newTestClient(t, iss)
+	subject := newTestTokens(t, iss, client, time.Now().Add(time.Hour), "openid", "profile")
+	actor := newTestTokens(t, iss, actorClient, time.Now().Add(time.Hour), "openid")
+
+	type testCase struct {
+		name         string
+		policy       func(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error)
+		form         url.Values
+		wantError    string
+		wantAudience []string
+		wantActor    *model.TokenActor
+	}
+
+	tests := []testCase{
+		{
+			name: "Delegation allowed by the policy",
+			policy: func(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error) {
+				return &model.TokenExchangeDecision{Scopes: req.Scopes, Audience: req.Audience}, nil
+			},
+			form: url.Values{
+				"subject_token":      {subject.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"actor_token":        {actor.access.Details.Identifier},
+				"actor_token_type":   {oauth.TokenTypeAccessToken},
+				"audience":           {"https://api.example.com"},
+			},
+			wantAudience: []string{"https://api.example.com"},
+			wantActor: &model.TokenActor{
+				Subject:  "user-1",
+				ClientId: actorClient.Identity.ClientId,
+			},
+		},
+		{
+			name: "Policy grants a scope which was not requested",
+			policy: func(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error) {
+				return &model.TokenExchangeDecision{Scopes: []string{"openid", "email"}}, nil
+			},
+			form: url.Values{
+				"subject_token":      {subject.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+			},
+			wantError: oauth.TokenErrorInvalidScope,
+		},
+		{
+			name: "Policy rejects the target",
+			policy: func(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error) {
+				return nil, model.ErrInvalidTarget
+			},
+			form: url.Values{
+				"subject_token":      {subject.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+				"resource":           {"https://api.example.com"},
+			},
+			wantError: oauth.TokenErrorInvalidTarget,
+		},
+		{
+			name: "Policy rejects the exchange",
+			policy: func(req *model.TokenExchangeRequest) (*model.TokenExchangeDecision, error) {
+				return nil, errors.New("not allowed")
+			},
+			form: url.Values{
+				"subject_token":      {subject.access.Details.Identifier},
+				"subject_token_type": {oauth.TokenTypeAccessToken},
+			},
+			wantError: oauth.TokenErrorInvalidGrant,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewProvider(testExchangePolicy{
+				ProviderCallbacks: testProvider.callbacks,
+				policy:            tc.policy,
+			})
+			tc.form.Set("grant_type", oauth.GrantTypeTokenExchange)
+			res, err := p.Token(ctx, newTestTokenRequest(iss, client, tc.form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantError != "" {
+				if assert.NotNil(t, res.Msg.GetFail()) {
+					assert.Equal(t, tc.wantError, res.Msg.GetFail().Error.Error)
+				}
+				return
+			}
+			success := res.Msg.GetSuccess()
+			if !assert.NotNil(t, success) {
+				return
+			}
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: success.AccessToken,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			if assert.Nil(t, dataprovider.Get(ctx, access)) {
+				assert.Equal(t, tc.wantAudience, access.Details.Audience)
+				assert.Equal(t, tc.wantActor, access.Details.Actor)
+			}
+		})
+	}
+}
+
+func TestDefaultTokenExchangePolicy(t *testing.T) {
+	client := &model.Client{Identity: &oppb.ClientIdentity{ClientId: "client"}}
+	token := func(clientId string, audience ...string) *model.TokenIdentifier {
+		return &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Audience: audience,
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Client: &model.Client{Identity: &oppb.ClientIdentity{ClientId: clientId}},
+					},
+				},
+			},
+		}
+	}
+
+	type testCase struct {
+		name      string
+		req       *model.TokenExchangeRequest
+		wantError error
+	}
+
+	tests := []testCase{
+		{
+			name: "Own token",
+			req:  &model.TokenExchangeRequest{Client: client, SubjectToken: token("client"), Scopes: []string{"openid"}},
+		},
+		{
+			name: "Client in the audience",
+			req:  &model.TokenExchangeRequest{Client: client, SubjectToken: token("other", "client")},
+		},
+		{
+			name:      "Token of another client",
+			req:       &model.TokenExchangeRequest{Client: client, SubjectToken: token("other", "api")},
+			wantError: errors.New("subject_token was issued to another client"),
+		},
+		{
+			name:      "Delegation",
+			req:       &model.TokenExchangeRequest{Client: client, SubjectToken: token("client"), ActorToken: token("other")},
+			wantError: errors.New("delegation is not allowed"),
+		},
+		{
+			name:      "Resource",
+			req:       &model.TokenExchangeRequest{Client: client, SubjectToken: token("client"), Resource: []string{"https://api.example.com"}},
+			wantError: model.ErrInvalidTarget,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decision, err := defaultTokenExchangePolicy(tc.req)
+			if tc.wantError != nil {
+				assert.EqualError(t, err, tc.wantError.Error())
+				assert.Nil(t, decision)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.req.Scopes, decision.Scopes)
+				assert.Empty(t, decision.Audience)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+)
+
+type tokenIssueOptions struct {
+	TlsClientCertificate string
+	DpopJkt              string
+	// https://www.rfc-editor.org/rfc/rfc8707.html#section-2.2
+	// accessTokenAudience で解決したアクセストークンの audience（省略時は認可時のリソース）
+	Audience []string
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.3.1
+	// push モードの ID トークンには at_hash, rt_hash, auth_req_id を含める
+	PushAuthReqId string
+}
+
+// issueTokens creates the access token, the refresh token (offline_access) and
+// the ID token (openid) for a grant that the end-user has already authorized.
+// Callers run it within retryhelper.RetryIfError.
+func issueTokens(ctx context.Context, mapper model.SubjectMapper, iss *model.Issuer, authorized model.Authorized, opts tokenIssueOptions, now time.Time) (*oppb.TokenSuccessResponse, error) {
+	success := &oppb.TokenSuccessResponse{}
+
+	access, err := makeAccessTokenIdentifier(authorized, now, opts.TlsClientCertificate, opts.DpopJkt)
+	if err != nil {
+		log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
+		return nil, err
+	}
+	if len(opts.Audience) > 0 {
+		access.Details.Audience = opts.Audience
+	}
+	if err := dataprovider.Create(ctx, access); err != nil {
+		log.Printf("create access token error:%s", err.Error())
+		return nil, err
+	}
+	success.AccessToken, err = makeAccessToken(ctx, mapper, iss, access)
+	if err != nil {
+		log.Printf("makeAccessToken error:%s", err.Error())
+		return nil, err
+	}
+	success.ExpiresIn = authorized.Request.Client.Attribute.AccessTokenLifetimeSeconds
+	success.TokenType = accessTokenType(access)
+	// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+	success.AuthorizationDetails = authorized.AuthorizationDetails
+
+	if slices.Contains(authorized.Request.AuthParams.Scopes, "offline_access") {
+		refresh, err := makeRefreshTokenIdentifier(authorized, now, now, opts.DpopJkt)
+		if err != nil {
+			log.Printf("makeRefreshTokenIdentifier error:%s", err.Error())
+			return nil, err
+		}
+		if err := dataprovider.Create(ctx, refresh); err != nil {
+			log.Printf("create refresh token error:%s", err.Error())
+			return nil, err
+		}
+		success.RefreshToken = refresh.Details.Identifier
+	}
+
+	if slices.Contains(authorized.Request.AuthParams.Scopes, "openid") {
+		id, err := makeIdTokenIdentifier(authorized, now)
+		if err != nil {
+			log.Printf("makeIdTokenIdentifier error:%s", err.Error())
+			return nil, err
+		}
+		if err := dataprovider.Create(ctx, id); err != nil {
+			log.Printf("create idToken error:%s", err.Error())
+			return nil, err
+		}
+		accessToken := ""
+		if opts.PushAuthReqId != "" {
+			accessToken = success.AccessToken
+		}
+		claims, err := makeIdTokenClaims(ctx, mapper, iss, id, now, "", accessToken, "")
+		if err != nil {
+			return nil, err
+		}
+		if opts.PushAuthReqId != "" {
+			claims["urn:openid:params:jwt:claim:auth_req_id"] = opts.PushAuthReqId
+			if success.RefreshToken != "" {
+				if rtHash := createHash(authorized.Request.Client.Meta.IdTokenSignedResponseAlg, success.RefreshToken); rtHash != nil {
+					claims["urn:openid:params:jwt:claim:rt_hash"] = base64.RawURLEncoding.EncodeToString(rtHash)
+				}
+			}
+		}
+		success.IdToken, err = makeIdToken(ctx, iss, authorized.Request.Client, claims)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return success, nil
+}
@@ -0,0 +1,248 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/internal/query"
+	"github.com/Eigen438/opgo/internal/randutil"
+	"github.com/Eigen438/opgo/internal/retryhelper"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// https://www.rfc-editor.org/rfc/rfc7523.html#section-2.1
+func (p *Provider) jwtBearerGrant(ctx context.Context,
+	iss *model.Issuer,
+	msg *oppb.TokenRequest,
+	vals *query.Result,
+	tr *tokenRequest) (*connect.Response[oppb.TokenResponse], error) {
+	assertion := vals.Get("assertion")
+	if assertion == "" {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidRequest, "assertion is required"), nil
+	}
+
+	client, fail, err := identifyClient(ctx, iss, msg.BasicAuth, vals)
+	if err != nil {
+		return nil, err
+	} else if fail != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: fail,
+			},
+		}), nil
+	}
+
+	// エンドポイント認証チェック
+	params := &clientAuthentication{
+		AllowAudience: []string{
+			iss.Meta.TokenEndpoint,
+			iss.Meta.Issuer,
+		},
+		BasicAuth:                         msg.BasicAuth,
+		Client:                            client,
+		Issuer:                            iss,
+		Values:                            vals,
+		TlsClientCertificate:              msg.TlsClientCertificate,
+		TlsClientCertificateFromHandshake: msg.TlsClientCertificateFromHandshake,
+	}
+	if terr := p.checkClientAuthentication(ctx, params); terr != nil {
+		return connect.NewResponse(&oppb.TokenResponse{
+			TokenResponseOneof: &oppb.TokenResponse_Fail{
+				Fail: terr,
+			},
+		}), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+	dpopJkt, derr := checkDpopRequest(ctx, iss, params.Client, msg.Dpop, msg.Method, iss.Meta.TokenEndpoint)
+	if derr != nil {
+		return dpopTokenFail(derr), nil
+	}
+	if res := checkCertificateBoundRequest(params.Client, msg.TlsClientCertificate); res != nil {
+		return res, nil
+	}
+
+	cb, ok := p.callbacks.(model.JwtBearerCallbacks)
+	if !ok || !slices.Contains(client.Meta.GrantTypes, oauth.GrantTypeJwtBearer) {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorUnauthorizedClient, "jwt-bearer is not allowed for this client"), nil
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := parseJwt(ctx, client.Meta, assertion, claims)
+	if err != nil {
+		log.Printf("parseJwt error: %v", err)
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "assertion parse error"), nil
+	}
+	if desc := checkJwtBearerAssertion(iss, client, token, claims); desc != "" {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, desc), nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc7523.html#section-3
+	// The authorization server MAY ensure that JWTs are not replayed by maintaining the set of used "jti" values
+	exp, _ := claims.GetExpirationTime()
+	jti, _ := claims["jti"].(string)
+	used := &model.Assertion{
+		CreateAt: time.Now(),
+		Details: model.AssertionDetails{
+			Issuer:   iss.Key,
+			ClientId: client.Identity.ClientId,
+			Jti:      jti,
+		},
+		ExpireAt: exp.Time,
+	}
+	if err := dataprovider.Create(ctx, used); err != nil {
+		log.Printf("assertion create error:%v", err)
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "assertion jti has already been used"), nil
+	}
+
+	sub, _ := claims.GetSubject()
+	subject, userClaims, err := cb.JwtBearerSubjectCallback(ctx, &model.JwtBearerAssertion{
+		Client:  client,
+		Subject: sub,
+		Claims:  claims,
+	})
+	if err != nil {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidGrant, "assertion subject not accepted:"+err.Error()), nil
+	}
+
+	scopes, ok := grantedScopes(iss, client, tr.Scope)
+	if !ok {
+		return tokenFail(http.StatusBadRequest, oauth.TokenErrorInvalidScope, "scope not allowed:"+tr.Scope), nil
+	}
+
+	requestId, err := randutil.UuidV4()
+	if err != nil {
+		return nil, err
+	}
+	// アサーションによる認可のため、session は設定しない
+	authorized := model.Authorized{
+		AuthTime: time.Now(),
+		Claims:   userClaims,
+		Request: model.RequestDetails{
+			Key: &oppb.CommonKey{
+				Id: requestId,
+			},
+			Client: client,
+			AuthParams: &oppb.AuthorizationParameters{
+				ClientId: client.Identity.ClientId,
+				Scopes:   scopes,
+			},
+			Issuer: iss.Meta.Issuer,
+		},
+		Subject: subject,
+	}
+
+	success := &oppb.TokenSuccessResponse{}
+	if err := retryhelper.RetryIfError(ctx, retryCount, func(ctx context.Context) error {
+		access, err := makeAccessTokenIdentifier(authorized, time.Now(), msg.TlsClientCertificate, dpopJkt)
+		if err != nil {
+			log.Printf("makeAccessTokenIdentifier error:%s", err.Error())
+			return err
+		}
+		if err := dataprovider.Create(ctx, access); err != nil {
+			log.Printf("create access token error:%s", err.Error())
+			return err
+		}
+		success.AccessToken, err = makeAccessToken(ctx, p.subjectMapper(), iss, access)
+		if err != nil {
+			log.Printf("makeAccessToken error:%s", err.Error())
+			return err
+		}
+		success.ExpiresIn = client.Attribute.AccessTokenLifetimeSeconds
+		success.TokenType = accessTokenType(access)
+		return nil
+	}); err != nil {
+		log.Printf("[BACKEND_ERROR] DB write error(TokenIdentifier):%v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if strings.Join(scopes, " ") != tr.Scope {
+		success.Scope = strings.Join(scopes, " ")
+	}
+	return connect.NewResponse(&oppb.TokenResponse{
+		TokenResponseOneof: &oppb.TokenResponse_Success{
+			Success: success,
+		},
+	}), nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc7523.html#section-3
+// checkJwtBearerAssertion returns the error description if the assertion is not acceptable.
+func checkJwtBearerAssertion(iss *model.Issuer, client *model.Client, token *jwt.Token, claims jwt.MapClaims) string {
+	alg := fmt.Sprintf("%v", token.Header["alg"])
+	if alg == "none" {
+		return "unsigned assertion is not allowed"
+	}
+	if client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_1_0 ||
+		client.Extensions.Profile == oppb.EnumClientProfile_ENUM_CLIENT_PROFILE_FAPI_2_0 {
+		if slices.Contains(fapiRejectionAlg, alg) {
+			return "signing alg not allow:" + alg
+		}
+	}
+
+	// The JWT MUST contain an "iss" (issuer) claim that contains a unique identifier for the entity that issued the JWT.
+	// 検証鍵はクライアントのものなので、発行者はクライアント自身とする
+	if v, _ := claims.GetIssuer(); v != client.Identity.ClientId {
+		return "invalid claims(iss)"
+	}
+	// The JWT MUST contain a "sub" (subject) claim identifying the principal that is the subject of the JWT.
+	if v, _ := claims.GetSubject(); v == "" {
+		return "invalid claims(sub)"
+	}
+	// The JWT MUST contain an "aud" (audience) claim containing a value that identifies the authorization server as an intended audience.
+	aud, _ := claims.GetAudience()
+	if !slices.Contains(aud, iss.Meta.TokenEndpoint) && !slices.Contains(aud, iss.Meta.Issuer) {
+		return "invalid claims(aud)"
+	}
+	// The JWT MUST contain an "exp" (expiration time) claim that limits the time window during which the JWT can be used.
+	now := time.Now()
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return "invalid claims(exp)"
+	}
+	if now.After(exp.Time) {
+		return "assertion expired"
+	}
+	// The JWT MAY contain an "nbf" (not before) claim that identifies the time before which the token MUST NOT be accepted for processing.
+	if nbf, err := claims.GetNotBefore(); err != nil {
+		return "invalid claims(nbf)"
+	} else if nbf != nil && now.Before(nbf.Time) {
+		return "assertion not yet valid"
+	}
+	// 再利用防止のため jti は必須とする
+	if jti, _ := claims["jti"].(string); jti == "" {
+		return "invalid claims(jti)"
+	}
+	return ""
+}
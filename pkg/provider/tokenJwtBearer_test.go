@@ -0,0 +1,302 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/convert"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSigningKeyId = "ec-sig"
+
+// testJwtBearer maps the subject of the assertion to the local subject.
+type testJwtBearer struct {
+	model.ProviderCallbacks
+}
+
+func (testJwtBearer) JwtBearerSubjectCallback(_ context.Context, assertion *model.JwtBearerAssertion) (string, string, error) {
+	if assertion.Subject == "unknown-user" {
+		return "", "", fmt.Errorf("unknown subject")
+	}
+	return "local-" + assertion.Subject, `{"name":"Test User"}`, nil
+}
+
+// newTestSigningKey generates the client key and returns the jwks publishing the public key with use=sig.
+func newTestSigningKey(t *testing.T) (*ecdsa.PrivateKey, *oppb.Jwks) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := jwkset.NewJWKFromKey(key.Public(), jwkset.JWKOptions{
+		Metadata: jwkset.JWKMetadataOptions{
+			KID: testSigningKeyId,
+			USE: jwkset.UseSig,
+			ALG: jwkset.AlgES256,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, &oppb.Jwks{
+		Keys: convert.JwksFromJWKMarshals([]jwkset.JWKMarshal{jwk.Marshal()}),
+	}
+}
+
+// https://www.rfc-editor.org/rfc/rfc7523.html#section-3
+func TestJwtBearerGrant(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	p := NewProvider(testJwtBearer{ProviderCallbacks: testProvider.callbacks})
+	key, jwks := newTestSigningKey(t)
+	otherKey, _ := newTestSigningKey(t)
+
+	jwtBearerClient := func(c *model.Client) {
+		c.Meta.GrantTypes = append(c.Meta.GrantTypes, oauth.GrantTypeJwtBearer)
+		c.Meta.Jwks = jwks
+	}
+	sign := func(t *testing.T, claims jwt.MapClaims, key any) string {
+		method := jwt.SigningMethod(jwt.SigningMethodES256)
+		if key == nil {
+			method, key = jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType
+		}
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = testSigningKeyId
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	grant := func(t *testing.T, p *Provider, client *model.Client, assertion string) (*oppb.TokenSuccessResponse, *oppb.TokenFailResponse) {
+		res, err := p.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+			"grant_type": {oauth.GrantTypeJwtBearer},
+			"assertion":  {assertion},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg.GetSuccess(), res.Msg.GetFail()
+	}
+
+	type testCase struct {
+		name string
+		// claims は正しいアサーションのクレームを変更する
+		claims        func(claims jwt.MapClaims)
+		key           any
+		expectedError string
+	}
+
+	tests := []testCase{
+		{
+			name:   "Valid assertion",
+			claims: func(claims jwt.MapClaims) {},
+			key:    key,
+		},
+		{
+			name: "Issuer as the audience",
+			claims: func(claims jwt.MapClaims) {
+				claims["aud"] = iss.Meta.Issuer
+			},
+			key: key,
+		},
+		{
+			name: "iss other than the client",
+			claims: func(claims jwt.MapClaims) {
+				claims["iss"] = "another-client"
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "aud other than the authorization server",
+			claims: func(claims jwt.MapClaims) {
+				claims["aud"] = "https://another.example.com/token"
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Missing exp",
+			claims: func(claims jwt.MapClaims) {
+				delete(claims, "exp")
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Expired assertion",
+			claims: func(claims jwt.MapClaims) {
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Assertion not yet valid",
+			claims: func(claims jwt.MapClaims) {
+				claims["nbf"] = time.Now().Add(time.Minute).Unix()
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Missing jti",
+			claims: func(claims jwt.MapClaims) {
+				delete(claims, "jti")
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Missing sub",
+			claims: func(claims jwt.MapClaims) {
+				delete(claims, "sub")
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name:          "Unsigned assertion",
+			claims:        func(claims jwt.MapClaims) {},
+			key:           nil,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name:          "Assertion signed with an unregistered key",
+			claims:        func(claims jwt.MapClaims) {},
+			key:           otherKey,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+		{
+			name: "Subject not accepted by the callback",
+			claims: func(claims jwt.MapClaims) {
+				claims["sub"] = "unknown-user"
+			},
+			key:           key,
+			expectedError: oauth.TokenErrorInvalidGrant,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, jwtBearerClient)
+			claims := jwt.MapClaims{
+				"iss": client.Identity.ClientId,
+				"sub": "user-1",
+				"aud": iss.Meta.TokenEndpoint,
+				"exp": time.Now().Add(time.Minute).Unix(),
+				"iat": time.Now().Unix(),
+				"jti": "jti-" + client.Identity.ClientId,
+			}
+			tc.claims(claims)
+			success, fail := grant(t, p, client, sign(t, claims, tc.key))
+			if tc.expectedError != "" {
+				if assert.NotNil(t, fail) {
+					assert.Equal(t, tc.expectedError, fail.Error.Error)
+				}
+				return
+			}
+			if !assert.NotNil(t, success) {
+				return
+			}
+			access := &model.TokenIdentifier{
+				Details: model.TokenIdentifierDetails{
+					Identifier: success.AccessToken,
+					Authorized: model.Authorized{
+						Request: model.RequestDetails{
+							Client: &model.Client{Issuer: iss.Key},
+						},
+					},
+				},
+			}
+			if assert.NoError(t, dataprovider.Get(ctx, access)) {
+				assert.Equal(t, "local-user-1", access.Details.Authorized.Subject)
+				assert.Empty(t, access.Details.Authorized.SessionId)
+			}
+		})
+	}
+
+	t.Run("Replayed jti is rejected", func(t *testing.T) {
+		client := newTestClient(t, iss, jwtBearerClient)
+		claims := jwt.MapClaims{
+			"iss": client.Identity.ClientId,
+			"sub": "user-1",
+			"aud": iss.Meta.TokenEndpoint,
+			"exp": time.Now().Add(time.Minute).Unix(),
+			"jti": "replayed",
+		}
+		assertion := sign(t, claims, key)
+		success, _ := grant(t, p, client, assertion)
+		assert.NotNil(t, success)
+		_, fail := grant(t, p, client, assertion)
+		if assert.NotNil(t, fail) {
+			assert.Equal(t, oauth.TokenErrorInvalidGrant, fail.Error.Error)
+		}
+
+		// jti はクライアントごとに記録する
+		other := newTestClient(t, iss, jwtBearerClient)
+		claims["iss"] = other.Identity.ClientId
+		success, _ = grant(t, p, other, sign(t, claims, key))
+		assert.NotNil(t, success)
+	})
+
+	t.Run("Not allowed without the callback", func(t *testing.T) {
+		client := newTestClient(t, iss, jwtBearerClient)
+		_, fail := grant(t, testProvider, client, sign(t, jwt.MapClaims{
+			"iss": client.Identity.ClientId,
+			"sub": "user-1",
+			"aud": iss.Meta.TokenEndpoint,
+			"exp": time.Now().Add(time.Minute).Unix(),
+			"jti": "no-callback",
+		}, key))
+		if assert.NotNil(t, fail) {
+			assert.Equal(t, oauth.TokenErrorUnauthorizedClient, fail.Error.Error)
+		}
+	})
+}
+
+// https://www.rfc-editor.org/rfc/rfc7523.html#section-3
+// 区切り文字を含む client_id と jti の組み合わせで衝突しない
+func TestAssertionPath(t *testing.T) {
+	ctx := context.Background()
+	issuer := &oppb.CommonKey{Id: "issuer"}
+	a := model.Assertion{Details: model.AssertionDetails{Issuer: issuer, ClientId: "client a", Jti: "b"}}
+	b := model.Assertion{Details: model.AssertionDetails{Issuer: issuer, ClientId: "client", Jti: "a b"}}
+	assert.NotEqual(t, a.Path(ctx), b.Path(ctx))
+}
@@ -0,0 +1,215 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/oauth"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshTokenGrant(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+
+	refresh := func(client *model.Client, token string) (*oppb.TokenSuccessResponse, *oppb.TokenFailResponse) {
+		res, err := testProvider.Token(ctx, newTestTokenRequest(iss, client, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {token},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg.GetSuccess(), res.Msg.GetFail()
+	}
+	exists := func(client *model.Client, identifier string) bool {
+		return dataprovider.Get(ctx, &model.TokenIdentifier{
+			Details: model.TokenIdentifierDetails{
+				Identifier: identifier,
+				Authorized: model.Authorized{
+					Request: model.RequestDetails{
+						Client: &model.Client{Issuer: client.Issuer},
+					},
+				},
+			},
+		}) == nil
+	}
+
+	type testCase struct {
+		name      string
+		attribute func(attr *oppb.ClientAttribute)
+		createAt  time.Time
+		assert    func(assert *assert.Assertions, client *model.Client, token string)
+	}
+
+	tests := []testCase{
+		{
+			name: "Rotation issues a new refresh token and marks the old one used",
+			attribute: func(attr *oppb.ClientAttribute) {
+				attr.RefreshTokenRotation = true
+			},
+			createAt: time.Now(),
+			assert: func(assert *assert.Assertions, client *model.Client, token string) {
+				success, fail := refresh(client, token)
+				assert.Nil(fail)
+				if assert.NotNil(success) {
+					assert.NotEmpty(success.AccessToken)
+					assert.NotEmpty(success.RefreshToken)
+					assert.NotEqual(token, success.RefreshToken)
+				}
+				old := &model.TokenIdentifier{
+					Details: model.TokenIdentifierDetails{
+						Identifier: token,
+						Authorized: model.Authorized{
+							Request: model.RequestDetails{
+								Client: &model.Client{Issuer: client.Issuer},
+							},
+						},
+					},
+				}
+				assert.Nil(dataprovider.Get(ctx, old))
+				assert.True(old.Details.IsUsed)
+			},
+		},
+		{
+			name: "Reuse of a rotated refresh token revokes the token family",
+			attribute: func(attr *oppb.ClientAttribute) {
+				attr.RefreshTokenRotation = true
+			},
+			createAt: time.Now(),
+			assert: func(assert *assert.Assertions, client *model.Client, token string) {
+				first, fail := refresh(client, token)
+				assert.Nil(fail)
+				if !assert.NotNil(first) {
+					return
+				}
+				assert.True(exists(client, first.RefreshToken))
+				assert.True(exists(client, first.AccessToken))
+
+				_, fail = refresh(client, token)
+				if assert.NotNil(fail) {
+					assert.Equal(oauth.TokenErrorInvalidGrant, fail.Error.Error)
+				}
+				assert.False(exists(client, first.RefreshToken))
+				assert.False(exists(client, first.AccessToken))
+
+				// The rotated refresh token of the family can no longer be used
+				_, fail = refresh(client, first.RefreshToken)
+				assert.NotNil(fail)
+			},
+		},
+		{
+			name:      "Without rotation the refresh token can be used again",
+			attribute: func(attr *oppb.ClientAttribute) {},
+			createAt:  time.Now(),
+			assert: func(assert *assert.Assertions, client *model.Client, token string) {
+				for range 2 {
+					success, fail := refresh(client, token)
+					assert.Nil(fail)
+					assert.NotNil(success)
+				}
+			},
+		},
+		{
+			name: "Idle timeout expires the refresh token",
+			attribute: func(attr *oppb.ClientAttribute) {
+				attr.RefreshTokenIdleTimeoutSeconds = 60
+			},
+			createAt: time.Now().Add(-2 * time.Minute),
+			assert: func(assert *assert.Assertions, client *model.Client, token string) {
+				_, fail := refresh(client, token)
+				if assert.NotNil(fail) {
+					assert.Equal(oauth.TokenErrorInvalidGrant, fail.Error.Error)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				tc.attribute(c.Attribute)
+			})
+			authorized := newTestAuthorized(t, iss, client, "openid", "offline_access")
+			token, err := makeRefreshTokenIdentifier(authorized, tc.createAt, tc.createAt, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 作成日時に合わせて有効期限を設定する
+			token.ExpireAt = refreshTokenExpireAt(client.Attribute, tc.createAt, tc.createAt, tc.createAt)
+			if err := dataprovider.Create(ctx, token); err != nil {
+				t.Fatal(err)
+			}
+			tc.assert(assert.New(t), client, token.Details.Identifier)
+		})
+	}
+}
+
+func TestRefreshTokenExpireAt(t *testing.T) {
+	now := time.Now()
+
+	type testCase struct {
+		name      string
+		attribute *oppb.ClientAttribute
+		lastUsed  time.Time
+		family    time.Time
+		expected  time.Time
+	}
+
+	tests := []testCase{
+		{
+			name:      "Lifetime only",
+			attribute: &oppb.ClientAttribute{RefreshTokenLifetimeSeconds: 3600},
+			lastUsed:  now,
+			family:    now,
+			expected:  now.Add(time.Hour),
+		},
+		{
+			name:      "Idle timeout is earlier than the lifetime",
+			attribute: &oppb.ClientAttribute{RefreshTokenLifetimeSeconds: 3600, RefreshTokenIdleTimeoutSeconds: 600},
+			lastUsed:  now,
+			family:    now,
+			expected:  now.Add(10 * time.Minute),
+		},
+		{
+			name:      "Maximum lifetime of the family is the earliest",
+			attribute: &oppb.ClientAttribute{RefreshTokenLifetimeSeconds: 3600, RefreshTokenIdleTimeoutSeconds: 600, RefreshTokenMaxLifetimeSeconds: 7200},
+			lastUsed:  now,
+			family:    now.Add(-115 * time.Minute),
+			expected:  now.Add(5 * time.Minute),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, refreshTokenExpireAt(tc.attribute, now, tc.lastUsed, tc.family))
+		})
+	}
+}
@@ -46,12 +46,15 @@ func (p *Provider) Userinfo(ctx context.Context,
 	} else {
 		// アクセストークン取得
 		var accessToken = ""
+		var scheme = ""
 		// https://www.rfc-editor.org/rfc/rfc9110#name-authentication-scheme
 		// 認証スキームは大文字小文字を区別しない
 		authHeaderStrings := strings.Split(req.Msg.Authorization, " ")
-		if len(authHeaderStrings) == 2 && strings.ToLower(authHeaderStrings[0]) == "bearer" {
+		if len(authHeaderStrings) == 2 && (strings.ToLower(authHeaderStrings[0]) == "bearer" ||
+			(strings.ToLower(authHeaderStrings[0]) == "dpop" && dpopSupported(iss))) {
 			// Authorizationヘッダから取得
 			accessToken = authHeaderStrings[1]
+			scheme = strings.ToLower(authHeaderStrings[0])
 		} else if req.Msg.Method == http.MethodPost {
 			// Content-Typeチェック
 			if ct := req.Msg.ContentType; strings.HasPrefix(ct, httphelper.MimeTypeWwwFormUnlencoded) {
@@ -68,7 +71,7 @@ func (p *Provider) Userinfo(ctx context.Context,
 		// アクセストークン取得
 		access := &model.TokenIdentifier{
 			Details: model.TokenIdentifierDetails{
-				Identifier: accessToken,
+				Identifier: accessTokenIdentifier(ctx, iss, accessToken),
 				Authorized: model.Authorized{
 					Request: model.RequestDetails{
 						Client: &model.Client{
@@ -82,10 +85,34 @@ func (p *Provider) Userinfo(ctx context.Context,
 			return errorInvalidToken()
 		}
 
-		// FAPI ClientCertificate チェック
-		if access.Details.Authorized.Request.Client.Meta.TlsClientCertificateBoundAccessTokens {
-			if access.Details.TlsClientCertificate != req.Msg.TlsClientCertificate {
-				return errorInvalidRequest("Client certificate unmatch")
+		// client_credentials などエンドユーザーが存在しないトークンは使用できない
+		if access.Details.Authorized.Subject == "" {
+			return errorInsufficientScope("The access token was not issued for an end-user")
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc8705.html#section-3
+		// 証明書バインドトークンの場合はクライアント証明書を照合する
+		if !checkCertificateBinding(access, req.Msg.TlsClientCertificate) {
+			return errorInvalidToken()
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9449.html#section-7.1
+		// DPoP バインドトークンは DPoP スキームと proof でのみ使用できる
+		if access.Details.DpopJkt != "" || scheme == "dpop" {
+			if access.Details.DpopJkt == "" || scheme != "dpop" {
+				return errorDpop(iss, "invalid_token", "The access token is not bound to DPoP scheme", "")
+			}
+			jkt, derr := checkDpopProof(ctx, iss, &dpopProof{
+				Proof:       req.Msg.Dpop,
+				Method:      req.Msg.Method,
+				Uri:         iss.Meta.UserinfoEndpoint,
+				AccessToken: accessToken,
+			})
+			if derr != nil {
+				return errorDpop(iss, derr.Error, derr.Description, derr.Nonce)
+			}
+			if jkt != access.Details.DpopJkt {
+				return errorDpop(iss, "invalid_token", "The DPoP key does not match the access token", "")
 			}
 		}
 
@@ -101,17 +128,40 @@ func (p *Provider) Userinfo(ctx context.Context,
 		}
 
 		// 必須クレーム設定
-		u["sub"] = access.Details.Authorized.Subject
+		// https://openid.net/specs/openid-connect-core-1_0.html#SubjectIDTypes
+		sub, err := p.subjectMapper().ClientSubject(ctx, iss, access.Details.Authorized.Request.Client, access.Details.Authorized.Subject)
+		if err != nil {
+			return nil, err
+		}
+		u["sub"] = sub
 
-		if access.Details.Authorized.Request.Client.Meta.UserinfoSignedResponseAlg == "" {
+		// https://openid.net/specs/openid-connect-core-1_0.html#UserInfoResponse
+		// If the UserInfo Response is signed and/or encrypted, then the Claims are returned in a JWT
+		// and the content-type MUST be application/jwt.
+		client := access.Details.Authorized.Request.Client
+		if client.Meta.UserinfoSignedResponseAlg == "" && client.Meta.UserinfoEncryptedResponseAlg == "" {
 			return responseJson(u)
+		} else if client.Meta.UserinfoSignedResponseAlg == "" {
+			jwt, err := encryptClaims(ctx, client, u, client.Meta.UserinfoEncryptedResponseAlg, client.Meta.UserinfoEncryptedResponseEnc)
+			if err != nil {
+				return nil, err
+			}
+			return responseJwt(jwt)
 		} else {
 			u["iss"] = iss.Meta.Issuer
-			u["aud"] = access.Details.Authorized.Request.Client.Identity.ClientId
-			jwt, err := makeJwt(ctx, iss, u, access.Details.Authorized.Request.Client.Meta.UserinfoSignedResponseAlg)
+			u["aud"] = client.Identity.ClientId
+			jwt, err := makeJwt(ctx, iss, u, client.Meta.UserinfoSignedResponseAlg)
 			if err != nil {
 				return nil, err
 			}
+			if client.Meta.UserinfoEncryptedResponseAlg != "" {
+				// https://openid.net/specs/openid-connect-core-1_0.html#UserInfoResponse
+				// If signed and encrypted, the UserInfo Response MUST be signed then encrypted, with the result being a Nested JWT
+				jwt, err = encryptJwt(ctx, client, jwt, client.Meta.UserinfoEncryptedResponseAlg, client.Meta.UserinfoEncryptedResponseEnc)
+				if err != nil {
+					return nil, err
+				}
+			}
 			return responseJwt(jwt)
 		}
 	}
@@ -170,6 +220,23 @@ func errorInvalidToken() (*connect.Response[oppb.UserinfoResponse], error) {
 	}), nil
 }
 
+// https://datatracker.ietf.org/doc/html/rfc6750#section-3.1
+// insufficient_scope
+//
+//	The request requires higher privileges than provided by the
+//	access token.  The resource server SHOULD respond with the HTTP
+//	403 (Forbidden) status code and MAY include the "scope"
+//	attribute with the scope necessary to access the protected
+//	resource.
+func errorInsufficientScope(errorDescription string) (*connect.Response[oppb.UserinfoResponse], error) {
+	return connect.NewResponse(&oppb.UserinfoResponse{
+		Headers: map[string]string{
+			"WWW-Authenticate": fmt.Sprintf("Bearer realm=\"token_access\", error=\"insufficient_scope\", error_description=\"%s\"", errorDescription),
+		},
+		StatusCode: http.StatusForbidden,
+	}), nil
+}
+
 func responseJson(claims any) (*connect.Response[oppb.UserinfoResponse], error) {
 	b, err := json.MarshalIndent(claims, "", "  ")
 	if err != nil {
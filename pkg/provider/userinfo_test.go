@@ -0,0 +1,151 @@
+// MIT License
+//
+// Copyright (c) 2025 Eigen
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/Eigen438/dataprovider"
+	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
+	"github.com/Eigen438/opgo/pkg/httphelper"
+	"github.com/Eigen438/opgo/pkg/model"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserinfoResponse(t *testing.T) {
+	ctx := context.Background()
+	iss := newTestIssuer(t)
+	keys, jwks := newTestEncryptionKeys(t)
+
+	type testCase struct {
+		name            string
+		signedAlg       string
+		encryptedAlg    string
+		encryptedEnc    string
+		wantContentType string
+	}
+
+	tests := []testCase{
+		{
+			name:            "Plain JSON",
+			wantContentType: httphelper.MimeTypeJson,
+		},
+		{
+			name:            "Signed",
+			signedAlg:       "RS256",
+			wantContentType: httphelper.MimeTypeJwt,
+		},
+		{
+			name:            "Encrypted",
+			encryptedAlg:    "RSA-OAEP",
+			encryptedEnc:    "A128GCM",
+			wantContentType: httphelper.MimeTypeJwt,
+		},
+		{
+			name:            "Signed and encrypted",
+			signedAlg:       "ES256",
+			encryptedAlg:    "ECDH-ES+A128KW",
+			wantContentType: httphelper.MimeTypeJwt,
+		},
+		{
+			name:            "Encrypted with client_secret",
+			encryptedAlg:    "A128KW",
+			encryptedEnc:    "A128CBC-HS256",
+			wantContentType: httphelper.MimeTypeJwt,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, iss, func(c *model.Client) {
+				c.Meta.Jwks = jwks
+				c.Meta.UserinfoSignedResponseAlg = tc.signedAlg
+				c.Meta.UserinfoEncryptedResponseAlg = tc.encryptedAlg
+				c.Meta.UserinfoEncryptedResponseEnc = tc.encryptedEnc
+			})
+			access, err := makeAccessTokenIdentifier(newTestAuthorized(t, iss, client, "openid", "profile"), time.Now(), "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dataprovider.Create(ctx, access); err != nil {
+				t.Fatal(err)
+			}
+
+			req := connect.NewRequest(&oppb.UserinfoRequest{
+				Authorization: "Bearer " + access.Details.Identifier,
+				Method:        http.MethodGet,
+			})
+			auth.SetAuth(req, auth.NewAuthInfo(iss.Key.Id, testIssuerPassword))
+			res, err := testProvider.Userinfo(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, int32(http.StatusOK), res.Msg.StatusCode)
+			assert.Contains(t, res.Msg.Headers[httphelper.HeaderContentType], tc.wantContentType)
+
+			body := res.Msg.Body
+			if tc.encryptedAlg != "" {
+				enc := tc.encryptedEnc
+				if enc == "" {
+					enc = defaultEncryptionEnc
+				}
+				if !assert.True(t, isJwe(body)) {
+					return
+				}
+				var header jose.Header
+				body, header = decryptTestJwe(t, body, keys.decryptionKey(client, tc.encryptedAlg, enc))
+				if tc.signedAlg != "" {
+					// https://openid.net/specs/openid-connect-core-1_0.html#UserInfoResponse
+					// 署名してから暗号化した Nested JWT
+					assert.Equal(t, "JWT", header.ExtraHeaders[jose.HeaderContentType])
+				} else {
+					assert.Nil(t, header.ExtraHeaders[jose.HeaderContentType])
+				}
+			}
+
+			claims := jwt.MapClaims{}
+			if tc.signedAlg != "" {
+				_, err := jwt.NewParser(jwt.WithValidMethods([]string{tc.signedAlg})).
+					ParseWithClaims(body, claims, keyutil.GetKeyfunc(ctx, iss.Key))
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, iss.Meta.Issuer, claims["iss"])
+				assert.Equal(t, client.Identity.ClientId, claims["aud"])
+			} else if err := json.Unmarshal([]byte(body), &claims); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "user-1", claims["sub"])
+			assert.Equal(t, "Test User", claims["name"])
+		})
+	}
+}
@@ -30,6 +30,8 @@ import (
 	"connectrpc.com/connect"
 	"github.com/Eigen438/dataprovider"
 	"github.com/Eigen438/opgo/internal/auth"
+	"github.com/Eigen438/opgo/internal/keyutil"
+	"github.com/Eigen438/opgo/internal/oauth"
 	"github.com/Eigen438/opgo/pkg/auto-generated/oppb/v1"
 	"github.com/Eigen438/opgo/pkg/model"
 )
@@ -55,16 +57,57 @@ func (rest *Rest) ClientCreate(ctx context.Context,
 				return nil, fmt.Errorf("id_token_signed_response_alg:%s not supported", v)
 			}
 		}
+		if v := req.Msg.Meta.IdTokenEncryptedResponseAlg; len(v) > 0 {
+			if !slices.Contains(iss.Meta.IdTokenEncryptionAlgValuesSupported, v) {
+				return nil, fmt.Errorf("id_token_encrypted_response_alg:%s not supported", v)
+			}
+		}
+		if v := req.Msg.Meta.IdTokenEncryptedResponseEnc; len(v) > 0 {
+			// https://openid.net/specs/openid-connect-registration-1_0-errata2.html#ClientMetadata
+			// When id_token_encrypted_response_enc is included, id_token_encrypted_response_alg MUST also be provided.
+			if req.Msg.Meta.IdTokenEncryptedResponseAlg == "" {
+				return nil, fmt.Errorf("id_token_encrypted_response_enc requires id_token_encrypted_response_alg")
+			}
+			if !slices.Contains(iss.Meta.IdTokenEncryptionEncValuesSupported, v) {
+				return nil, fmt.Errorf("id_token_encrypted_response_enc:%s not supported", v)
+			}
+		}
 		if v := req.Msg.Meta.UserinfoSignedResponseAlg; len(v) > 0 {
 			if !slices.Contains(iss.Meta.UserinfoSigningAlgValuesSupported, v) {
 				return nil, fmt.Errorf("userinfo_signed_response_alg:%s not supported", v)
 			}
 		}
+		if v := req.Msg.Meta.UserinfoEncryptedResponseAlg; len(v) > 0 {
+			if !slices.Contains(iss.Meta.UserinfoEncryptionAlgValuesSupported, v) {
+				return nil, fmt.Errorf("userinfo_encrypted_response_alg:%s not supported", v)
+			}
+		}
+		if v := req.Msg.Meta.UserinfoEncryptedResponseEnc; len(v) > 0 {
+			if req.Msg.Meta.UserinfoEncryptedResponseAlg == "" {
+				return nil, fmt.Errorf("userinfo_encrypted_response_enc requires userinfo_encrypted_response_alg")
+			}
+			if !slices.Contains(iss.Meta.UserinfoEncryptionEncValuesSupported, v) {
+				return nil, fmt.Errorf("userinfo_encrypted_response_enc:%s not supported", v)
+			}
+		}
 		if v := req.Msg.Meta.RequestObjectSigningAlg; len(v) > 0 {
 			if !slices.Contains(iss.Meta.RequestObjectSigningAlgValuesSupported, v) {
 				return nil, fmt.Errorf("request_object_signed_response_alg:%s not supported", v)
 			}
 		}
+		if v := req.Msg.Meta.RequestObjectEncryptionAlg; len(v) > 0 {
+			if !slices.Contains(iss.Meta.RequestObjectEncryptionAlgValuesSupported, v) {
+				return nil, fmt.Errorf("request_object_encryption_alg:%s not supported", v)
+			}
+		}
+		if v := req.Msg.Meta.RequestObjectEncryptionEnc; len(v) > 0 {
+			if req.Msg.Meta.RequestObjectEncryptionAlg == "" {
+				return nil, fmt.Errorf("request_object_encryption_enc requires request_object_encryption_alg")
+			}
+			if !slices.Contains(iss.Meta.RequestObjectEncryptionEncValuesSupported, v) {
+				return nil, fmt.Errorf("request_object_encryption_enc:%s not supported", v)
+			}
+		}
 		if v := req.Msg.Meta.TokenEndpointAuthMethod; len(v) > 0 {
 			if !slices.Contains(iss.Meta.TokenEndpointAuthMethodsSupported, v) {
 				return nil, fmt.Errorf("token_endpoint_auth_method:%s not supported", v)
@@ -80,6 +123,101 @@ func (rest *Rest) ClientCreate(ctx context.Context,
 				return nil, fmt.Errorf("authorization_signed_response_alg:%s not supported", v)
 			}
 		}
+		if v := req.Msg.Meta.AuthorizationEncryptedResponseAlg; len(v) > 0 {
+			if !slices.Contains(iss.Meta.AuthorizationEncryptionAlgValuesSupported, v) {
+				return nil, fmt.Errorf("authorization_encrypted_response_alg:%s not supported", v)
+			}
+		}
+		if v := req.Msg.Meta.AuthorizationEncryptedResponseEnc; len(v) > 0 {
+			if req.Msg.Meta.AuthorizationEncryptedResponseAlg == "" {
+				return nil, fmt.Errorf("authorization_encrypted_response_enc requires authorization_encrypted_response_alg")
+			}
+			if !slices.Contains(iss.Meta.AuthorizationEncryptionEncValuesSupported, v) {
+				return nil, fmt.Errorf("authorization_encrypted_response_enc:%s not supported", v)
+			}
+		}
+
+		if v := req.Msg.Meta.IntrospectionSignedResponseAlg; len(v) > 0 {
+			if !slices.Contains(iss.Meta.IntrospectionSigningAlgValuesSupported, v) {
+				return nil, fmt.Errorf("introspection_signed_response_alg:%s not supported", v)
+			}
+		}
+
+		if v := req.Msg.Meta.BackchannelLogoutUri; len(v) > 0 {
+			if !iss.Meta.BackchannelLogoutSupported {
+				return nil, fmt.Errorf("backchannel_logout_uri:%s not supported", v)
+			}
+		}
+
+		if v := req.Msg.Meta.FrontchannelLogoutUri; len(v) > 0 {
+			if !iss.Meta.FrontchannelLogoutSupported {
+				return nil, fmt.Errorf("frontchannel_logout_uri:%s not supported", v)
+			}
+		}
+
+		if req.Msg.Meta.TokenEndpointAuthMethod == oauth.TokenEndpointAuthMethodTlsClientAuth {
+			// https://www.rfc-editor.org/rfc/rfc8705.html#section-2.1.2
+			// A client using the "tls_client_auth" authentication method MUST use exactly one of the below metadata parameters
+			count := 0
+			for _, v := range []string{
+				req.Msg.Meta.TlsClientAuthSubjectDn,
+				req.Msg.Meta.TlsClientAuthSanDns,
+				req.Msg.Meta.TlsClientAuthSanUri,
+				req.Msg.Meta.TlsClientAuthSanIp,
+				req.Msg.Meta.TlsClientAuthSanEmail,
+			} {
+				if len(v) > 0 {
+					count++
+				}
+			}
+			if count != 1 {
+				return nil, fmt.Errorf("tls_client_auth requires exactly one of tls_client_auth_subject_dn, tls_client_auth_san_dns, tls_client_auth_san_uri, tls_client_auth_san_ip and tls_client_auth_san_email")
+			}
+		}
+
+		if v := req.Msg.Meta.BackchannelTokenDeliveryMode; len(v) > 0 {
+			if !slices.Contains(iss.Meta.BackchannelTokenDeliveryModesSupported, v) {
+				return nil, fmt.Errorf("backchannel_token_delivery_mode:%s not supported", v)
+			}
+		}
+
+		if v := req.Msg.Meta.SubjectType; len(v) > 0 {
+			if !slices.Contains(iss.Meta.SubjectTypesSupported, v) {
+				return nil, fmt.Errorf("subject_type:%s not supported", v)
+			}
+			// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+			if v == "pairwise" {
+				if iss.Secret.GetPairwiseSalt() == "" {
+					return nil, fmt.Errorf("subject_type:pairwise requires the pairwise salt of the issuer")
+				}
+				if _, err := model.SectorIdentifier(req.Msg.Meta); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+		if req.Msg.Meta.DpopBoundAccessTokens && len(iss.Meta.DpopSigningAlgValuesSupported) == 0 {
+			return nil, fmt.Errorf("dpop_bound_access_tokens not supported")
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9396.html#section-10
+		for _, v := range req.Msg.Meta.AuthorizationDetailsTypes {
+			if !slices.Contains(iss.Meta.AuthorizationDetailsTypesSupported, v) {
+				return nil, fmt.Errorf("authorization_details_types:%s not supported", v)
+			}
+		}
+
+		// https://www.rfc-editor.org/rfc/rfc9068.html#section-2.1
+		if v := req.Msg.Attribute.GetAccessTokenSignedResponseAlg(); len(v) > 0 {
+			keyType, ok := keyutil.KeyType(v)
+			if !ok {
+				return nil, fmt.Errorf("access_token_signed_response_alg:%s not supported", v)
+			}
+			if _, ok := iss.Resources.KeyMap[keyType]; !ok {
+				return nil, fmt.Errorf("access_token_signed_response_alg:%s no signing key", v)
+			}
+		}
 
 		client := &model.Client{
 			Identity:   req.Msg.Identity,
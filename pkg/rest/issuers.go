@@ -88,6 +88,14 @@ func (rest *Rest) IssuerCreate(ctx context.Context,
 	if rest.isSingleTenant {
 		_ = dataprovider.Get(ctx, iss)
 	}
+	if iss.Secret.PairwiseSalt == "" {
+		// https://openid.net/specs/openid-connect-core-1_0.html#PairwiseAlg
+		salt, err := randutil.String(32, randutil.RunesNumAndAlpha)
+		if err != nil {
+			return nil, err
+		}
+		iss.Secret.PairwiseSalt = salt
+	}
 
 	if req.Msg.Meta != nil {
 		iss.Meta = req.Msg.Meta
@@ -113,6 +121,12 @@ func (rest *Rest) IssuerCreate(ctx context.Context,
 			keyTypes[keyType] = true
 		}
 	}
+	// Generate encryption keys for the Issuer to decrypt request objects
+	for _, alg := range iss.Meta.RequestObjectEncryptionAlgValuesSupported {
+		if keyType, ok := keyutil.EncryptionKeyType(alg); ok {
+			keyTypes[keyType] = true
+		}
+	}
 	for keyType, ok := range keyTypes {
 		if ok {
 			if _, ok := iss.Resources.KeyMap[keyType]; ok {
@@ -38,7 +38,25 @@ import (
 
 func AppendHandlerFunc(mux *http.ServeMux, sdk opgo.Sdk) {
 	mux.HandleFunc("/login", loginHandler(sdk))
+	mux.HandleFunc("/consent", consentHandler(sdk))
 	mux.HandleFunc("/cancel", cancelHandler(sdk))
+	mux.HandleFunc("/logout_confirm", logoutConfirmHandler(sdk))
+}
+
+func logoutConfirmHandler(s opgo.Sdk) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// You need to get the logoutId from the browser.
+		logoutId := r.FormValue("logout_id")
+		s.EndSessionConfirm(w, r, logoutId)
+	}
+}
+
+func consentHandler(s opgo.Sdk) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// You need to get the requestId from the browser.
+		requestId := r.FormValue("request_id")
+		s.AuthorizationConsent(w, r, requestId)
+	}
 }
 
 func cancelHandler(s opgo.Sdk) http.HandlerFunc {
@@ -98,6 +116,30 @@ func (c Callbacks) WriteLoginHtmlCallback(info *opgo.RequestInfo) http.HandlerFu
 	}
 }
 
+//go:embed consent.html
+var consentHtml []byte
+
+func (Callbacks) WriteConsentHtmlCallback(info *opgo.RequestInfo) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t := template.Must(template.New("consent").Parse(string(consentHtml)))
+		if err := t.Execute(w, info); err != nil {
+			log.Printf("t.Execute err:%v", err)
+		}
+	}
+}
+
+//go:embed logout.html
+var logoutHtml []byte
+
+func (Callbacks) WriteLogoutHtmlCallback(info *opgo.LogoutInfo) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t := template.Must(template.New("logout").Parse(string(logoutHtml)))
+		if err := t.Execute(w, info); err != nil {
+			log.Printf("t.Execute err:%v", err)
+		}
+	}
+}
+
 func (Callbacks) GetUserClaimsCallback(_ context.Context, subject string) (string, error) {
 	if subject == "abcdef12345" {
 		c := map[string]interface{}{
@@ -36,10 +36,13 @@ import (
 func (i *innerSdk) PushedAuthorizationEndpoint(w http.ResponseWriter, r *http.Request) {
 	if err := func() error {
 		ctx := r.Context()
+		cert, handshake := i.clientCertificate(r)
 		req := connect.NewRequest(&oppb.PushedAuthorizationRequest{
-			ContentType:          r.Header.Get(httphelper.HeaderContentType),
-			Method:               r.Method,
-			TlsClientCertificate: r.Header.Get("X-Client-Cert-Hash"),
+			ContentType:                       r.Header.Get(httphelper.HeaderContentType),
+			Method:                            r.Method,
+			TlsClientCertificate:              cert,
+			TlsClientCertificateFromHandshake: handshake,
+			Dpop:                              dpopProof(r),
 		})
 		// Get form
 		defer r.Body.Close()
@@ -60,6 +63,7 @@ func (i *innerSdk) PushedAuthorizationEndpoint(w http.ResponseWriter, r *http.Re
 		if err != nil {
 			return err
 		}
+		setDpopNonce(w, res.Msg.DpopNonce)
 		if fail := res.Msg.GetFail(); fail != nil {
 			body, err := json.MarshalIndent(fail.Error, "", "  ")
 			if err != nil {
@@ -24,6 +24,7 @@ package opgo
 
 import (
 	"context"
+	"encoding/json"
 
 	"connectrpc.com/connect"
 	"github.com/Eigen438/opgo/internal/auth"
@@ -39,9 +40,20 @@ func (i *innerSdk) GetRequestInfo(ctx context.Context, requestId string) (*Reque
 	if err != nil {
 		return nil, err
 	}
-	return &RequestInfo{
+	info := newRequestInfo(requestId, res.Msg.Client, res.Msg.AuthParams)
+	info.Subject = res.Msg.Subject
+	return info, nil
+}
+
+func newRequestInfo(requestId string, client *oppb.ClientMeta, params *oppb.AuthorizationParameters) *RequestInfo {
+	info := &RequestInfo{
 		RequestId:  requestId,
-		Client:     res.Msg.Client,
-		AuthParams: res.Msg.AuthParams,
-	}, nil
+		Client:     client,
+		AuthParams: params,
+	}
+	if v := params.GetAuthorizationDetails(); v != "" {
+		// validated by the provider
+		json.Unmarshal([]byte(v), &info.AuthorizationDetails)
+	}
+	return info
 }
@@ -47,6 +47,28 @@ type RequestInfo struct {
 	Client *oppb.ClientMeta
 	// AuthParams contains the authorization parameters for the request.
 	AuthParams *oppb.AuthorizationParameters
+	// AuthorizationDetails is the requested authorization_details (RFC 9396) to be shown to the end-user.
+	AuthorizationDetails []map[string]any
+	// Subject is the end-user whose consent is requested. It is empty until the consent is requested.
+	Subject string
+}
+
+// LogoutInfo holds information about an RP-initiated logout request.
+type LogoutInfo struct {
+	// LogoutId is the unique identifier for the logout request.
+	LogoutId string
+	// ClientId is the client requesting the logout. It is empty if the client was not identified.
+	ClientId string
+	// Client contains metadata about the client. It is nil if the client was not identified.
+	Client *oppb.ClientMeta
+	// PostLogoutRedirectUri is the URI to redirect to after the logout.
+	PostLogoutRedirectUri string
+	// LogoutHint is the logout_hint parameter of the request.
+	LogoutHint string
+	// UiLocales is the ui_locales parameter of the request.
+	UiLocales []string
+	// Subject is the subject of the id_token_hint. It is empty if the hint was not presented.
+	Subject string
 }
 
 // SdkCallbacks defines the callbacks for the SDK.
@@ -63,6 +85,90 @@ type SdkCallbacks interface {
 	WriteLoginHtmlCallback(info *RequestInfo) http.HandlerFunc
 }
 
+// ConsentCallbacks can optionally be implemented by SdkCallbacks
+// to ask the end-user for consent. If it is not implemented,
+// the consent is granted without confirmation.
+type ConsentCallbacks interface {
+	// WriteConsentHtmlCallback writes the consent HTML response.
+	// info is the RequestInfo containing request details and the subject of the end-user.
+	// It returns an http.HandlerFunc that serves the HTML response,
+	// or nil to grant the consent without confirmation.
+	// The consent must be completed by calling Sdk.AuthorizationConsent,
+	// or denied by calling Sdk.AuthorizationCancel.
+	WriteConsentHtmlCallback(info *RequestInfo) http.HandlerFunc
+}
+
+// LogoutCallbacks can optionally be implemented by SdkCallbacks
+// to ask the end-user to confirm the logout. If it is not implemented,
+// the logout is completed without confirmation.
+type LogoutCallbacks interface {
+	// WriteLogoutHtmlCallback writes the logout confirmation HTML response.
+	// info is the LogoutInfo containing logout request details.
+	// It returns an http.HandlerFunc that serves the HTML response,
+	// or nil to log out without confirmation.
+	// The confirmed logout must be completed by calling Sdk.EndSessionConfirm.
+	WriteLogoutHtmlCallback(info *LogoutInfo) http.HandlerFunc
+}
+
+// AuthorizationDetailsCallbacks can optionally be implemented by SdkCallbacks
+// to enrich the authorization_details (RFC 9396) approved by the end-user.
+type AuthorizationDetailsCallbacks interface {
+	// EnrichAuthorizationDetailsCallback returns the authorization_details(json array string) to be granted.
+	// ctx is the context for the request.
+	// info is the RequestInfo containing the requested authorization_details.
+	// subject is the subject of the end-user.
+	EnrichAuthorizationDetailsCallback(ctx context.Context, info *RequestInfo, subject string) (string, error)
+}
+
+// BackchannelLogoutCallbacks can optionally be implemented by SdkCallbacks
+// to receive the delivery outcomes of back-channel logout tokens.
+type BackchannelLogoutCallbacks interface {
+	// BackchannelLogoutResultCallback is called after a session has ended.
+	// ctx is the context for the request.
+	// results contains one entry per client notified.
+	BackchannelLogoutResultCallback(ctx context.Context, results []*oppb.BackchannelLogoutResult)
+}
+
+// ErrUnknownUser is returned by NotifyBackchannelAuthenticationCallback
+// when the end-user indicated by the hint can not be identified.
+var ErrUnknownUser = errors.New("unknown user")
+
+// BackchannelAuthenticationInfo holds information about a CIBA authentication request.
+type BackchannelAuthenticationInfo struct {
+	// AuthReqId is the unique identifier for the authentication request.
+	AuthReqId string
+	// ClientId is the client requesting the authentication.
+	ClientId string
+	// Client contains metadata about the client.
+	Client *oppb.ClientMeta
+	// Scopes is the requested scopes.
+	Scopes []string
+	// AcrValues is the requested acr_values.
+	AcrValues []string
+	// LoginHint is the login_hint parameter of the request.
+	LoginHint string
+	// LoginHintToken is the login_hint_token parameter of the request.
+	LoginHintToken string
+	// Subject is the subject of the id_token_hint. It is empty if the hint was not presented.
+	Subject string
+	// BindingMessage is the binding_message to be shown to the end-user.
+	BindingMessage string
+	// UserCode is the user_code parameter of the request.
+	UserCode string
+}
+
+// BackchannelAuthenticationCallbacks can optionally be implemented by SdkCallbacks
+// to accept CIBA authentication requests.
+type BackchannelAuthenticationCallbacks interface {
+	// NotifyBackchannelAuthenticationCallback notifies the end-user of an authentication request.
+	// ctx is the context for the request.
+	// info is the BackchannelAuthenticationInfo containing request details.
+	// It returns ErrUnknownUser if the end-user can not be identified.
+	// The result must be completed by calling Sdk.BackchannelAuthenticationIssue or
+	// Sdk.BackchannelAuthenticationCancel.
+	NotifyBackchannelAuthenticationCallback(ctx context.Context, info *BackchannelAuthenticationInfo) error
+}
+
 // Sdk defines the interface for the OPGo SDK.
 // It provides methods for handling OpenID Connect endpoints, as well as other
 // management tasks.
@@ -82,6 +188,23 @@ type Sdk interface {
 	RegistrationEndpoint(w http.ResponseWriter, r *http.Request)
 	// PushedAuthorizationEndpoint handles the OpenID Connect pushed authorization endpoint.
 	PushedAuthorizationEndpoint(w http.ResponseWriter, r *http.Request)
+	// RevocationEndpoint handles the OAuth 2.0 token revocation endpoint (RFC 7009).
+	RevocationEndpoint(w http.ResponseWriter, r *http.Request)
+	// IntrospectionEndpoint handles the OAuth 2.0 token introspection endpoint (RFC 7662).
+	// A JWT response (RFC 9701) is returned when requested with the Accept header.
+	IntrospectionEndpoint(w http.ResponseWriter, r *http.Request)
+	// EndSessionEndpoint handles the OpenID Connect RP-initiated logout endpoint.
+	EndSessionEndpoint(w http.ResponseWriter, r *http.Request)
+	// CheckSessionIframeEndpoint serves the OpenID Connect Session Management OP iframe.
+	CheckSessionIframeEndpoint(w http.ResponseWriter, r *http.Request)
+	// DeviceAuthorizationEndpoint handles the OAuth 2.0 device authorization endpoint (RFC 8628).
+	DeviceAuthorizationEndpoint(w http.ResponseWriter, r *http.Request)
+	// DeviceVerificationEndpoint serves the end-user verification page of the device authorization grant.
+	// After the user_code is accepted, the login page is written with WriteLoginHtmlCallback.
+	DeviceVerificationEndpoint(w http.ResponseWriter, r *http.Request)
+	// BackchannelAuthenticationEndpoint handles the OpenID Connect CIBA backchannel authentication endpoint.
+	// The end-user is notified with BackchannelAuthenticationCallbacks.
+	BackchannelAuthenticationEndpoint(w http.ResponseWriter, r *http.Request)
 
 	// AuthorizationIssue issues an authorization request.
 	// w is the http.ResponseWriter to write the response to.
@@ -90,12 +213,36 @@ type Sdk interface {
 	// subject is the subject of the authorization request.
 	AuthorizationIssue(w http.ResponseWriter, r *http.Request, requestId, subject string)
 
+	// AuthorizationConsent completes an authorization request consented by the end-user.
+	// The granted scopes and claims are remembered, so repeat logins skip the consent.
+	// w is the http.ResponseWriter to write the response to.
+	// r is the http.Request containing the request data.
+	// requestId is the ID of the authorization request.
+	AuthorizationConsent(w http.ResponseWriter, r *http.Request, requestId string)
+
 	// AuthorizationCancel cancels an authorization request.
 	// w is the http.ResponseWriter to write the response to.
 	// r is the http.Request containing the request data.
 	// requestId is the ID of the authorization request to cancel.
 	AuthorizationCancel(w http.ResponseWriter, r *http.Request, requestId string)
 
+	// BackchannelAuthenticationIssue approves a CIBA authentication request.
+	// ctx is the context for the request.
+	// authReqId is the auth_req_id of the authentication request.
+	// subject is the subject of the authenticated end-user.
+	BackchannelAuthenticationIssue(ctx context.Context, authReqId, subject string) error
+
+	// BackchannelAuthenticationCancel denies a CIBA authentication request.
+	// ctx is the context for the request.
+	// authReqId is the auth_req_id of the authentication request to deny.
+	BackchannelAuthenticationCancel(ctx context.Context, authReqId string) error
+
+	// EndSessionConfirm terminates the sessions of a confirmed logout request.
+	// w is the http.ResponseWriter to write the response to.
+	// r is the http.Request containing the request data.
+	// logoutId is the ID of the logout request.
+	EndSessionConfirm(w http.ResponseWriter, r *http.Request, logoutId string)
+
 	// WriteLoginHtml writes the login HTML response.
 	// w is the http.ResponseWriter to write the HTML to.
 	// r is the http.Request containing the request data.
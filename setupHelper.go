@@ -25,13 +25,20 @@ package opgo
 import "net/http"
 
 const (
-	DEFAULT_DISCOVERY_PATH            = "/.well-known/openid-configuration"
-	DEFAULT_JWKS_PATH                 = "/.well-known/jwks.json"
-	DEFAULT_AUTHORIZATION_PATH        = "/authorize"
-	DEFAULT_TOKEN_PATH                = "/token"
-	DEFAULT_USERINFO_PATH             = "/userinfo"
-	DEFAULT_REGISTRATION_PATH         = "/registration"
-	DEFAULT_PUSHED_AUTHORIZATION_PATH = "/par"
+	DEFAULT_DISCOVERY_PATH                  = "/.well-known/openid-configuration"
+	DEFAULT_JWKS_PATH                       = "/.well-known/jwks.json"
+	DEFAULT_AUTHORIZATION_PATH              = "/authorize"
+	DEFAULT_TOKEN_PATH                      = "/token"
+	DEFAULT_USERINFO_PATH                   = "/userinfo"
+	DEFAULT_REGISTRATION_PATH               = "/registration"
+	DEFAULT_PUSHED_AUTHORIZATION_PATH       = "/par"
+	DEFAULT_REVOCATION_PATH                 = "/revoke"
+	DEFAULT_INTROSPECTION_PATH              = "/introspect"
+	DEFAULT_END_SESSION_PATH                = "/logout"
+	DEFAULT_CHECK_SESSION_IFRAME_PATH       = "/check_session"
+	DEFAULT_DEVICE_AUTHORIZATION_PATH       = "/device_authorization"
+	DEFAULT_DEVICE_VERIFICATION_PATH        = "/device"
+	DEFAULT_BACKCHANNEL_AUTHENTICATION_PATH = "/bc-authorize"
 )
 
 // SetupHelper is a helper for setting up the OpenID Connect server.
@@ -51,6 +58,20 @@ type SetupHelper struct {
 	RegistrationPath string
 	// PushedAuthorizationPath is the path for the pushed authorization endpoint.
 	PushedAuthorizationPath string
+	// RevocationPath is the path for the token revocation endpoint.
+	RevocationPath string
+	// IntrospectionPath is the path for the token introspection endpoint.
+	IntrospectionPath string
+	// EndSessionPath is the path for the end session (logout) endpoint.
+	EndSessionPath string
+	// CheckSessionIframePath is the path for the check session iframe.
+	CheckSessionIframePath string
+	// DeviceAuthorizationPath is the path for the device authorization endpoint.
+	DeviceAuthorizationPath string
+	// DeviceVerificationPath is the path for the device user verification page.
+	DeviceVerificationPath string
+	// BackchannelAuthenticationPath is the path for the CIBA backchannel authentication endpoint.
+	BackchannelAuthenticationPath string
 }
 
 func (helper SetupHelper) useDiscovery() bool {
@@ -87,6 +108,34 @@ func (helper SetupHelper) pushedAuthorizationPath() string {
 	return helper.PushedAuthorizationPath
 }
 
+func (helper SetupHelper) revocationPath() string {
+	return helper.RevocationPath
+}
+
+func (helper SetupHelper) introspectionPath() string {
+	return helper.IntrospectionPath
+}
+
+func (helper SetupHelper) endSessionPath() string {
+	return helper.EndSessionPath
+}
+
+func (helper SetupHelper) checkSessionIframePath() string {
+	return helper.CheckSessionIframePath
+}
+
+func (helper SetupHelper) deviceAuthorizationPath() string {
+	return helper.DeviceAuthorizationPath
+}
+
+func (helper SetupHelper) deviceVerificationPath() string {
+	return helper.DeviceVerificationPath
+}
+
+func (helper SetupHelper) backchannelAuthenticationPath() string {
+	return helper.BackchannelAuthenticationPath
+}
+
 // NewServeMux creates a new http.ServeMux and registers the handlers for the configured paths.
 // It takes an Sdk interface and returns a new *http.ServeMux.
 func (p *SetupHelper) NewServeMux(sdk Sdk) *http.ServeMux {
@@ -110,6 +159,27 @@ func (p *SetupHelper) NewServeMux(sdk Sdk) *http.ServeMux {
 	if p.pushedAuthorizationPath() != "" {
 		mux.HandleFunc(p.pushedAuthorizationPath(), sdk.PushedAuthorizationEndpoint)
 	}
+	if p.revocationPath() != "" {
+		mux.HandleFunc(p.revocationPath(), sdk.RevocationEndpoint)
+	}
+	if p.introspectionPath() != "" {
+		mux.HandleFunc(p.introspectionPath(), sdk.IntrospectionEndpoint)
+	}
+	if p.endSessionPath() != "" {
+		mux.HandleFunc(p.endSessionPath(), sdk.EndSessionEndpoint)
+	}
+	if p.checkSessionIframePath() != "" {
+		mux.HandleFunc(p.checkSessionIframePath(), sdk.CheckSessionIframeEndpoint)
+	}
+	if p.deviceAuthorizationPath() != "" {
+		mux.HandleFunc(p.deviceAuthorizationPath(), sdk.DeviceAuthorizationEndpoint)
+	}
+	if p.deviceVerificationPath() != "" {
+		mux.HandleFunc(p.deviceVerificationPath(), sdk.DeviceVerificationEndpoint)
+	}
+	if p.backchannelAuthenticationPath() != "" {
+		mux.HandleFunc(p.backchannelAuthenticationPath(), sdk.BackchannelAuthenticationEndpoint)
+	}
 	return mux
 }
 
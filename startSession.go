@@ -47,5 +47,16 @@ func (i *innerSdk) startSession(w http.ResponseWriter, r *http.Request, requestI
 		HttpOnly: true,
 		SameSite: http.SameSiteLaxMode,
 	})
+	if res.Msg.BrowserStateName != "" {
+		// https://openid.net/specs/openid-connect-session-1_0.html#OPiframe
+		// check_session_iframe reads this cookie from JavaScript inside the RP's page.
+		http.SetCookie(w, &http.Cookie{
+			Name:     res.Msg.BrowserStateName,
+			Value:    res.Msg.BrowserStateValue,
+			Secure:   true,
+			HttpOnly: false,
+			SameSite: http.SameSiteNoneMode,
+		})
+	}
 	return res.Msg.Value, nil
 }
@@ -36,10 +36,13 @@ import (
 func (i *innerSdk) TokenEndpoint(w http.ResponseWriter, r *http.Request) {
 	if err := func() error {
 		ctx := r.Context()
+		cert, handshake := i.clientCertificate(r)
 		req := connect.NewRequest(&oppb.TokenRequest{
-			ContentType:          r.Header.Get(httphelper.HeaderContentType),
-			Method:               r.Method,
-			TlsClientCertificate: r.Header.Get("X-Client-Cert-Hash"),
+			ContentType:                       r.Header.Get(httphelper.HeaderContentType),
+			Method:                            r.Method,
+			TlsClientCertificate:              cert,
+			TlsClientCertificateFromHandshake: handshake,
+			Dpop:                              dpopProof(r),
 		})
 		// Get form
 		defer r.Body.Close()
@@ -60,6 +63,7 @@ func (i *innerSdk) TokenEndpoint(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			return err
 		}
+		setDpopNonce(w, res.Msg.DpopNonce)
 		if fail := res.Msg.GetFail(); fail != nil {
 			body, err := json.MarshalIndent(fail.Error, "", "  ")
 			if err != nil {
@@ -72,7 +76,15 @@ func (i *innerSdk) TokenEndpoint(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(int(fail.StatusCode))
 			w.Write(body)
 		} else if success := res.Msg.GetSuccess(); success != nil {
-			body, err := json.MarshalIndent(success, "", "  ")
+			// https://www.rfc-editor.org/rfc/rfc9396.html#section-7
+			// authorization_details is returned as a JSON array
+			body, err := json.MarshalIndent(struct {
+				*oppb.TokenSuccessResponse
+				AuthorizationDetails json.RawMessage `json:"authorization_details,omitempty"`
+			}{
+				TokenSuccessResponse: success,
+				AuthorizationDetails: json.RawMessage(success.AuthorizationDetails),
+			}, "", "  ")
 			if err != nil {
 				return err
 			}
@@ -34,11 +34,13 @@ import (
 
 func (i *innerSdk) UserinfoEndpoint(w http.ResponseWriter, r *http.Request) {
 	if err := func() error {
+		cert, _ := i.clientCertificate(r)
 		req := connect.NewRequest(&oppb.UserinfoRequest{
 			Authorization:        r.Header.Get(httphelper.HeaderAuthorization),
 			ContentType:          r.Header.Get(httphelper.HeaderContentType),
 			Method:               r.Method,
-			TlsClientCertificate: r.Header.Get("X-Client-Cert-Hash"),
+			TlsClientCertificate: cert,
+			Dpop:                 dpopProof(r),
 		})
 		// Get form
 		defer r.Body.Close()